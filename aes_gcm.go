@@ -0,0 +1,114 @@
+package goutil
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+)
+
+// ErrDecrypt is returned for any decryption failure, such as the wrong key or
+// the tampered data, without revealing the reason.
+var ErrDecrypt = errors.New("goutil: decryption failed")
+
+const (
+	passphraseVersion   byte = 1
+	passphraseSaltLen        = 16
+	passphraseHeaderLen      = 1 + 4 + 4 + 1 + passphraseSaltLen
+)
+
+// Encrypt encrypts and authenticates the plaintext with AES-GCM.
+// The key must be 16, 24 or 32 bytes to select AES-128, AES-192 or AES-256.
+// A random nonce is generated and prepended to the returned ciphertext.
+func Encrypt(key, plaintext []byte) ([]byte, error) {
+	return gcmSeal(key, plaintext, nil)
+}
+
+// Decrypt decrypts the ciphertext returned by Encrypt,
+// any failure of the authentication is reported as ErrDecrypt.
+func Decrypt(key, ciphertext []byte) ([]byte, error) {
+	return gcmOpen(key, ciphertext, nil)
+}
+
+// EncryptWithPassphrase encrypts the plaintext with AES-256-GCM,
+// using the key derived from the passphrase and a random salt by Argon2id.
+// The Argon2 parameters (DefaultArgon2Params by default) and the salt are embedded
+// in the authenticated header of the returned data.
+func EncryptWithPassphrase(passphrase, plaintext []byte, params ...Argon2Params) ([]byte, error) {
+	p := DefaultArgon2Params
+	if len(params) > 0 {
+		p = params[0]
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	header := make([]byte, passphraseHeaderLen)
+	header[0] = passphraseVersion
+	binary.BigEndian.PutUint32(header[1:], p.Time)
+	binary.BigEndian.PutUint32(header[5:], p.Memory)
+	header[9] = p.Threads
+	cryptoFill(header[10:])
+	key := Argon2IDKey(passphrase, header[10:], p.Time, p.Memory, p.Threads, 32)
+	sealed, err := gcmSeal(key, plaintext, header)
+	if err != nil {
+		return nil, err
+	}
+	return append(header, sealed...), nil
+}
+
+// DecryptWithPassphrase decrypts the data returned by EncryptWithPassphrase.
+// The Argon2 parameters in the header exceeding MaxArgon2Params are reported as
+// ErrArgon2Params before deriving the key, any other failure is reported as ErrDecrypt.
+func DecryptWithPassphrase(passphrase, data []byte) ([]byte, error) {
+	if len(data) < passphraseHeaderLen || data[0] != passphraseVersion {
+		return nil, ErrDecrypt
+	}
+	header := data[:passphraseHeaderLen]
+	p := Argon2Params{
+		Time:    binary.BigEndian.Uint32(header[1:]),
+		Memory:  binary.BigEndian.Uint32(header[5:]),
+		Threads: header[9],
+	}
+	if p.Time == 0 || p.Threads == 0 {
+		return nil, ErrDecrypt
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	key := Argon2IDKey(passphrase, header[10:], p.Time, p.Memory, p.Threads, 32)
+	return gcmOpen(key, data[passphraseHeaderLen:], header)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func gcmSeal(key, plaintext, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize(), gcm.NonceSize()+len(plaintext)+gcm.Overhead())
+	cryptoFill(nonce)
+	return gcm.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func gcmOpen(key, ciphertext, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize()+gcm.Overhead() {
+		return nil, ErrDecrypt
+	}
+	n := gcm.NonceSize()
+	plaintext, err := gcm.Open(nil, ciphertext[:n], ciphertext[n:], aad)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,71 @@
+package goutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestEncrypt(t *testing.T) {
+	key := RandomBytes(32)
+	plaintext := []byte("attack at dawn")
+	c1, err := Encrypt(key, plaintext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c2, _ := Encrypt(key, plaintext)
+	if bytes.Equal(c1, c2) {
+		t.Fatal("nonce is reused")
+	}
+	p, err := Decrypt(key, c1)
+	if err != nil || !bytes.Equal(p, plaintext) {
+		t.Fatal(p, err)
+	}
+	c1[len(c1)-1] ^= 1
+	if _, err = Decrypt(key, c1); err != ErrDecrypt {
+		t.Fatalf("tampered: %v", err)
+	}
+	if _, err = Decrypt(RandomBytes(32), c2); err != ErrDecrypt {
+		t.Fatalf("wrong key: %v", err)
+	}
+	if _, err = Decrypt(key, c2[:10]); err != ErrDecrypt {
+		t.Fatalf("short: %v", err)
+	}
+	if _, err = Encrypt(key[:10], plaintext); err == nil {
+		t.Fatal("expect key size error")
+	}
+}
+
+func TestEncryptWithPassphrase(t *testing.T) {
+	params := Argon2Params{Time: 1, Memory: 64, Threads: 2}
+	plaintext := []byte("secret config")
+	data, err := EncryptWithPassphrase([]byte("correct horse"), plaintext, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := DecryptWithPassphrase([]byte("correct horse"), data)
+	if err != nil || !bytes.Equal(p, plaintext) {
+		t.Fatal(p, err)
+	}
+	if _, err = DecryptWithPassphrase([]byte("wrong"), data); err != ErrDecrypt {
+		t.Fatalf("wrong passphrase: %v", err)
+	}
+	data[12] ^= 1 // tamper the embedded salt
+	if _, err = DecryptWithPassphrase([]byte("correct horse"), data); err != ErrDecrypt {
+		t.Fatalf("tampered header: %v", err)
+	}
+	// the hostile header must be rejected before allocating the memory
+	binary.BigEndian.PutUint32(data[5:], 0xffffffff)
+	if _, err = DecryptWithPassphrase([]byte("correct horse"), data); err != ErrArgon2Params {
+		t.Fatalf("hostile memory: %v", err)
+	}
+	// 1 GiB and 10 passes are far beyond the defaults
+	binary.BigEndian.PutUint32(data[1:], 10)
+	binary.BigEndian.PutUint32(data[5:], 1<<20)
+	if _, err = DecryptWithPassphrase([]byte("correct horse"), data); err != ErrArgon2Params {
+		t.Fatalf("costly params: %v", err)
+	}
+	if _, err = EncryptWithPassphrase([]byte("x"), plaintext, Argon2Params{Time: 100, Memory: 64, Threads: 1}); err != ErrArgon2Params {
+		t.Fatalf("hostile time: %v", err)
+	}
+}
@@ -0,0 +1,355 @@
+package goutil
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	// ErrArchiveFormat is returned for the archive names other than .zip, .tar.gz and .tgz.
+	ErrArchiveFormat = errors.New("goutil: unsupported archive format")
+	// ErrArchiveTooLarge is returned by ExtractArchive if the limits of ArchiveOptions are exceeded.
+	ErrArchiveTooLarge = errors.New("goutil: archive exceeds the size limit")
+)
+
+// ArchiveOptions is the options of ArchiveDir and ExtractArchive.
+type ArchiveOptions struct {
+	// Symlinks is the symbolic links policy, SymlinkCopy by default. SymlinkFollow is the same as
+	// SymlinkSkip on extraction, and only the links pointing inside the destination are extracted.
+	Symlinks SymlinkPolicy
+	// MaxSize is the max total size of the extracted files, unlimited if <= 0.
+	MaxSize int64
+	// MaxFiles is the max number of the extracted entries, unlimited if <= 0.
+	MaxFiles int
+	// Progress is called after each entry with the total bytes of the file contents so far.
+	Progress func(name string, written int64)
+}
+
+func archiveFormat(name string) (string, error) {
+	name = strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(name, ".zip"):
+		return "zip", nil
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		return "tgz", nil
+	}
+	return "", ErrArchiveFormat
+}
+
+type archiveEntry struct {
+	name string
+	info os.FileInfo
+	path string
+	link string
+}
+
+// ArchiveDir archives the directory srcDir into the file dest, whose format is
+// detected by the extension: .zip, .tar.gz or .tgz.
+// The entry names are relative to srcDir.
+func ArchiveDir(srcDir, dest string, opts *ArchiveOptions) (err error) {
+	if opts == nil {
+		opts = new(ArchiveOptions)
+	}
+	format, err := archiveFormat(dest)
+	if err != nil {
+		return err
+	}
+	entries, err := archiveEntries(srcDir, opts.Symlinks)
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			os.Remove(dest)
+		}
+	}()
+	if format == "zip" {
+		return writeZip(f, entries, opts)
+	}
+	return writeTarGz(f, entries, opts)
+}
+
+func archiveEntries(srcDir string, policy SymlinkPolicy) ([]archiveEntry, error) {
+	var entries []archiveEntry
+	err := filepath.Walk(srcDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(srcDir, path)
+		if err != nil || rel == "." {
+			return err
+		}
+		e := archiveEntry{name: filepath.ToSlash(rel), info: info, path: path}
+		if info.Mode()&os.ModeSymlink != 0 {
+			switch policy {
+			case SymlinkCopy:
+				if e.link, err = os.Readlink(path); err != nil {
+					return err
+				}
+			case SymlinkFollow:
+				if e.info, err = os.Stat(path); err != nil {
+					return err
+				}
+				if e.info.IsDir() {
+					// avoids the loops, only the files are followed
+					return nil
+				}
+			default:
+				return nil
+			}
+		}
+		entries = append(entries, e)
+		return nil
+	})
+	return entries, err
+}
+
+func copyArchiveFile(w io.Writer, path string) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	return io.Copy(w, f)
+}
+
+func writeZip(f io.Writer, entries []archiveEntry, opts *ArchiveOptions) error {
+	zw := zip.NewWriter(f)
+	var written int64
+	for _, e := range entries {
+		h, err := zip.FileInfoHeader(e.info)
+		if err != nil {
+			return err
+		}
+		h.Name = e.name
+		if e.info.IsDir() {
+			h.Name += "/"
+		} else {
+			h.Method = zip.Deflate
+		}
+		w, err := zw.CreateHeader(h)
+		if err != nil {
+			return err
+		}
+		switch {
+		case e.link != "":
+			_, err = io.WriteString(w, e.link)
+		case e.info.Mode().IsRegular():
+			var n int64
+			n, err = copyArchiveFile(w, e.path)
+			written += n
+		}
+		if err != nil {
+			return err
+		}
+		if opts.Progress != nil {
+			opts.Progress(e.name, written)
+		}
+	}
+	return zw.Close()
+}
+
+func writeTarGz(f io.Writer, entries []archiveEntry, opts *ArchiveOptions) error {
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+	var written int64
+	for _, e := range entries {
+		if !e.info.IsDir() && !e.info.Mode().IsRegular() && e.link == "" {
+			// skips the devices, pipes and sockets
+			continue
+		}
+		h, err := tar.FileInfoHeader(e.info, e.link)
+		if err != nil {
+			return err
+		}
+		h.Name = e.name
+		if e.info.IsDir() {
+			h.Name += "/"
+		}
+		if err = tw.WriteHeader(h); err != nil {
+			return err
+		}
+		if e.info.Mode().IsRegular() {
+			n, err := copyArchiveFile(tw, e.path)
+			written += n
+			if err != nil {
+				return err
+			}
+		}
+		if opts.Progress != nil {
+			opts.Progress(e.name, written)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+type archiveExtractor struct {
+	dest    string
+	opts    *ArchiveOptions
+	written int64
+	files   int
+}
+
+// ExtractArchive extracts the .zip, .tar.gz or .tgz file src into the directory destDir.
+// The entries escaping from destDir are rejected with ErrPathTraversal,
+// and the setuid, setgid and sticky bits are dropped.
+func ExtractArchive(src, destDir string, opts *ArchiveOptions) error {
+	if opts == nil {
+		opts = new(ArchiveOptions)
+	}
+	format, err := archiveFormat(src)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(destDir, 0755); err != nil {
+		return err
+	}
+	x := &archiveExtractor{dest: destDir, opts: opts}
+	if format == "zip" {
+		return x.extractZip(src)
+	}
+	return x.extractTarGz(src)
+}
+
+func (x *archiveExtractor) extractZip(src string) error {
+	zr, err := zip.OpenReader(src)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	for _, zf := range zr.File {
+		err = func() error {
+			r, err := zf.Open()
+			if err != nil {
+				return err
+			}
+			defer r.Close()
+			return x.extract(zf.Name, zf.Mode(), r)
+		}()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (x *archiveExtractor) extractTarGz(src string) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(gr)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		mode := h.FileInfo().Mode()
+		switch h.Typeflag {
+		case tar.TypeSymlink:
+			err = x.extract(h.Name, mode, strings.NewReader(h.Linkname))
+		case tar.TypeReg, tar.TypeDir:
+			err = x.extract(h.Name, mode, tr)
+		default:
+			// skips the hard links, devices and pipes
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (x *archiveExtractor) extract(name string, mode os.FileMode, r io.Reader) error {
+	x.files++
+	if x.opts.MaxFiles > 0 && x.files > x.opts.MaxFiles {
+		return ErrArchiveTooLarge
+	}
+	path, err := SecureJoin(x.dest, name)
+	if err != nil {
+		return err
+	}
+	switch {
+	case mode.IsDir():
+		err = os.MkdirAll(path, mode.Perm()|0700)
+	case mode&os.ModeSymlink != 0:
+		err = x.extractSymlink(path, r)
+	case mode.IsRegular():
+		err = x.extractFile(path, mode.Perm(), r)
+	}
+	if err != nil {
+		return err
+	}
+	if x.opts.Progress != nil {
+		x.opts.Progress(name, x.written)
+	}
+	return nil
+}
+
+func (x *archiveExtractor) extractSymlink(path string, r io.Reader) error {
+	if x.opts.Symlinks != SymlinkCopy {
+		return nil
+	}
+	b, err := ioutil.ReadAll(io.LimitReader(r, 4096))
+	if err != nil {
+		return err
+	}
+	link := filepath.FromSlash(string(b))
+	if filepath.IsAbs(link) || filepath.VolumeName(link) != "" ||
+		!withinDir(x.dest, filepath.Join(filepath.Dir(path), link)) {
+		return ErrPathTraversal
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.Symlink(link, path)
+}
+
+func (x *archiveExtractor) extractFile(path string, perm os.FileMode, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	if x.opts.MaxSize > 0 {
+		r = io.LimitReader(r, x.opts.MaxSize-x.written+1)
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	n, err := io.Copy(f, r)
+	x.written += n
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil && x.opts.MaxSize > 0 && x.written > x.opts.MaxSize {
+		err = ErrArchiveTooLarge
+	}
+	if err != nil {
+		os.Remove(path)
+	}
+	return err
+}
@@ -0,0 +1,88 @@
+package goutil
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestArchiveDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "archive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	src := filepath.Join(dir, "src")
+	os.MkdirAll(filepath.Join(src, "a", "b"), 0755)
+	ioutil.WriteFile(filepath.Join(src, "x.txt"), []byte("hello"), 0644)
+	ioutil.WriteFile(filepath.Join(src, "a", "b", "y.txt"), []byte("world!"), 0600)
+	hasLink := runtime.GOOS != "windows"
+	if hasLink {
+		os.Symlink("x.txt", filepath.Join(src, "link"))
+	}
+	for _, name := range []string{"out.zip", "out.tar.gz"} {
+		file := filepath.Join(dir, name)
+		var last int64
+		err = ArchiveDir(src, file, &ArchiveOptions{
+			Progress: func(_ string, n int64) { last = n },
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if last != 11 {
+			t.Fatalf("%s: got progress %d", name, last)
+		}
+		dest := filepath.Join(dir, name+".d")
+		if err = ExtractArchive(file, dest, nil); err != nil {
+			t.Fatal(err)
+		}
+		b, err := ioutil.ReadFile(filepath.Join(dest, "a", "b", "y.txt"))
+		if err != nil || string(b) != "world!" {
+			t.Fatalf("%s: got %q, %v", name, b, err)
+		}
+		if hasLink {
+			if link, err := os.Readlink(filepath.Join(dest, "link")); err != nil || link != "x.txt" {
+				t.Fatalf("%s: got link %q, %v", name, link, err)
+			}
+			if fi, _ := os.Stat(filepath.Join(dest, "a", "b", "y.txt")); fi.Mode().Perm() != 0600 {
+				t.Fatalf("%s: got mode %v", name, fi.Mode())
+			}
+		}
+		err = ExtractArchive(file, filepath.Join(dir, name+".limit"), &ArchiveOptions{MaxSize: 10})
+		if err != ErrArchiveTooLarge {
+			t.Fatalf("%s: got %v", name, err)
+		}
+	}
+	if err = ArchiveDir(src, filepath.Join(dir, "out.rar"), nil); err != ErrArchiveFormat {
+		t.Fatalf("got %v", err)
+	}
+}
+
+func TestExtractArchiveTraversal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "archive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for i, h := range []*tar.Header{
+		{Name: "../evil.txt", Typeflag: tar.TypeReg, Mode: 0644},
+		{Name: "evil", Typeflag: tar.TypeSymlink, Linkname: "../../etc", Mode: 0777},
+	} {
+		file := filepath.Join(dir, "evil.tgz")
+		f, _ := os.Create(file)
+		gw := gzip.NewWriter(f)
+		tw := tar.NewWriter(gw)
+		tw.WriteHeader(h)
+		tw.Close()
+		gw.Close()
+		f.Close()
+		err = ExtractArchive(file, filepath.Join(dir, "out"), nil)
+		if err != ErrPathTraversal {
+			t.Fatalf("%d: got %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package goutil
+
+import (
+	"errors"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Argon2Params are the cost parameters of Argon2id.
+type Argon2Params struct {
+	// Time is the number of passes over the memory.
+	Time uint32
+	// Memory is the size of the memory in KiB.
+	Memory uint32
+	// Threads is the number of lanes computed in parallel.
+	Threads uint8
+}
+
+// DefaultArgon2Params are the second recommended parameters of RFC 9106:
+// 3 passes over 64 MiB memory with 4 lanes.
+var DefaultArgon2Params = Argon2Params{Time: 3, Memory: 64 * 1024, Threads: 4}
+
+// MaxArgon2Params are the upper bounds of the Argon2 parameters accepted from
+// the untrusted input, such as the header of EncryptWithPassphrase data or a password hash,
+// so that a forged input can't make the verifier spend much more than DefaultArgon2Params:
+// 4 passes over 64 MiB memory with 16 lanes.
+// Raise it before using the stronger parameters, which are rejected otherwise.
+var MaxArgon2Params = Argon2Params{Time: 4, Memory: 64 * 1024, Threads: 16}
+
+// ErrArgon2Params is returned when the Argon2 parameters are zero or exceed MaxArgon2Params.
+var ErrArgon2Params = errors.New("goutil: argon2 parameters out of range")
+
+// Validate checks that the time and threads are at least 1,
+// and that no parameter exceeds MaxArgon2Params.
+func (p Argon2Params) Validate() error {
+	if p.Time < 1 || p.Threads < 1 ||
+		p.Time > MaxArgon2Params.Time ||
+		p.Memory > MaxArgon2Params.Memory ||
+		p.Threads > MaxArgon2Params.Threads {
+		return ErrArgon2Params
+	}
+	return nil
+}
+
+// Argon2IDKey derives a key of keyLen bytes from the password and salt with Argon2id (RFC 9106).
+// passes is the number of passes, memory is in KiB, and threads is the number of lanes.
+// It is golang.org/x/crypto/argon2.IDKey.
+func Argon2IDKey(password, salt []byte, passes, memory uint32, threads uint8, keyLen uint32) []byte {
+	return argon2.IDKey(password, salt, passes, memory, threads, keyLen)
+}
+
+const argon2Version = argon2.Version
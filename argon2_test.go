@@ -0,0 +1,33 @@
+package goutil
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestArgon2IDKey(t *testing.T) {
+	key := Argon2IDKey([]byte("password"), []byte("somesalt"), 2, 64, 2, 32)
+	expect := "94387415dfb84ed1977465a1e8626073adf42bd4eeae1faa1dd4e23a1ff6859f"
+	if got := hex.EncodeToString(key); got != expect {
+		t.Fatalf("got %s, expect %s", got, expect)
+	}
+}
+
+func TestArgon2ParamsValidate(t *testing.T) {
+	for _, c := range []struct {
+		p  Argon2Params
+		ok bool
+	}{
+		{DefaultArgon2Params, true},
+		{MaxArgon2Params, true},
+		{Argon2Params{Time: 0, Memory: 64, Threads: 1}, false},
+		{Argon2Params{Time: 1, Memory: 64, Threads: 0}, false},
+		{Argon2Params{Time: 5, Memory: 64, Threads: 1}, false},
+		{Argon2Params{Time: 1, Memory: 64, Threads: 17}, false},
+		{Argon2Params{Time: 1, Memory: 64*1024 + 1, Threads: 1}, false},
+	} {
+		if err := c.p.Validate(); (err == nil) != c.ok {
+			t.Errorf("%+v: %v", c.p, err)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package goutil
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/henrylee2cn/goutil/fs"
+)
+
+// WriteFileAtomic writes data to the file atomically: it writes a temporary file
+// in the same directory, fsyncs it, and renames it to filename,
+// so that the readers never see a torn file even if the process crashes.
+func WriteFileAtomic(filename string, data []byte, perm os.FileMode) error {
+	return WriteFileAtomicFS(fs.OS, filename, data, perm)
+}
+
+// WriteFileAtomicFS is WriteFileAtomic on the file system fsys.
+func WriteFileAtomicFS(fsys fs.FS, filename string, data []byte, perm os.FileMode) error {
+	w, err := NewAtomicFileWriterFS(fsys, filename, perm)
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(data); err != nil {
+		w.Abort()
+		return err
+	}
+	return w.Close()
+}
+
+// AtomicFileWriter is an io.WriteCloser whose content replaces the file atomically on Close.
+type AtomicFileWriter struct {
+	fsys     fs.FS
+	filename string
+	perm     os.FileMode
+	f        fs.File
+	err      error
+	done     bool
+}
+
+// NewAtomicFileWriter creates an *AtomicFileWriter writing to a temporary file
+// in the same directory as filename.
+// Close commits the content to filename, and Abort discards it.
+func NewAtomicFileWriter(filename string, perm os.FileMode) (*AtomicFileWriter, error) {
+	return NewAtomicFileWriterFS(fs.OS, filename, perm)
+}
+
+// NewAtomicFileWriterFS is NewAtomicFileWriter on the file system fsys.
+func NewAtomicFileWriterFS(fsys fs.FS, filename string, perm os.FileMode) (*AtomicFileWriter, error) {
+	dir, base := filepath.Split(filename)
+	if dir == "" {
+		dir = "."
+	}
+	f, err := fsys.TempFile(dir, "."+base+".tmp")
+	if err != nil {
+		return nil, err
+	}
+	return &AtomicFileWriter{fsys: fsys, filename: filename, perm: perm, f: f}, nil
+}
+
+// Write writes p to the temporary file.
+func (w *AtomicFileWriter) Write(p []byte) (int, error) {
+	if w.done {
+		return 0, os.ErrClosed
+	}
+	n, err := w.f.Write(p)
+	if err != nil && w.err == nil {
+		w.err = err
+	}
+	return n, err
+}
+
+// Close fsyncs the temporary file and renames it to the target file.
+// If any write failed, it discards the temporary file and returns the error.
+func (w *AtomicFileWriter) Close() error {
+	if w.done {
+		return os.ErrClosed
+	}
+	w.done = true
+	tmp := w.f.Name()
+	err := w.err
+	if err == nil {
+		err = w.f.Sync()
+	}
+	if cerr := w.f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = w.fsys.Chmod(tmp, w.perm)
+	}
+	if err == nil && w.fsys == fs.OS && FileExists(w.filename) {
+		// keeps the ACL of the replaced file on Windows
+		err = copyACL(w.filename, tmp)
+	}
+	if err == nil {
+		err = w.fsys.Rename(tmp, w.filename)
+	}
+	if err != nil {
+		w.fsys.Remove(tmp)
+		return err
+	}
+	if w.fsys != fs.OS {
+		return nil
+	}
+	return syncDir(filepath.Dir(w.filename))
+}
+
+// Abort discards the temporary file, leaving the target file unchanged.
+func (w *AtomicFileWriter) Abort() error {
+	if w.done {
+		return nil
+	}
+	w.done = true
+	w.f.Close()
+	return w.fsys.Remove(w.f.Name())
+}
+
+// syncDir fsyncs the directory to persist the rename, which is a no-op on Windows.
+func syncDir(dir string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = d.Sync()
+	if cerr := d.Close(); err == nil {
+		err = cerr
+	}
+	// some file systems do not support fsync on directories
+	if errors.Is(err, os.ErrInvalid) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,83 @@
+package goutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/henrylee2cn/goutil/fs"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goutil_atomic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "config.json")
+	if err = ioutil.WriteFile(name, []byte("old"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = WriteFileAtomic(name, []byte("new"), 0640); err != nil {
+		t.Fatal(err)
+	}
+	b, _ := ioutil.ReadFile(name)
+	if string(b) != "new" {
+		t.Fatalf("got %q", b)
+	}
+	if fi, _ := os.Stat(name); fi.Mode().Perm() != 0640 {
+		t.Fatalf("perm %v", fi.Mode().Perm())
+	}
+
+	w, err := NewAtomicFileWriter(name, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write([]byte("partial"))
+	if b, _ = ioutil.ReadFile(name); string(b) != "new" {
+		t.Fatalf("target changed before Close: %q", b)
+	}
+	if err = w.Abort(); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ = ioutil.ReadFile(name); string(b) != "new" {
+		t.Fatalf("target changed after Abort: %q", b)
+	}
+	if _, err = w.Write([]byte("x")); err == nil {
+		t.Fatal("expect write after Abort error")
+	}
+	entries, _ := ioutil.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Fatalf("temporary files are left: %d", len(entries))
+	}
+	if err = WriteFileAtomic(filepath.Join(dir, "missing", "x"), nil, 0600); err == nil {
+		t.Fatal("expect error for missing directory")
+	}
+}
+
+func TestWriteFileAtomicFS(t *testing.T) {
+	fsys := fs.NewMemFS()
+	fsys.MkdirAll("/etc/app", 0755)
+	filename := "/etc/app/conf"
+	if err := WriteFileAtomicFS(fsys, filename, []byte("v1"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteFileAtomicFS(fsys, filename, []byte("v2"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	b, err := fs.ReadFile(fsys, filename)
+	if err != nil || string(b) != "v2" {
+		t.Fatalf("got %q, %v", b, err)
+	}
+	infos, _ := fsys.ReadDir("/etc/app")
+	if len(infos) != 1 || infos[0].Mode().Perm() != 0600 {
+		t.Fatalf("got %v", infos)
+	}
+	w, _ := NewAtomicFileWriterFS(fsys, filename, 0600)
+	w.Write([]byte("v3"))
+	w.Abort()
+	if infos, _ = fsys.ReadDir("/etc/app"); len(infos) != 1 {
+		t.Fatalf("got %v", infos)
+	}
+}
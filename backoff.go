@@ -0,0 +1,115 @@
+package goutil
+
+import (
+	"math"
+	mrand "math/rand"
+	"time"
+)
+
+// BackoffStop is returned by (*Backoff).Next when the max elapsed time is exceeded.
+const BackoffStop time.Duration = -1
+
+// BackoffPolicy is the growth of the backoff delays.
+type BackoffPolicy int
+
+// The backoff policies.
+const (
+	// BackoffExponential multiplies the delay by the Multiplier every time.
+	BackoffExponential BackoffPolicy = iota
+	// BackoffConstant keeps the delay as the Initial.
+	BackoffConstant
+	// BackoffFibonacci grows the delay by the Fibonacci sequence of the Initial.
+	BackoffFibonacci
+)
+
+// BackoffOptions is the options of NewBackoff.
+type BackoffOptions struct {
+	Policy BackoffPolicy
+	// Initial is the first delay, 100ms by default.
+	Initial time.Duration
+	// Max caps the delay before the jitter, no cap if <= 0.
+	Max time.Duration
+	// Multiplier is the growth factor of BackoffExponential, 2 by default.
+	Multiplier float64
+	// Jitter randomizes every delay by the ratio in [0, 1], e.g. 0.2 makes it ±20%.
+	Jitter float64
+	// MaxElapsed makes Next return BackoffStop once the time since the creation or Reset
+	// exceeds it, no limit if <= 0.
+	MaxElapsed time.Duration
+}
+
+// Backoff is the schedule iterator of the delays between retries,
+// e.g. for the retry helpers and the reconnect loops.
+// It is not safe for concurrent use.
+type Backoff struct {
+	opts    BackoffOptions
+	attempt int
+	cur     float64
+	prev    float64
+	start   time.Time
+}
+
+// NewBackoff creates a backoff schedule. opts may be nil.
+func NewBackoff(opts *BackoffOptions) *Backoff {
+	b := new(Backoff)
+	if opts != nil {
+		b.opts = *opts
+	}
+	if b.opts.Initial <= 0 {
+		b.opts.Initial = 100 * time.Millisecond
+	}
+	if b.opts.Multiplier <= 1 {
+		b.opts.Multiplier = 2
+	}
+	if b.opts.Jitter < 0 {
+		b.opts.Jitter = 0
+	} else if b.opts.Jitter > 1 {
+		b.opts.Jitter = 1
+	}
+	b.Reset()
+	return b
+}
+
+// Next returns the next delay, or BackoffStop if the MaxElapsed is exceeded.
+func (b *Backoff) Next() time.Duration {
+	if b.opts.MaxElapsed > 0 && time.Since(b.start) > b.opts.MaxElapsed {
+		return BackoffStop
+	}
+	d := b.cur
+	b.attempt++
+	switch b.opts.Policy {
+	case BackoffExponential:
+		b.cur *= b.opts.Multiplier
+	case BackoffFibonacci:
+		b.cur, b.prev = b.cur+b.prev, b.cur
+	}
+	if max := float64(b.opts.Max); max > 0 {
+		if d > max {
+			d = max
+		}
+		if b.cur > max {
+			// stops growing, and keeps the Fibonacci sequence from overflowing
+			b.cur, b.prev = max, max
+		}
+	}
+	if j := b.opts.Jitter; j > 0 {
+		d *= 1 + j*(2*mrand.Float64()-1)
+	}
+	if d >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(d)
+}
+
+// Attempt returns the number of the delays returned since the creation or Reset.
+func (b *Backoff) Attempt() int {
+	return b.attempt
+}
+
+// Reset restarts the schedule and the elapsed time.
+func (b *Backoff) Reset() {
+	b.attempt = 0
+	b.cur = float64(b.opts.Initial)
+	b.prev = 0
+	b.start = time.Now()
+}
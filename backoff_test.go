@@ -0,0 +1,57 @@
+package goutil
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	next := func(b *Backoff, n int) []time.Duration {
+		var ds []time.Duration
+		for i := 0; i < n; i++ {
+			ds = append(ds, b.Next())
+		}
+		return ds
+	}
+	ms := time.Millisecond
+	b := NewBackoff(&BackoffOptions{Initial: ms, Max: 10 * ms})
+	if got := next(b, 6); !reflect.DeepEqual(got, []time.Duration{ms, 2 * ms, 4 * ms, 8 * ms, 10 * ms, 10 * ms}) {
+		t.Fatalf("exponential: got %v", got)
+	}
+	if b.Attempt() != 6 {
+		t.Fatalf("got attempt %d", b.Attempt())
+	}
+	b.Reset()
+	if b.Next() != ms || b.Attempt() != 1 {
+		t.Fatal("Reset does not restart the schedule")
+	}
+	b = NewBackoff(&BackoffOptions{Policy: BackoffFibonacci, Initial: ms})
+	if got := next(b, 6); !reflect.DeepEqual(got, []time.Duration{ms, ms, 2 * ms, 3 * ms, 5 * ms, 8 * ms}) {
+		t.Fatalf("fibonacci: got %v", got)
+	}
+	b = NewBackoff(&BackoffOptions{Policy: BackoffConstant, Initial: 3 * ms})
+	if got := next(b, 3); !reflect.DeepEqual(got, []time.Duration{3 * ms, 3 * ms, 3 * ms}) {
+		t.Fatalf("constant: got %v", got)
+	}
+	b = NewBackoff(&BackoffOptions{Policy: BackoffConstant, Initial: 100 * ms, Jitter: 0.5})
+	for i := 0; i < 100; i++ {
+		if d := b.Next(); d < 50*ms || d > 150*ms {
+			t.Fatalf("jitter: got %v", d)
+		}
+	}
+	b = NewBackoff(&BackoffOptions{MaxElapsed: 10 * ms})
+	if b.Next() == BackoffStop {
+		t.Fatal("stopped too early")
+	}
+	time.Sleep(20 * ms)
+	if b.Next() != BackoffStop {
+		t.Fatal("expect BackoffStop")
+	}
+	b = NewBackoff(nil)
+	for i := 0; i < 100; i++ {
+		if d := b.Next(); d <= 0 {
+			t.Fatalf("overflow: got %v", d)
+		}
+	}
+}
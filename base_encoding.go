@@ -0,0 +1,235 @@
+package goutil
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"errors"
+	"math"
+	"strings"
+	"sync"
+)
+
+var encodeBufPool = sync.Pool{
+	New: func() interface{} {
+		b := make([]byte, 0, 64)
+		return &b
+	},
+}
+
+func getEncodeBuf(n int) *[]byte {
+	bp := encodeBufPool.Get().(*[]byte)
+	if cap(*bp) < n {
+		*bp = make([]byte, n)
+	}
+	*bp = (*bp)[:n]
+	return bp
+}
+
+func putEncodeBuf(bp *[]byte) {
+	// Don't retain the huge buffers.
+	if cap(*bp) <= 64<<10 {
+		encodeBufPool.Put(bp)
+	}
+}
+
+var base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Base32Encode returns the standard base32 encoding of b without padding.
+func Base32Encode(b []byte) string {
+	bp := getEncodeBuf(base32NoPadding.EncodedLen(len(b)))
+	base32NoPadding.Encode(*bp, b)
+	s := string(*bp)
+	putEncodeBuf(bp)
+	return s
+}
+
+// Base32Decode decodes the standard base32 string, with or without padding.
+func Base32Decode(s string) ([]byte, error) {
+	return base32NoPadding.DecodeString(strings.TrimRight(s, "="))
+}
+
+// Base64URLEncode returns the URL-safe base64 encoding of b without padding.
+func Base64URLEncode(b []byte) string {
+	bp := getEncodeBuf(base64.RawURLEncoding.EncodedLen(len(b)))
+	base64.RawURLEncoding.Encode(*bp, b)
+	s := string(*bp)
+	putEncodeBuf(bp)
+	return s
+}
+
+// Base64URLDecode decodes the URL-safe base64 string, with or without padding.
+func Base64URLDecode(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var base58Index = func() (idx [256]int8) {
+	for i := range idx {
+		idx[i] = -1
+	}
+	for i := 0; i < len(base58Alphabet); i++ {
+		idx[base58Alphabet[i]] = int8(i)
+	}
+	return
+}()
+
+// ErrBase58 is returned when decoding an invalid base58 string.
+var ErrBase58 = errors.New("goutil: invalid base58 string")
+
+// Base58Encode returns the base58 encoding of b, using the bitcoin alphabet.
+// Each leading zero byte is encoded as '1'.
+func Base58Encode(b []byte) string {
+	return radixEncode(b, base58Alphabet)
+}
+
+// Base58Decode decodes the base58 string using the bitcoin alphabet.
+func Base58Decode(s string) ([]byte, error) {
+	b, ok := radixDecode(s, base58Alphabet, &base58Index)
+	if !ok {
+		return nil, ErrBase58
+	}
+	return b, nil
+}
+
+const base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+var base62Index = func() (idx [256]int8) {
+	for i := range idx {
+		idx[i] = -1
+	}
+	for i := 0; i < len(base62Alphabet); i++ {
+		idx[base62Alphabet[i]] = int8(i)
+	}
+	return
+}()
+
+// ErrBase62 is returned when decoding an invalid base62 string.
+var ErrBase62 = errors.New("goutil: invalid base62 string")
+
+// Base62Encode returns the base62 encoding of n, using the alphabet 0-9A-Za-z.
+// It is suitable for making short URL-friendly identifiers from numeric IDs.
+func Base62Encode(n uint64) string {
+	var buf [11]byte
+	i := len(buf)
+	for {
+		i--
+		buf[i] = base62Alphabet[n%62]
+		n /= 62
+		if n == 0 {
+			break
+		}
+	}
+	return string(buf[i:])
+}
+
+// Base62Decode decodes the base62 string produced by Base62Encode.
+func Base62Decode(s string) (uint64, error) {
+	if s == "" {
+		return 0, ErrBase62
+	}
+	var n uint64
+	for i := 0; i < len(s); i++ {
+		d := base62Index[s[i]]
+		if d < 0 || n > (math.MaxUint64-uint64(d))/62 {
+			return 0, ErrBase62
+		}
+		n = n*62 + uint64(d)
+	}
+	return n, nil
+}
+
+// Base62EncodeBytes returns the base62 encoding of b, using the alphabet 0-9A-Za-z.
+// Each leading zero byte is encoded as '0'.
+func Base62EncodeBytes(b []byte) string {
+	return radixEncode(b, base62Alphabet)
+}
+
+// Base62DecodeBytes decodes the base62 string produced by Base62EncodeBytes.
+func Base62DecodeBytes(s string) ([]byte, error) {
+	b, ok := radixDecode(s, base62Alphabet, &base62Index)
+	if !ok {
+		return nil, ErrBase62
+	}
+	return b, nil
+}
+
+// radixEncode encodes b as a big-endian number in the base of len(alphabet) (58 or 62),
+// each leading zero byte is encoded as alphabet[0].
+func radixEncode(b []byte, alphabet string) string {
+	base := len(alphabet)
+	zeros := 0
+	for zeros < len(b) && b[zeros] == 0 {
+		zeros++
+	}
+	// log(256)/log(58) ~= 1.37
+	size := (len(b)-zeros)*138/100 + 1
+	bp := getEncodeBuf(size)
+	buf := *bp
+	for i := range buf {
+		buf[i] = 0
+	}
+	high := size - 1
+	for _, c := range b[zeros:] {
+		carry := int(c)
+		j := size - 1
+		for ; j > high || carry != 0; j-- {
+			carry += 256 * int(buf[j])
+			buf[j] = byte(carry % base)
+			carry /= base
+		}
+		high = j
+	}
+	i := 0
+	for i < size && buf[i] == 0 {
+		i++
+	}
+	out := make([]byte, zeros+size-i)
+	for j := 0; j < zeros; j++ {
+		out[j] = alphabet[0]
+	}
+	for j := zeros; i < size; i, j = i+1, j+1 {
+		out[j] = alphabet[buf[i]]
+	}
+	putEncodeBuf(bp)
+	return BytesToString(out)
+}
+
+// radixDecode is the inverse of radixEncode.
+func radixDecode(s string, alphabet string, index *[256]int8) ([]byte, bool) {
+	base := len(alphabet)
+	zeros := 0
+	for zeros < len(s) && s[zeros] == alphabet[0] {
+		zeros++
+	}
+	// log(62)/log(256) ~= 0.744
+	size := (len(s)-zeros)*745/1000 + 1
+	bp := getEncodeBuf(size)
+	buf := *bp
+	for i := range buf {
+		buf[i] = 0
+	}
+	high := size - 1
+	for i := zeros; i < len(s); i++ {
+		carry := int(index[s[i]])
+		if carry < 0 {
+			putEncodeBuf(bp)
+			return nil, false
+		}
+		j := size - 1
+		for ; j > high || carry != 0; j-- {
+			carry += base * int(buf[j])
+			buf[j] = byte(carry)
+			carry >>= 8
+		}
+		high = j
+	}
+	i := 0
+	for i < size && buf[i] == 0 {
+		i++
+	}
+	out := make([]byte, zeros+size-i)
+	copy(out[zeros:], buf[i:])
+	putEncodeBuf(bp)
+	return out, true
+}
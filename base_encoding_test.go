@@ -0,0 +1,87 @@
+package goutil
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBase58(t *testing.T) {
+	cases := []struct {
+		raw []byte
+		enc string
+	}{
+		{[]byte(""), ""},
+		{[]byte("hello world"), "StV1DL6CwTryKyV"},
+		{[]byte{0, 0, 1}, "112"},
+		{[]byte{0}, "1"},
+		{[]byte{0xff, 0xff}, "LUv"},
+	}
+	for _, c := range cases {
+		if enc := Base58Encode(c.raw); enc != c.enc {
+			t.Errorf("Base58Encode(%x) = %q, expect %q", c.raw, enc, c.enc)
+		}
+		raw, err := Base58Decode(c.enc)
+		if err != nil || !bytes.Equal(raw, c.raw) {
+			t.Errorf("Base58Decode(%q) = %x, %v, expect %x", c.enc, raw, err, c.raw)
+		}
+	}
+	if _, err := Base58Decode("0OIl"); err != ErrBase58 {
+		t.Errorf("expect ErrBase58, got %v", err)
+	}
+	for i := 0; i < 100; i++ {
+		raw := RandomBytes(i)
+		got, err := Base58Decode(Base58Encode(raw))
+		if err != nil || !bytes.Equal(got, raw) {
+			t.Fatalf("round trip %x: %x, %v", raw, got, err)
+		}
+	}
+}
+
+func TestBase62(t *testing.T) {
+	for n, enc := range map[uint64]string{0: "0", 61: "z", 62: "10", 1234567890: "1LY7VK", 1<<64 - 1: "LygHa16AHYF"} {
+		if got := Base62Encode(n); got != enc {
+			t.Errorf("Base62Encode(%d) = %q, expect %q", n, got, enc)
+		}
+		if got, err := Base62Decode(enc); err != nil || got != n {
+			t.Errorf("Base62Decode(%q) = %d, %v, expect %d", enc, got, err, n)
+		}
+	}
+	for _, s := range []string{"", "a-b", "LygHa16AHYG", "zzzzzzzzzzzz"} {
+		if _, err := Base62Decode(s); err != ErrBase62 {
+			t.Errorf("Base62Decode(%q) expect ErrBase62, got %v", s, err)
+		}
+	}
+	if enc := Base62EncodeBytes([]byte("hello world")); enc != "AAwf93rvy4aWQVw" {
+		t.Errorf("Base62EncodeBytes = %q", enc)
+	}
+	if enc := Base62EncodeBytes([]byte{0, 0, 1}); enc != "001" {
+		t.Errorf("Base62EncodeBytes = %q", enc)
+	}
+	for i := 0; i < 100; i++ {
+		raw := RandomBytes(i)
+		got, err := Base62DecodeBytes(Base62EncodeBytes(raw))
+		if err != nil || !bytes.Equal(got, raw) {
+			t.Fatalf("round trip %x: %x, %v", raw, got, err)
+		}
+	}
+}
+
+func TestBase32AndBase64URL(t *testing.T) {
+	raw := []byte("hello?>")
+	if s := Base32Encode(raw); s != "NBSWY3DPH47A" {
+		t.Errorf("Base32Encode: %q", s)
+	}
+	for _, s := range []string{"NBSWY3DPH47A", "NBSWY3DPH47A===="} {
+		if b, err := Base32Decode(s); err != nil || !bytes.Equal(b, raw) {
+			t.Errorf("Base32Decode(%q) = %q, %v", s, b, err)
+		}
+	}
+	if s := Base64URLEncode(raw); s != "aGVsbG8_Pg" {
+		t.Errorf("Base64URLEncode: %q", s)
+	}
+	for _, s := range []string{"aGVsbG8_Pg", "aGVsbG8_Pg=="} {
+		if b, err := Base64URLDecode(s); err != nil || !bytes.Equal(b, raw) {
+			t.Errorf("Base64URLDecode(%q) = %q, %v", s, b, err)
+		}
+	}
+}
@@ -0,0 +1,305 @@
+package goutil
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+var (
+	// ErrShortBytes is returned when the data ends before the value is read completely.
+	ErrShortBytes = errors.New("goutil: unexpected end of bytes")
+	// ErrVarintOverflow is returned when the varint overflows 64 bits.
+	ErrVarintOverflow = errors.New("goutil: varint overflows a 64-bit integer")
+)
+
+// ZigzagEncode maps the signed integer to unsigned, so that the small absolute values
+// have the small encodings: 0 => 0, -1 => 1, 1 => 2, -2 => 3, ...
+func ZigzagEncode(x int64) uint64 {
+	return uint64(x<<1) ^ uint64(x>>63)
+}
+
+// ZigzagDecode is the inverse of ZigzagEncode.
+func ZigzagDecode(u uint64) int64 {
+	return int64(u>>1) ^ -int64(u&1)
+}
+
+// PutUvarint encodes x into buf and returns the number of bytes written.
+// It panics if buf is too small (binary.MaxVarintLen64 is always enough).
+func PutUvarint(buf []byte, x uint64) int {
+	return binary.PutUvarint(buf, x)
+}
+
+// Uvarint decodes the uint64 from buf and returns it with the number of bytes read.
+// The error is ErrShortBytes or ErrVarintOverflow.
+func Uvarint(buf []byte) (uint64, int, error) {
+	x, n := binary.Uvarint(buf)
+	return x, n, varintError(n)
+}
+
+// PutVarint zigzag-encodes x into buf and returns the number of bytes written.
+func PutVarint(buf []byte, x int64) int {
+	return binary.PutVarint(buf, x)
+}
+
+// Varint decodes the zigzag-encoded int64 from buf and returns it with the number of bytes read.
+func Varint(buf []byte) (int64, int, error) {
+	x, n := binary.Varint(buf)
+	return x, n, varintError(n)
+}
+
+// AppendUvarint appends the varint encoding of x to b.
+func AppendUvarint(b []byte, x uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutUvarint(buf[:], x)]...)
+}
+
+// AppendVarint appends the zigzag varint encoding of x to b.
+func AppendVarint(b []byte, x int64) []byte {
+	return AppendUvarint(b, ZigzagEncode(x))
+}
+
+func varintError(n int) error {
+	switch {
+	case n == 0:
+		return ErrShortBytes
+	case n < 0:
+		return ErrVarintOverflow
+	}
+	return nil
+}
+
+// ByteWriter builds the binary data in the byte order.
+// The zero value is not usable, use NewByteWriter.
+type ByteWriter struct {
+	buf   []byte
+	order binary.ByteOrder
+}
+
+// NewByteWriter creates a *ByteWriter with the byte order,
+// e.g. binary.BigEndian or binary.LittleEndian.
+func NewByteWriter(order binary.ByteOrder, capacity ...int) *ByteWriter {
+	w := &ByteWriter{order: order}
+	if len(capacity) > 0 && capacity[0] > 0 {
+		w.buf = make([]byte, 0, capacity[0])
+	}
+	return w
+}
+
+// Write appends p, and never fails. It implements io.Writer.
+func (w *ByteWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	return len(p), nil
+}
+
+// WriteByte appends c, and never fails. It implements io.ByteWriter.
+func (w *ByteWriter) WriteByte(c byte) error {
+	w.buf = append(w.buf, c)
+	return nil
+}
+
+// PutUint8 appends v.
+func (w *ByteWriter) PutUint8(v uint8) { w.buf = append(w.buf, v) }
+
+// PutUint16 appends v in the byte order.
+func (w *ByteWriter) PutUint16(v uint16) {
+	var b [2]byte
+	w.order.PutUint16(b[:], v)
+	w.buf = append(w.buf, b[:]...)
+}
+
+// PutUint32 appends v in the byte order.
+func (w *ByteWriter) PutUint32(v uint32) {
+	var b [4]byte
+	w.order.PutUint32(b[:], v)
+	w.buf = append(w.buf, b[:]...)
+}
+
+// PutUint64 appends v in the byte order.
+func (w *ByteWriter) PutUint64(v uint64) {
+	var b [8]byte
+	w.order.PutUint64(b[:], v)
+	w.buf = append(w.buf, b[:]...)
+}
+
+// PutInt8 appends v.
+func (w *ByteWriter) PutInt8(v int8) { w.PutUint8(uint8(v)) }
+
+// PutInt16 appends v in the byte order.
+func (w *ByteWriter) PutInt16(v int16) { w.PutUint16(uint16(v)) }
+
+// PutInt32 appends v in the byte order.
+func (w *ByteWriter) PutInt32(v int32) { w.PutUint32(uint32(v)) }
+
+// PutInt64 appends v in the byte order.
+func (w *ByteWriter) PutInt64(v int64) { w.PutUint64(uint64(v)) }
+
+// PutFloat32 appends the IEEE 754 bits of v in the byte order.
+func (w *ByteWriter) PutFloat32(v float32) { w.PutUint32(math.Float32bits(v)) }
+
+// PutFloat64 appends the IEEE 754 bits of v in the byte order.
+func (w *ByteWriter) PutFloat64(v float64) { w.PutUint64(math.Float64bits(v)) }
+
+// PutUvarint appends the varint encoding of v.
+func (w *ByteWriter) PutUvarint(v uint64) { w.buf = AppendUvarint(w.buf, v) }
+
+// PutVarint appends the zigzag varint encoding of v.
+func (w *ByteWriter) PutVarint(v int64) { w.buf = AppendVarint(w.buf, v) }
+
+// PutBytes appends b as is.
+func (w *ByteWriter) PutBytes(b []byte) { w.buf = append(w.buf, b...) }
+
+// PutLenBytes appends b prefixed with its uvarint length.
+func (w *ByteWriter) PutLenBytes(b []byte) {
+	w.PutUvarint(uint64(len(b)))
+	w.buf = append(w.buf, b...)
+}
+
+// PutLenString appends s prefixed with its uvarint length.
+func (w *ByteWriter) PutLenString(s string) {
+	w.PutUvarint(uint64(len(s)))
+	w.buf = append(w.buf, s...)
+}
+
+// Bytes returns the written data, which is valid until the next write.
+func (w *ByteWriter) Bytes() []byte { return w.buf }
+
+// Len returns the length of the written data.
+func (w *ByteWriter) Len() int { return len(w.buf) }
+
+// Reset clears the written data and keeps the capacity.
+func (w *ByteWriter) Reset() { w.buf = w.buf[:0] }
+
+// ByteReader reads the binary data in the byte order.
+// The errors are sticky: once a read fails, the following reads return zero values,
+// and Err reports the first error.
+type ByteReader struct {
+	buf   []byte
+	off   int
+	order binary.ByteOrder
+	err   error
+}
+
+// NewByteReader creates a *ByteReader reading b in the byte order.
+func NewByteReader(b []byte, order binary.ByteOrder) *ByteReader {
+	return &ByteReader{buf: b, order: order}
+}
+
+func (r *ByteReader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || len(r.buf)-r.off < n {
+		r.err = ErrShortBytes
+		return nil
+	}
+	b := r.buf[r.off : r.off+n]
+	r.off += n
+	return b
+}
+
+// Err returns the first error encountered.
+func (r *ByteReader) Err() error { return r.err }
+
+// Offset returns the number of bytes read.
+func (r *ByteReader) Offset() int { return r.off }
+
+// Remaining returns the number of unread bytes.
+func (r *ByteReader) Remaining() int { return len(r.buf) - r.off }
+
+// ReadByte reads a byte. It implements io.ByteReader.
+func (r *ByteReader) ReadByte() (byte, error) {
+	b := r.next(1)
+	if b == nil {
+		return 0, r.err
+	}
+	return b[0], nil
+}
+
+// Skip skips n bytes.
+func (r *ByteReader) Skip(n int) { r.next(n) }
+
+// Uint8 reads an uint8.
+func (r *ByteReader) Uint8() uint8 {
+	if b := r.next(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+// Uint16 reads an uint16 in the byte order.
+func (r *ByteReader) Uint16() uint16 {
+	if b := r.next(2); b != nil {
+		return r.order.Uint16(b)
+	}
+	return 0
+}
+
+// Uint32 reads an uint32 in the byte order.
+func (r *ByteReader) Uint32() uint32 {
+	if b := r.next(4); b != nil {
+		return r.order.Uint32(b)
+	}
+	return 0
+}
+
+// Uint64 reads an uint64 in the byte order.
+func (r *ByteReader) Uint64() uint64 {
+	if b := r.next(8); b != nil {
+		return r.order.Uint64(b)
+	}
+	return 0
+}
+
+// Int8 reads an int8.
+func (r *ByteReader) Int8() int8 { return int8(r.Uint8()) }
+
+// Int16 reads an int16 in the byte order.
+func (r *ByteReader) Int16() int16 { return int16(r.Uint16()) }
+
+// Int32 reads an int32 in the byte order.
+func (r *ByteReader) Int32() int32 { return int32(r.Uint32()) }
+
+// Int64 reads an int64 in the byte order.
+func (r *ByteReader) Int64() int64 { return int64(r.Uint64()) }
+
+// Float32 reads a float32 in the byte order.
+func (r *ByteReader) Float32() float32 { return math.Float32frombits(r.Uint32()) }
+
+// Float64 reads a float64 in the byte order.
+func (r *ByteReader) Float64() float64 { return math.Float64frombits(r.Uint64()) }
+
+// Uvarint reads a varint encoded uint64.
+func (r *ByteReader) Uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	x, n, err := Uvarint(r.buf[r.off:])
+	if err != nil {
+		r.err = err
+		return 0
+	}
+	r.off += n
+	return x
+}
+
+// Varint reads a zigzag varint encoded int64.
+func (r *ByteReader) Varint() int64 { return ZigzagDecode(r.Uvarint()) }
+
+// Bytes reads n bytes, which share the underlying data.
+func (r *ByteReader) Bytes(n int) []byte { return r.next(n) }
+
+// LenBytes reads the bytes prefixed with the uvarint length, which share the underlying data.
+func (r *ByteReader) LenBytes() []byte {
+	n := r.Uvarint()
+	if n > uint64(r.Remaining()) {
+		if r.err == nil {
+			r.err = ErrShortBytes
+		}
+		return nil
+	}
+	return r.next(int(n))
+}
+
+// LenString reads the string prefixed with the uvarint length.
+func (r *ByteReader) LenString() string { return string(r.LenBytes()) }
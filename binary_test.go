@@ -0,0 +1,85 @@
+package goutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+func TestZigzag(t *testing.T) {
+	for x, u := range map[int64]uint64{0: 0, -1: 1, 1: 2, -2: 3, math.MaxInt64: math.MaxUint64 - 1, math.MinInt64: math.MaxUint64} {
+		if got := ZigzagEncode(x); got != u {
+			t.Errorf("ZigzagEncode(%d) = %d, expect %d", x, got, u)
+		}
+		if got := ZigzagDecode(u); got != x {
+			t.Errorf("ZigzagDecode(%d) = %d, expect %d", u, got, x)
+		}
+	}
+}
+
+func TestVarint(t *testing.T) {
+	b := AppendVarint(AppendUvarint(nil, 300), -3)
+	if !bytes.Equal(b, []byte{0xac, 0x02, 0x05}) {
+		t.Fatalf("got %x", b)
+	}
+	u, n, err := Uvarint(b)
+	if u != 300 || n != 2 || err != nil {
+		t.Fatal(u, n, err)
+	}
+	x, n, err := Varint(b[2:])
+	if x != -3 || n != 1 || err != nil {
+		t.Fatal(x, n, err)
+	}
+	if _, _, err = Uvarint([]byte{0x80}); err != ErrShortBytes {
+		t.Fatal(err)
+	}
+	if _, _, err = Uvarint(bytes.Repeat([]byte{0xff}, 11)); err != ErrVarintOverflow {
+		t.Fatal(err)
+	}
+	buf := make([]byte, binary.MaxVarintLen64)
+	if n := PutVarint(buf, -3); n != 1 || buf[0] != 5 {
+		t.Fatal(n, buf)
+	}
+	if n := PutUvarint(buf, 300); n != 2 {
+		t.Fatal(n)
+	}
+}
+
+func TestByteReaderWriter(t *testing.T) {
+	for _, order := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+		w := NewByteWriter(order, 64)
+		w.PutUint8(1)
+		w.PutInt16(-2)
+		w.PutUint32(3)
+		w.PutInt64(-4)
+		w.PutFloat32(1.5)
+		w.PutFloat64(-2.5)
+		w.PutUvarint(1 << 40)
+		w.PutVarint(-100)
+		w.PutLenString("hello")
+		w.PutBytes([]byte{9, 9})
+
+		r := NewByteReader(w.Bytes(), order)
+		if r.Uint8() != 1 || r.Int16() != -2 || r.Uint32() != 3 || r.Int64() != -4 ||
+			r.Float32() != 1.5 || r.Float64() != -2.5 || r.Uvarint() != 1<<40 || r.Varint() != -100 ||
+			r.LenString() != "hello" || !bytes.Equal(r.Bytes(2), []byte{9, 9}) || r.Err() != nil {
+			t.Fatalf("%v: read mismatch, err=%v", order, r.Err())
+		}
+		if r.Remaining() != 0 || r.Offset() != w.Len() {
+			t.Fatalf("%v: remaining %d", order, r.Remaining())
+		}
+		if r.Uint16() != 0 || r.Err() != ErrShortBytes {
+			t.Fatalf("%v: expect sticky ErrShortBytes, got %v", order, r.Err())
+		}
+	}
+	w := NewByteWriter(binary.BigEndian)
+	w.PutUint16(0x0102)
+	if !bytes.Equal(w.Bytes(), []byte{1, 2}) {
+		t.Fatalf("got %x", w.Bytes())
+	}
+	r := NewByteReader([]byte{0x05, 'a'}, binary.BigEndian)
+	if r.LenBytes() != nil || r.Err() != ErrShortBytes {
+		t.Fatal("expect ErrShortBytes for LenBytes")
+	}
+}
@@ -0,0 +1,101 @@
+package goutil
+
+import (
+	"context"
+	"time"
+)
+
+// Budget is the time budget until a deadline, which can be split across
+// the sequential downstream calls.
+type Budget struct {
+	deadline time.Time
+}
+
+// BudgetFromContext returns the budget of the context deadline,
+// and the budget has no deadline if the context has none.
+func BudgetFromContext(ctx context.Context) Budget {
+	deadline, _ := ctx.Deadline()
+	return Budget{deadline: deadline}
+}
+
+// NewBudget returns the budget of d from now.
+func NewBudget(d time.Duration) Budget {
+	return Budget{deadline: time.Now().Add(d)}
+}
+
+// Deadline returns the deadline, and false if the budget has none.
+func (b Budget) Deadline() (time.Time, bool) {
+	return b.deadline, !b.deadline.IsZero()
+}
+
+// Remaining returns the time left, 0 if it is expired, and -1 if the budget has no deadline.
+func (b Budget) Remaining() time.Duration {
+	if b.deadline.IsZero() {
+		return -1
+	}
+	if d := time.Until(b.deadline); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// Expired reports whether the deadline has passed.
+func (b Budget) Expired() bool {
+	return !b.deadline.IsZero() && !time.Now().Before(b.deadline)
+}
+
+// Context returns a child context of parent whose deadline is the fraction (0, 1] of the remaining budget.
+// If the budget has no deadline, the child has no deadline either.
+func (b Budget) Context(parent context.Context, fraction float64) (context.Context, context.CancelFunc) {
+	if b.deadline.IsZero() {
+		return context.WithCancel(parent)
+	}
+	if fraction <= 0 || fraction > 1 {
+		fraction = 1
+	}
+	now := time.Now()
+	d := b.deadline.Sub(now)
+	if d <= 0 {
+		return context.WithDeadline(parent, b.deadline)
+	}
+	return context.WithDeadline(parent, now.Add(time.Duration(float64(d)*fraction)))
+}
+
+// Split splits the budget by the weights over the sequential calls, e.g. Split(60, 40).
+// Every call takes its weight of the budget remaining when it starts,
+// so that the time left by the early finished calls rolls over to the later ones.
+func (b Budget) Split(weights ...float64) *BudgetSplit {
+	return &BudgetSplit{budget: b, weights: weights}
+}
+
+// BudgetSplit is the sequential split of a budget. It is not safe for concurrent use.
+type BudgetSplit struct {
+	budget  Budget
+	weights []float64
+	next    int
+}
+
+// Next returns the context of the next call. After the last weight,
+// the contexts take all the remaining budget.
+func (s *BudgetSplit) Next(parent context.Context) (context.Context, context.CancelFunc) {
+	var rest float64
+	for _, w := range s.weights[minInt(s.next, len(s.weights)):] {
+		if w > 0 {
+			rest += w
+		}
+	}
+	fraction := 1.0
+	if s.next < len(s.weights) {
+		if w := s.weights[s.next]; w > 0 && rest > 0 {
+			fraction = w / rest
+		} else {
+			fraction = 0
+		}
+	}
+	s.next++
+	if fraction == 0 && !s.budget.deadline.IsZero() {
+		// the zero weight gets the already expired context
+		return context.WithDeadline(parent, time.Now())
+	}
+	return s.budget.Context(parent, fraction)
+}
@@ -0,0 +1,68 @@
+package goutil
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestBudget(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	b := BudgetFromContext(ctx)
+	if _, ok := b.Deadline(); !ok || b.Expired() {
+		t.Fatal("expect an active deadline")
+	}
+	if r := b.Remaining(); r <= 900*time.Millisecond || r > time.Second {
+		t.Fatalf("got %v", r)
+	}
+	child, cancelChild := b.Context(ctx, 0.5)
+	defer cancelChild()
+	if deadline, _ := child.Deadline(); time.Until(deadline) > 510*time.Millisecond {
+		t.Fatalf("got deadline in %v", time.Until(deadline))
+	}
+	none := BudgetFromContext(context.Background())
+	if none.Remaining() != -1 || none.Expired() {
+		t.Fatal("expect no deadline")
+	}
+	noneCtx, cancelNone := none.Split(1, 0).Next(context.Background())
+	defer cancelNone()
+	if _, ok := noneCtx.Deadline(); ok {
+		t.Fatal("expect no deadline")
+	}
+	expired := NewBudget(-time.Second)
+	if !expired.Expired() || expired.Remaining() != 0 {
+		t.Fatal("expect expired")
+	}
+	expiredCtx, cancelExpired := expired.Context(context.Background(), 1)
+	defer cancelExpired()
+	if expiredCtx.Err() == nil {
+		t.Fatal("the expired budget gives a live context")
+	}
+}
+
+func TestBudgetSplit(t *testing.T) {
+	b := NewBudget(time.Second)
+	split := b.Split(60, 40)
+	first, cancel1 := split.Next(context.Background())
+	defer cancel1()
+	if d, _ := first.Deadline(); time.Until(d) > 610*time.Millisecond || time.Until(d) < 500*time.Millisecond {
+		t.Fatalf("the first gets %v", time.Until(d))
+	}
+	// the first call finishes early, and the second takes the rest
+	second, cancel2 := split.Next(context.Background())
+	defer cancel2()
+	if d, _ := second.Deadline(); time.Until(d) < 900*time.Millisecond {
+		t.Fatalf("the second gets %v", time.Until(d))
+	}
+	third, cancel3 := split.Next(context.Background())
+	defer cancel3()
+	if d, _ := third.Deadline(); time.Until(d) < 900*time.Millisecond {
+		t.Fatalf("the extra call gets %v", time.Until(d))
+	}
+	zero, cancel4 := NewBudget(time.Second).Split(0, 1).Next(context.Background())
+	defer cancel4()
+	if zero.Err() == nil {
+		t.Fatal("the zero weight gives a live context")
+	}
+}
@@ -0,0 +1,142 @@
+package goutil
+
+import (
+	"bytes"
+	"sync"
+	"sync/atomic"
+	"unicode/utf8"
+)
+
+// DefaultMaxRetainedCapacity is the default maximum capacity of the builders
+// and buffers retained by the pools.
+const DefaultMaxRetainedCapacity = 64 << 10
+
+var maxRetainedCapacity int64 = DefaultMaxRetainedCapacity
+
+// SetMaxRetainedCapacity sets the maximum capacity of the builders and buffers
+// that ReleaseBuilder and ReleaseBuffer put back into the pools,
+// the larger ones are dropped so that a few huge requests don't pin memory.
+// If n<=0, will use DefaultMaxRetainedCapacity.
+func SetMaxRetainedCapacity(n int) {
+	if n <= 0 {
+		n = DefaultMaxRetainedCapacity
+	}
+	atomic.StoreInt64(&maxRetainedCapacity, int64(n))
+}
+
+func retainable(c int) bool {
+	return int64(c) <= atomic.LoadInt64(&maxRetainedCapacity)
+}
+
+var bufferPool = sync.Pool{
+	New: func() interface{} { return new(bytes.Buffer) },
+}
+
+// AcquireBuffer returns an empty *bytes.Buffer from the pool.
+// The buffer must be released by ReleaseBuffer when it is no longer used.
+func AcquireBuffer() *bytes.Buffer {
+	return bufferPool.Get().(*bytes.Buffer)
+}
+
+// ReleaseBuffer resets the buffer and puts it back into the pool.
+// The buffer and its bytes must not be used after releasing.
+func ReleaseBuffer(b *bytes.Buffer) {
+	if b == nil || !retainable(b.Cap()) {
+		return
+	}
+	b.Reset()
+	bufferPool.Put(b)
+}
+
+var builderPool = sync.Pool{
+	New: func() interface{} { return new(Builder) },
+}
+
+// AcquireBuilder returns an empty *Builder from the pool.
+// The builder must be released by ReleaseBuilder when it is no longer used.
+func AcquireBuilder() *Builder {
+	return builderPool.Get().(*Builder)
+}
+
+// ReleaseBuilder resets the builder and puts it back into the pool.
+// The builder must not be used after releasing.
+func ReleaseBuilder(b *Builder) {
+	if b == nil || !retainable(cap(b.buf)) {
+		return
+	}
+	b.Reset()
+	builderPool.Put(b)
+}
+
+// Builder is a reusable string builder.
+// Unlike strings.Builder, String copies the bytes, so the capacity of
+// the builder can be reused after Reset.
+// The zero value is ready to use.
+type Builder struct {
+	buf []byte
+}
+
+// Write appends p to the builder, it always returns len(p), nil.
+func (b *Builder) Write(p []byte) (int, error) {
+	b.buf = append(b.buf, p...)
+	return len(p), nil
+}
+
+// WriteString appends s to the builder, it always returns len(s), nil.
+func (b *Builder) WriteString(s string) (int, error) {
+	b.buf = append(b.buf, s...)
+	return len(s), nil
+}
+
+// WriteByte appends c to the builder, it always returns nil.
+func (b *Builder) WriteByte(c byte) error {
+	b.buf = append(b.buf, c)
+	return nil
+}
+
+// WriteRune appends the UTF-8 encoding of r to the builder, it always returns nil error.
+func (b *Builder) WriteRune(r rune) (int, error) {
+	if r < utf8.RuneSelf {
+		b.buf = append(b.buf, byte(r))
+		return 1, nil
+	}
+	n := len(b.buf)
+	b.buf = append(b.buf, 0, 0, 0, 0)
+	m := utf8.EncodeRune(b.buf[n:], r)
+	b.buf = b.buf[:n+m]
+	return m, nil
+}
+
+// Grow grows the capacity of the builder to hold another n bytes.
+func (b *Builder) Grow(n int) {
+	if cap(b.buf)-len(b.buf) < n {
+		buf := make([]byte, len(b.buf), 2*cap(b.buf)+n)
+		copy(buf, b.buf)
+		b.buf = buf
+	}
+}
+
+// Bytes returns the accumulated bytes, which are valid until the next modification.
+func (b *Builder) Bytes() []byte {
+	return b.buf
+}
+
+// String returns a copy of the accumulated string.
+func (b *Builder) String() string {
+	return string(b.buf)
+}
+
+// Len returns the number of accumulated bytes.
+func (b *Builder) Len() int {
+	return len(b.buf)
+}
+
+// Cap returns the capacity of the builder.
+func (b *Builder) Cap() int {
+	return cap(b.buf)
+}
+
+// Reset resets the builder to be empty, but it retains the capacity.
+func (b *Builder) Reset() {
+	b.buf = b.buf[:0]
+}
@@ -0,0 +1,44 @@
+package goutil
+
+import (
+	"testing"
+)
+
+func TestBuilderPool(t *testing.T) {
+	b := AcquireBuilder()
+	b.WriteString("hello")
+	b.WriteByte(' ')
+	b.WriteRune('世')
+	b.Write([]byte("界"))
+	s := b.String()
+	if s != "hello 世界" || b.Len() != len(s) {
+		t.Fatalf("unexpected string: %q", s)
+	}
+	ReleaseBuilder(b)
+	b = AcquireBuilder()
+	if b.Len() != 0 {
+		t.Fatal("the acquired builder should be empty")
+	}
+	b.WriteString("HELLO")
+	if s != "hello 世界" {
+		t.Fatalf("the built string is modified: %q", s)
+	}
+	ReleaseBuilder(b)
+}
+
+func TestBufferPool(t *testing.T) {
+	SetMaxRetainedCapacity(1024)
+	defer SetMaxRetainedCapacity(0)
+	b := AcquireBuffer()
+	b.WriteString("hello")
+	ReleaseBuffer(b)
+	if b.Len() != 0 {
+		t.Fatal("the released buffer should be reset")
+	}
+	b = AcquireBuffer()
+	b.Write(make([]byte, 2000))
+	ReleaseBuffer(b)
+	if b.Len() != 2000 {
+		t.Fatal("the huge buffer should be dropped without reset")
+	}
+}
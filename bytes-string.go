@@ -1,16 +1,25 @@
+//go:build !purego && !appengine
+// +build !purego,!appengine
+
 package goutil
 
 import (
 	"unsafe"
 )
 
-// BytesToString convert []byte type to string type.
+// BytesToString convert []byte type to string type without copying.
+// NOTE: the string shares the memory with b, so b must not be modified
+// while the string is still in use.
+// In the purego or appengine build, the bytes are copied.
 func BytesToString(b []byte) string {
 	return *(*string)(unsafe.Pointer(&b))
 }
 
-// StringToBytes convert string type to []byte type.
+// StringToBytes convert string type to []byte type without copying.
 // NOTE: panic if modify the member value of the []byte.
+// The []byte shares the read-only memory with s, so it must never be modified;
+// appending to it is safe because its capacity equals its length.
+// In the purego or appengine build, the string is copied.
 func StringToBytes(s string) []byte {
 	sp := *(*[2]uintptr)(unsafe.Pointer(&s))
 	bp := [3]uintptr{sp[0], sp[1], sp[1]}
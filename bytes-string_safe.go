@@ -0,0 +1,18 @@
+//go:build purego || appengine
+// +build purego appengine
+
+package goutil
+
+// BytesToString convert []byte type to string type.
+// NOTE: this is the safe fallback for the purego or appengine build, the bytes are copied.
+func BytesToString(b []byte) string {
+	return string(b)
+}
+
+// StringToBytes convert string type to []byte type.
+// NOTE: this is the safe fallback for the purego or appengine build, the string is copied.
+func StringToBytes(s string) []byte {
+	b := make([]byte, len(s))
+	copy(b, s)
+	return b
+}
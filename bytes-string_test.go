@@ -8,12 +8,24 @@ func TestBytesToString(t *testing.T) {
 	bb := []byte("testing: BytesToString")
 	ss := BytesToString(bb)
 	t.Logf("type: %T, value: %v", ss, ss)
+	if ss != "testing: BytesToString" {
+		t.Fatalf("unexpected string: %q", ss)
+	}
+	if BytesToString(nil) != "" {
+		t.Fatal("expect empty string")
+	}
 }
 
 func TestStringToBytes(t *testing.T) {
 	s := "testing: StringToBytes"
 	b := StringToBytes(s)
 	t.Logf("type: %T, value: %v, val-string: %s\n", b, b, b)
+	if string(b) != s || len(b) != cap(b) {
+		t.Fatalf("unexpected bytes: %q, cap: %d", b, cap(b))
+	}
 	b = append(b, '!')
 	t.Logf("after append:\ntype: %T, value: %v, val-string: %s\n", b, b, b)
+	if s != "testing: StringToBytes" {
+		t.Fatalf("the source string is modified: %q", s)
+	}
 }
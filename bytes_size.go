@@ -0,0 +1,100 @@
+package goutil
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Byte size units.
+const (
+	Byte uint64 = 1
+
+	KB = 1000 * Byte
+	MB = 1000 * KB
+	GB = 1000 * MB
+	TB = 1000 * GB
+	PB = 1000 * TB
+	EB = 1000 * PB
+
+	KiB = 1024 * Byte
+	MiB = 1024 * KiB
+	GiB = 1024 * MiB
+	TiB = 1024 * GiB
+	PiB = 1024 * TiB
+	EiB = 1024 * PiB
+)
+
+var bytesUnits = map[string]uint64{
+	"": Byte, "b": Byte,
+	"k": KB, "kb": KB, "m": MB, "mb": MB, "g": GB, "gb": GB,
+	"t": TB, "tb": TB, "p": PB, "pb": PB, "e": EB, "eb": EB,
+	"ki": KiB, "kib": KiB, "mi": MiB, "mib": MiB, "gi": GiB, "gib": GiB,
+	"ti": TiB, "tib": TiB, "pi": PiB, "pib": PiB, "ei": EiB, "eib": EiB,
+}
+
+// ParseBytes parses a human-friendly byte size into the number of bytes,
+// such as "42", "1.5GiB", "10 MB", "64k".
+// The SI units (KB, MB...) are powers of 1000, and the IEC units (KiB, MiB...)
+// are powers of 1024. The units are case insensitive.
+func ParseBytes(s string) (uint64, error) {
+	orig := s
+	s = strings.TrimSpace(s)
+	i := strings.IndexFunc(s, func(r rune) bool {
+		return r != '.' && !unicode.IsDigit(r)
+	})
+	if i < 0 {
+		i = len(s)
+	}
+	num, unit := s[:i], strings.ToLower(strings.TrimSpace(s[i:]))
+	mul, ok := bytesUnits[unit]
+	if !ok || num == "" {
+		return 0, errors.New("goutil: invalid byte size " + strconv.Quote(orig))
+	}
+	if n, err := strconv.ParseUint(num, 10, 64); err == nil {
+		if n > math.MaxUint64/mul {
+			return 0, errors.New("goutil: byte size overflows uint64 " + strconv.Quote(orig))
+		}
+		return n * mul, nil
+	}
+	f, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		return 0, errors.New("goutil: invalid byte size " + strconv.Quote(orig))
+	}
+	f *= float64(mul)
+	if f >= math.MaxUint64 {
+		return 0, errors.New("goutil: byte size overflows uint64 " + strconv.Quote(orig))
+	}
+	return uint64(f), nil
+}
+
+// FormatBytes formats the number of bytes with IEC units (powers of 1024),
+// such as FormatBytes(1610612736) returns "1.5GiB".
+func FormatBytes(n uint64) string {
+	return formatBytes(n, 1024, []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"})
+}
+
+// FormatBytesSI formats the number of bytes with SI units (powers of 1000),
+// such as FormatBytesSI(1500000000) returns "1.5GB".
+func FormatBytesSI(n uint64) string {
+	return formatBytes(n, 1000, []string{"B", "KB", "MB", "GB", "TB", "PB", "EB"})
+}
+
+func formatBytes(n uint64, base float64, units []string) string {
+	if float64(n) < base {
+		return strconv.FormatUint(n, 10) + units[0]
+	}
+	f := float64(n)
+	i := 0
+	for f >= base && i < len(units)-1 {
+		f /= base
+		i++
+	}
+	s := strconv.FormatFloat(f, 'f', 1, 64)
+	if strings.HasSuffix(s, ".0") {
+		s = s[:len(s)-2]
+	}
+	return s + units[i]
+}
@@ -0,0 +1,60 @@
+package goutil
+
+import (
+	"testing"
+)
+
+func TestParseBytes(t *testing.T) {
+	cases := []struct {
+		s string
+		n uint64
+	}{
+		{"42", 42},
+		{"42B", 42},
+		{"1.5GiB", 1610612736},
+		{"10 MB", 10000000},
+		{"64k", 64000},
+		{"2KiB", 2048},
+		{" 1.5 gib ", 1610612736},
+		{"16EiB", 0},
+		{"1x", 0},
+		{"", 0},
+		{"MB", 0},
+	}
+	for _, c := range cases {
+		n, err := ParseBytes(c.s)
+		if c.n == 0 {
+			if err == nil {
+				t.Errorf("ParseBytes(%q) expect error, got %d", c.s, n)
+			}
+			continue
+		}
+		if err != nil || n != c.n {
+			t.Errorf("ParseBytes(%q) = %d, %v, expect %d", c.s, n, err, c.n)
+		}
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	cases := []struct {
+		n       uint64
+		iec, si string
+	}{
+		{0, "0B", "0B"},
+		{999, "999B", "999B"},
+		{1024, "1KiB", "1KB"},
+		{1610612736, "1.5GiB", "1.6GB"},
+		{1 << 63, "8EiB", "9.2EB"},
+	}
+	for _, c := range cases {
+		if s := FormatBytes(c.n); s != c.iec {
+			t.Errorf("FormatBytes(%d) = %q, expect %q", c.n, s, c.iec)
+		}
+		if s := FormatBytesSI(c.n); s != c.si {
+			t.Errorf("FormatBytesSI(%d) = %q, expect %q", c.n, s, c.si)
+		}
+		if n, err := ParseBytes(FormatBytes(c.n)); err != nil || (c.n < 1000 && n != c.n) {
+			t.Errorf("round trip %d: %d, %v", c.n, n, err)
+		}
+	}
+}
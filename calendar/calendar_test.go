@@ -0,0 +1,36 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConvert(t *testing.T) {
+	l := NewSolar(2020, 1, 25, 12, 0, 0, 0, time.UTC).Convert()
+	if l.Year() != 2020 || l.Month() != 1 || l.Day() != 1 || l.IsLeapMonth() {
+		t.Fatalf("got %v", l)
+	}
+	// 2020 has the leap 4th month
+	l = NewSolar(2020, 5, 23, 12, 0, 0, 0, time.UTC).Convert()
+	if LeapMonth(2020) != 4 || l.Month() != 4 || l.Day() != 1 || !l.IsLeapMonth() {
+		t.Fatalf("got %v, leap month %d", l, LeapMonth(2020))
+	}
+	s := l.Convert()
+	if y, m, d := s.Date(); y != 2020 || m != 5 || d != 23 {
+		t.Fatalf("got %v", s)
+	}
+	if AnimalYear(2020) != "鼠" {
+		t.Fatalf("got %s", AnimalYear(2020))
+	}
+}
+
+func TestJieQi(t *testing.T) {
+	// 2020: 小寒 Jan 6, 大寒 Jan 20, 立春 Feb 4, 冬至 Dec 21
+	if first, second := JieQisOfMonth(2020, 1); first != 6 || second != 20 {
+		t.Fatalf("got %d, %d", first, second)
+	}
+	list := JieQisOfYear(2020)
+	if list[1][0] != 4 || list[11][1] != 21 {
+		t.Fatalf("got %v", list)
+	}
+}
@@ -30,6 +30,13 @@ func (schedule ConstantDelaySchedule) Next(t time.Time) time.Time {
 	return t.Add(schedule.Delay - time.Duration(t.Nanosecond())*time.Nanosecond)
 }
 
+// Prev returns the previous time this would have run.
+// This rounds so that the previous activation time will be on the second.
+func (schedule ConstantDelaySchedule) Prev(t time.Time) time.Time {
+	t = t.Add(-schedule.Delay)
+	return t.Add(-time.Duration(t.Nanosecond()) * time.Nanosecond)
+}
+
 // Next returns the next time this should be run.
 // This rounds so that the next activation time will be on the second.
 func (schedule ConstantDelaySchedule) LunarNext(t *calendar.Lunar) *calendar.Lunar {
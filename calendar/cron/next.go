@@ -0,0 +1,36 @@
+package cron
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrNoPrev is returned by CronPrev if the schedule cannot compute the previous activation.
+var ErrNoPrev = errors.New("cron: schedule does not support Prev")
+
+// CronNext parses the spec like Parse, and returns the next activation time after from,
+// without running a Cron, e.g. for displaying "next run at ..." or validating the configured specs.
+// It returns the zero time if none is found within five years.
+func CronNext(spec string, from time.Time) (time.Time, error) {
+	sched, err := Parse(spec)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return sched.Next(from), nil
+}
+
+// CronPrev parses the spec like Parse, and returns the previous activation time before from.
+// It returns the zero time if none is found within five years.
+func CronPrev(spec string, from time.Time) (time.Time, error) {
+	sched, err := Parse(spec)
+	if err != nil {
+		return time.Time{}, err
+	}
+	p, ok := sched.(interface {
+		Prev(time.Time) time.Time
+	})
+	if !ok {
+		return time.Time{}, ErrNoPrev
+	}
+	return p.Prev(from), nil
+}
@@ -0,0 +1,37 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCronNextPrev(t *testing.T) {
+	tests := []struct {
+		spec, from, next, prev string
+	}{
+		{"0 0/15 * * * *", "Mon Jul 9 15:07 2012", "Mon Jul 9 15:15 2012", "Mon Jul 9 15:00 2012"},
+		{"0 0/15 * * * *", "Mon Jul 9 15:00 2012", "Mon Jul 9 15:15 2012", "Mon Jul 9 14:45 2012"},
+		{"0 30 8 * * Mon-Fri", "Sat Jul 14 12:00 2012", "Mon Jul 16 08:30 2012", "Fri Jul 13 08:30 2012"},
+		{"0 0 0 1 1 *", "Mon Jul 9 15:00 2012", "Tue Jan 1 00:00 2013", "Sun Jan 1 00:00 2012"},
+		{"0 0 0 29 Feb *", "Mon Jul 9 15:00 2012", "Fri Feb 29 00:00 2016", "Wed Feb 29 00:00 2012"},
+		{"0 59 23 31 * *", "Mon Jul 9 15:00 2012", "Tue Jul 31 23:59 2012", "Thu May 31 23:59 2012"},
+		{"@every 1h", "Mon Jul 9 15:00 2012", "Mon Jul 9 16:00 2012", "Mon Jul 9 14:00 2012"},
+	}
+	for _, c := range tests {
+		from := getTime(c.from)
+		next, err := CronNext(c.spec, from)
+		if err != nil || !next.Equal(getTime(c.next)) {
+			t.Errorf("%s next of %s: got %v, %v", c.spec, c.from, next, err)
+		}
+		prev, err := CronPrev(c.spec, from)
+		if err != nil || !prev.Equal(getTime(c.prev)) {
+			t.Errorf("%s prev of %s: got %v, %v", c.spec, c.from, prev, err)
+		}
+	}
+	if _, err := CronNext("61 * * * * *", time.Now()); err == nil {
+		t.Error("expect the invalid spec error")
+	}
+	if prev, err := CronPrev("0 0 0 30 Feb *", time.Now()); err != nil || !prev.IsZero() {
+		t.Errorf("got %v, %v", prev, err)
+	}
+}
@@ -148,6 +148,69 @@ WRAP:
 	return t
 }
 
+// Prev returns the previous time this schedule is activated, less than the given
+// time.  If no time can be found to satisfy the schedule, return the zero time.
+func (s *SpecSchedule) Prev(t time.Time) time.Time {
+	// General approach, mirroring Next:
+	// Check the fields from Month to Second, and if one doesn't match, move to the
+	// last second of the previous value of the field until it matches.
+	// A wrap-around of a field re-verifies the previous fields.
+
+	// Start at the latest possible time (the previous second).
+	if t.Nanosecond() > 0 {
+		t = t.Add(-time.Duration(t.Nanosecond()) * time.Nanosecond)
+	} else {
+		t = t.Add(-1 * time.Second)
+	}
+
+	// If no time is found within five years, return zero.
+	yearLimit := t.Year() - 5
+
+WRAP:
+	if t.Year() < yearLimit {
+		return time.Time{}
+	}
+
+	for 1<<uint(t.Month())&s.Month == 0 {
+		t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).Add(-1 * time.Second)
+		if t.Month() == time.December {
+			goto WRAP
+		}
+	}
+
+	for !dayMatches(s, t) {
+		month := t.Month()
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).Add(-1 * time.Second)
+		if t.Month() != month {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Hour())&s.Hour == 0 {
+		day := t.Day()
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location()).Add(-1 * time.Second)
+		if t.Day() != day {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Minute())&s.Minute == 0 {
+		t = t.Truncate(time.Minute).Add(-1 * time.Second)
+		if t.Minute() == 59 {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Second())&s.Second == 0 {
+		t = t.Add(-1 * time.Second)
+		if t.Second() == 59 {
+			goto WRAP
+		}
+	}
+
+	return t
+}
+
 // LunarNext returns the next lunar time this schedule is activated, greater than the given
 // lunar time.  If no lunar time can be found to satisfy the schedule, return the zero lunar time.
 func (s *SpecSchedule) LunarNext(t *calendar.Lunar) *calendar.Lunar {
@@ -31,7 +31,7 @@ func JieQi(year, n int) int {
 
 // month range [1,12]
 func JieQisOfMonth(year, m int) (first, second int) {
-	return JieQi(year, (m-1)*2), JieQi(year, m*2-1)
+	return JieQi(year, m*2-1), JieQi(year, m*2)
 }
 
 func JieQisOfYear(year int) (list [12][2]int) {
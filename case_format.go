@@ -4,49 +4,159 @@ import (
 	"reflect"
 	"runtime"
 	"strings"
+	"unicode"
 )
 
 // SnakeString converts the accepted string to a snake string (XxYy to xx_yy)
+// Acronyms are kept as one word (HTTPServer to http_server),
+// and digits belong to the preceding word (Utf8String to utf8_string).
+// The other characters are kept (_id to _id, a.b to a.b).
 func SnakeString(s string) string {
-	data := make([]byte, 0, len(s)*2)
+	rs := []rune(s)
+	data := make([]rune, 0, len(rs)*2)
 	j := false
-	num := len(s)
-	for i := 0; i < num; i++ {
-		d := s[i]
-		if i > 0 && d >= 'A' && d <= 'Z' && j {
+	for i, r := range rs {
+		if j && isWordBreak(rs, i) {
 			data = append(data, '_')
 		}
-		if d != '_' {
+		if r != '_' {
 			j = true
 		}
-		data = append(data, d)
+		data = append(data, unicode.ToLower(r))
 	}
-	return strings.ToLower(string(data[:]))
+	return string(data)
+}
+
+// KebabString converts the accepted string to a kebab string (XxYy to xx-yy)
+// Unlike SnakeString, all non-alphanumeric characters are separators (a.b to a-b).
+func KebabString(s string) string {
+	return joinWords(splitWords(s), '-', strings.ToLower)
 }
 
 // CamelString converts the accepted string to a camel string (xx_yy to XxYy)
+// The case of the other letters and the other characters are kept (ID to ID, a.b to A.b).
 func CamelString(s string) string {
-	data := make([]byte, 0, len(s))
+	rs := []rune(s)
+	data := make([]rune, 0, len(rs))
 	j := false
 	k := false
-	num := len(s) - 1
-	for i := 0; i <= num; i++ {
-		d := s[i]
-		if k == false && d >= 'A' && d <= 'Z' {
+	for i, r := range rs {
+		if !k && unicode.IsUpper(r) {
 			k = true
 		}
-		if d >= 'a' && d <= 'z' && (j || k == false) {
-			d = d - 32
+		if unicode.IsLower(r) && (j || !k) {
+			r = unicode.ToUpper(r)
 			j = false
 			k = true
 		}
-		if k && d == '_' && num > i && s[i+1] >= 'a' && s[i+1] <= 'z' {
+		if k && r == '_' && i+1 < len(rs) && unicode.IsLower(rs[i+1]) {
 			j = true
 			continue
 		}
-		data = append(data, d)
+		data = append(data, r)
+	}
+	return string(data)
+}
+
+// LowerCamelString converts the accepted string to a lower camel string (xx_yy to xxYy)
+// The first word is lower-cased and the others are the same as PascalString (HTTPServer to httpServer).
+func LowerCamelString(s string) string {
+	words := splitWords(s)
+	if len(words) == 0 {
+		return ""
+	}
+	return strings.ToLower(words[0]) + joinWords(words[1:], 0, titleFunc(s, words))
+}
+
+// PascalString converts the accepted string to a pascal string (xx_yy to XxYy)
+// Acronyms are kept (HTTPServer to HTTPServer, ID to ID),
+// while the words of an upper case string are title-cased (MAX_VALUE to MaxValue).
+func PascalString(s string) string {
+	words := splitWords(s)
+	return joinWords(words, 0, titleFunc(s, words))
+}
+
+func joinWords(words []string, sep rune, fn func(string) string) string {
+	var b strings.Builder
+	for i, w := range words {
+		if i > 0 && sep != 0 {
+			b.WriteRune(sep)
+		}
+		b.WriteString(fn(w))
+	}
+	return b.String()
+}
+
+// titleFunc returns the func title-casing a word of s,
+// which keeps the acronyms unless s has several words and no lower case letter.
+func titleFunc(s string, words []string) func(string) string {
+	if len(words) > 1 && strings.IndexFunc(s, unicode.IsLower) < 0 {
+		return titleWord
+	}
+	return func(w string) string {
+		if strings.IndexFunc(w, unicode.IsLower) < 0 {
+			return w
+		}
+		return titleWord(w)
+	}
+}
+
+// titleWord upper-cases the first letter of the word and lower-cases the rest.
+func titleWord(w string) string {
+	rs := []rune(w)
+	for i, r := range rs {
+		if i == 0 {
+			rs[i] = unicode.ToUpper(r)
+		} else {
+			rs[i] = unicode.ToLower(r)
+		}
+	}
+	return string(rs)
+}
+
+// splitWords splits the string into words at separators and case changes.
+func splitWords(s string) []string {
+	var (
+		words []string
+		word  []rune
+		rs    = []rune(s)
+	)
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, string(word))
+			word = word[:0]
+		}
+	}
+	for i, r := range rs {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		if len(word) > 0 && isWordBreak(rs, i) {
+			flush()
+		}
+		word = append(word, r)
+	}
+	flush()
+	return words
+}
+
+// isWordBreak reports whether a new word starts at the upper case letter rs[i].
+func isWordBreak(rs []rune, i int) bool {
+	if i == 0 || !unicode.IsUpper(rs[i]) {
+		return false
+	}
+	prev := rs[i-1]
+	switch {
+	case !unicode.IsLetter(prev) && !unicode.IsDigit(prev):
+		return false
+	case !unicode.IsUpper(prev):
+		// fooBar, foo2Bar
+		return true
+	default:
+		// HTTPServer
+		return i+1 < len(rs) && unicode.IsLower(rs[i+1])
 	}
-	return string(data[:])
 }
 
 // ObjectName gets the type name of the object
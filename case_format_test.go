@@ -0,0 +1,74 @@
+package goutil
+
+import (
+	"testing"
+)
+
+func TestCaseFormat(t *testing.T) {
+	cases := []struct {
+		in, snake, kebab, camel, pascal, lowerCamel string
+	}{
+		{"xx_yy", "xx_yy", "xx-yy", "XxYy", "XxYy", "xxYy"},
+		{"XxYy", "xx_yy", "xx-yy", "XxYy", "XxYy", "xxYy"},
+		{"HTTPServer", "http_server", "http-server", "HTTPServer", "HTTPServer", "httpServer"},
+		{"parseHTTPResponse", "parse_http_response", "parse-http-response", "ParseHTTPResponse", "ParseHTTPResponse", "parseHTTPResponse"},
+		{"HTTP_SERVER", "http_server", "http-server", "HTTP_SERVER", "HttpServer", "httpServer"},
+		{"MAX_VALUE", "max_value", "max-value", "MAX_VALUE", "MaxValue", "maxValue"},
+		{"userID", "user_id", "user-id", "UserID", "UserID", "userID"},
+		{"ID", "id", "id", "ID", "ID", "id"},
+		{"Utf8String", "utf8_string", "utf8-string", "Utf8String", "Utf8String", "utf8String"},
+		{"Foo_Bar", "foo_bar", "foo-bar", "Foo_Bar", "FooBar", "fooBar"},
+		{"version 2 beta", "version 2 beta", "version-2-beta", "Version 2 beta", "Version2Beta", "version2Beta"},
+		{"--a--b--", "--a--b--", "a-b", "--A--b--", "AB", "aB"},
+		{"a.b", "a.b", "a-b", "A.b", "AB", "aB"},
+		{"_id", "_id", "id", "_Id", "Id", "id"},
+		{"ÄpfelBaum", "äpfel_baum", "äpfel-baum", "ÄpfelBaum", "ÄpfelBaum", "äpfelBaum"},
+		{"äpfel_baum", "äpfel_baum", "äpfel-baum", "ÄpfelBaum", "ÄpfelBaum", "äpfelBaum"},
+		{"用户Name", "用户_name", "用户-name", "用户Name", "用户Name", "用户Name"},
+		{"", "", "", "", "", ""},
+	}
+	for _, c := range cases {
+		if got := SnakeString(c.in); got != c.snake {
+			t.Errorf("SnakeString(%q) = %q, expect %q", c.in, got, c.snake)
+		}
+		if got := KebabString(c.in); got != c.kebab {
+			t.Errorf("KebabString(%q) = %q, expect %q", c.in, got, c.kebab)
+		}
+		if got := CamelString(c.in); got != c.camel {
+			t.Errorf("CamelString(%q) = %q, expect %q", c.in, got, c.camel)
+		}
+		if got := PascalString(c.in); got != c.pascal {
+			t.Errorf("PascalString(%q) = %q, expect %q", c.in, got, c.pascal)
+		}
+		if got := LowerCamelString(c.in); got != c.lowerCamel {
+			t.Errorf("LowerCamelString(%q) = %q, expect %q", c.in, got, c.lowerCamel)
+		}
+	}
+}
+
+// TestCaseFormatBaseline checks the outputs of the earlier versions are kept.
+func TestCaseFormatBaseline(t *testing.T) {
+	cases := []struct {
+		in, snake, camel string
+	}{
+		{"xx_yy", "xx_yy", "XxYy"},
+		{"XxYy", "xx_yy", "XxYy"},
+		{"userName", "user_name", "UserName"},
+		{"_id", "_id", "_Id"},
+		{"__init__", "__init__", "__Init__"},
+		{"a.b", "a.b", "A.b"},
+		{"--a--b--", "--a--b--", "--A--b--"},
+		{"version 2 beta", "version 2 beta", "Version 2 beta"},
+		{"ID", "id", "ID"},
+		{"HTTP_SERVER", "http_server", "HTTP_SERVER"},
+		{"xx_Yy", "xx_yy", "Xx_Yy"},
+	}
+	for _, c := range cases {
+		if got := SnakeString(c.in); got != c.snake {
+			t.Errorf("SnakeString(%q) = %q, expect %q", c.in, got, c.snake)
+		}
+		if got := CamelString(c.in); got != c.camel {
+			t.Errorf("CamelString(%q) = %q, expect %q", c.in, got, c.camel)
+		}
+	}
+}
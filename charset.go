@@ -0,0 +1,271 @@
+package goutil
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// The charset names used by DetectCharset and DecodeCharset.
+const (
+	CharsetUTF8    = "utf-8"
+	CharsetUTF16LE = "utf-16le"
+	CharsetUTF16BE = "utf-16be"
+	CharsetGBK     = "gbk"
+	CharsetBig5    = "big5"
+)
+
+// ErrUnknownCharset is returned when the charset is not supported.
+var ErrUnknownCharset = errors.New("goutil: unknown charset")
+
+const (
+	gbkTrailCount  = 190
+	big5TrailCount = 157
+)
+
+var (
+	gbkOnce, big5Once   sync.Once
+	gbkTable, big5Table []uint16
+)
+
+func loadCharsetTable(data string, n int) []uint16 {
+	z, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		panic(err)
+	}
+	r, err := zlib.NewReader(bytes.NewReader(z))
+	if err != nil {
+		panic(err)
+	}
+	raw := make([]byte, 2*n)
+	if _, err = io.ReadFull(r, raw); err != nil {
+		panic(err)
+	}
+	t := make([]uint16, n)
+	var prev uint16
+	for i := range t {
+		prev += uint16(raw[2*i])<<8 | uint16(raw[2*i+1])
+		t[i] = prev
+	}
+	return t
+}
+
+func gbkTrailIndex(c byte) int {
+	switch {
+	case c >= 0x40 && c <= 0x7e:
+		return int(c) - 0x40
+	case c >= 0x80 && c <= 0xfe:
+		return int(c) - 0x41
+	}
+	return -1
+}
+
+func big5TrailIndex(c byte) int {
+	switch {
+	case c >= 0x40 && c <= 0x7e:
+		return int(c) - 0x40
+	case c >= 0xa1 && c <= 0xfe:
+		return int(c) - 0xa1 + 0x3f
+	}
+	return -1
+}
+
+// dbcsStats is the statistics of decoding the double-byte charset.
+type dbcsStats struct {
+	pairs, lowTrails, errors int
+}
+
+// decodeDBCS decodes the double-byte charset into UTF-8, the invalid codes are
+// replaced with utf8.RuneError.
+func decodeDBCS(b []byte, table []uint16, trailIndex func(byte) int, trailCount int, euro bool) ([]byte, dbcsStats) {
+	var st dbcsStats
+	out := make([]byte, 0, len(b)+len(b)/2)
+	for i := 0; i < len(b); {
+		c := b[i]
+		if c < 0x80 {
+			out = append(out, c)
+			i++
+			continue
+		}
+		if c == 0x80 && euro {
+			out = appendRune(out, '€')
+			i++
+			continue
+		}
+		if c == 0x80 || c == 0xff || i+1 >= len(b) {
+			out = appendRune(out, utf8.RuneError)
+			st.errors++
+			i++
+			continue
+		}
+		t := trailIndex(b[i+1])
+		var r rune
+		if t >= 0 {
+			r = rune(table[(int(c)-0x81)*trailCount+t])
+		}
+		if r == 0 {
+			out = appendRune(out, utf8.RuneError)
+			st.errors++
+			// do not swallow the ASCII byte
+			if b[i+1] < 0x80 {
+				i++
+			} else {
+				i += 2
+			}
+			continue
+		}
+		st.pairs++
+		if b[i+1] < 0x7f {
+			st.lowTrails++
+		}
+		out = appendRune(out, r)
+		i += 2
+	}
+	return out, st
+}
+
+// DecodeGBK decodes the GBK (CP936) encoded bytes into UTF-8,
+// the invalid codes are replaced with U+FFFD.
+func DecodeGBK(b []byte) []byte {
+	gbkOnce.Do(func() { gbkTable = loadCharsetTable(gbkTableData, 126*gbkTrailCount) })
+	out, _ := decodeDBCS(b, gbkTable, gbkTrailIndex, gbkTrailCount, true)
+	return out
+}
+
+// DecodeBig5 decodes the Big5 encoded bytes into UTF-8,
+// the invalid codes are replaced with U+FFFD.
+func DecodeBig5(b []byte) []byte {
+	big5Once.Do(func() { big5Table = loadCharsetTable(big5TableData, 126*big5TrailCount) })
+	out, _ := decodeDBCS(b, big5Table, big5TrailIndex, big5TrailCount, false)
+	return out
+}
+
+// DecodeUTF16 decodes the UTF-16 encoded bytes into UTF-8.
+// The byte order is detected from the BOM (which is dropped), or big-endian if bigEndian
+// is not specified as false. The invalid surrogates and the odd trailing byte
+// are replaced with U+FFFD.
+func DecodeUTF16(b []byte, bigEndian ...bool) []byte {
+	be := len(bigEndian) == 0 || bigEndian[0]
+	if len(b) >= 2 {
+		switch {
+		case b[0] == 0xfe && b[1] == 0xff:
+			be, b = true, b[2:]
+		case b[0] == 0xff && b[1] == 0xfe:
+			be, b = false, b[2:]
+		}
+	}
+	u := make([]uint16, len(b)/2)
+	for i := range u {
+		if be {
+			u[i] = uint16(b[2*i])<<8 | uint16(b[2*i+1])
+		} else {
+			u[i] = uint16(b[2*i+1])<<8 | uint16(b[2*i])
+		}
+	}
+	out := make([]byte, 0, len(b)+len(b)/2)
+	for _, r := range utf16.Decode(u) {
+		out = appendRune(out, r)
+	}
+	if len(b)%2 == 1 {
+		out = appendRune(out, utf8.RuneError)
+	}
+	return out
+}
+
+// DetectCharset guesses the charset of b, which is one of CharsetUTF8, CharsetUTF16LE,
+// CharsetUTF16BE, CharsetGBK and CharsetBig5.
+// It checks the BOM, the UTF-8 validity and the zero bytes of UTF-16 first,
+// then chooses between GBK and Big5 by the decoding errors and the trail bytes.
+// NOTE: it is a heuristic, and the short inputs are easy to be misjudged.
+func DetectCharset(b []byte) string {
+	switch {
+	case bytes.HasPrefix(b, []byte{0xef, 0xbb, 0xbf}):
+		return CharsetUTF8
+	case bytes.HasPrefix(b, []byte{0xfe, 0xff}):
+		return CharsetUTF16BE
+	case bytes.HasPrefix(b, []byte{0xff, 0xfe}):
+		return CharsetUTF16LE
+	}
+	if cs := detectUTF16(b); cs != "" {
+		return cs
+	}
+	if utf8.Valid(b) {
+		return CharsetUTF8
+	}
+	gbkOnce.Do(func() { gbkTable = loadCharsetTable(gbkTableData, 126*gbkTrailCount) })
+	big5Once.Do(func() { big5Table = loadCharsetTable(big5TableData, 126*big5TrailCount) })
+	_, g := decodeDBCS(b, gbkTable, gbkTrailIndex, gbkTrailCount, true)
+	_, b5 := decodeDBCS(b, big5Table, big5TrailIndex, big5TrailCount, false)
+	switch {
+	case g.errors < b5.errors:
+		return CharsetGBK
+	case b5.errors < g.errors:
+		return CharsetBig5
+	}
+	// GB2312 text rarely uses the trail bytes below 0x7f, which are common in Big5.
+	if b5.lowTrails*10 > b5.pairs {
+		return CharsetBig5
+	}
+	return CharsetGBK
+}
+
+func detectUTF16(b []byte) string {
+	if len(b) < 4 || len(b)%2 != 0 {
+		return ""
+	}
+	var evenZeros, oddZeros int
+	for i, c := range b {
+		if c == 0 {
+			if i%2 == 0 {
+				evenZeros++
+			} else {
+				oddZeros++
+			}
+		}
+	}
+	half := len(b) / 2
+	switch {
+	case oddZeros*3 > half && evenZeros*10 < half:
+		return CharsetUTF16LE
+	case evenZeros*3 > half && oddZeros*10 < half:
+		return CharsetUTF16BE
+	}
+	return ""
+}
+
+// DecodeCharset decodes b in the charset into UTF-8.
+// The charset name is case-insensitive, and "gb2312", "cp936", "big5-hkscs" and "utf-16"
+// are accepted as aliases.
+func DecodeCharset(b []byte, charset string) ([]byte, error) {
+	switch strings.ToLower(strings.TrimSpace(charset)) {
+	case CharsetUTF8, "utf8":
+		return bytes.TrimPrefix(b, []byte{0xef, 0xbb, 0xbf}), nil
+	case CharsetGBK, "gb2312", "cp936":
+		return DecodeGBK(b), nil
+	case CharsetBig5, "big5-hkscs", "cp950":
+		return DecodeBig5(b), nil
+	case CharsetUTF16BE, "utf-16":
+		return DecodeUTF16(b), nil
+	case CharsetUTF16LE:
+		return DecodeUTF16(b, false), nil
+	}
+	return nil, ErrUnknownCharset
+}
+
+// ToUTF8 detects the charset of b by DetectCharset and decodes it into UTF-8.
+func ToUTF8(b []byte) ([]byte, string) {
+	cs := DetectCharset(b)
+	out, _ := DecodeCharset(b, cs)
+	return out, cs
+}
+
+func appendRune(b []byte, r rune) []byte {
+	var buf [utf8.UTFMax]byte
+	return append(b, buf[:utf8.EncodeRune(buf[:], r)]...)
+}
@@ -0,0 +1,86 @@
+package goutil
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+const (
+	charsetGBKText  = "今天天气很好，我们一起去学校吧。这是一段简体中文的测试文字，包含许多常用字。"
+	charsetGBKHex   = "bdf1ccecccecc6f8badcbac3a3acced2c3c7d2bbc6f0c8a5d1a7d0a3b0c9a1a3d5e2cac7d2bbb6cebcf2cce5d6d0cec4b5c4b2e2cad4cec4d7d6a3acb0fcbaacd0edb6e0b3a3d3c3d7d6a1a3"
+	charsetBig5Text = "今天天氣很好，我們一起去學校吧。這是一段繁體中文的測試文字，包含許多常用字。"
+	charsetBig5Hex  = "a4b5a4d1a4d1aef0abdca66ea141a7daadcca440b05fa568bec7aed5a761a143b36fac4fa440ac71c163c5e9a4a4a4e5aabab4fab8d5a4e5a672a141a55da774b35ca668b160a5cea672a143"
+)
+
+func mustHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func TestDecodeGBK(t *testing.T) {
+	if got := string(DecodeGBK(mustHex(charsetGBKHex))); got != charsetGBKText {
+		t.Fatalf("got %q", got)
+	}
+	if got := string(DecodeGBK([]byte{'a', 0x80, 0x81, 0x40, 0xff, 0xa1, 'b', 0xb0})); got != "a€丂��b�" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestDecodeBig5(t *testing.T) {
+	if got := string(DecodeBig5(mustHex(charsetBig5Hex))); got != charsetBig5Text {
+		t.Fatalf("got %q", got)
+	}
+	if got := string(DecodeBig5([]byte{0x81, 0x40, 'x'})); got != "�@x" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestDecodeUTF16(t *testing.T) {
+	for _, c := range []struct {
+		in        []byte
+		bigEndian []bool
+	}{
+		{[]byte{0xff, 0xfe, 'h', 0, 'i', 0, 0x3d, 0xd8, 0x00, 0xde}, nil},
+		{[]byte{0xfe, 0xff, 0, 'h', 0, 'i', 0xd8, 0x3d, 0xde, 0x00}, []bool{false}},
+		{[]byte{0, 'h', 0, 'i', 0xd8, 0x3d, 0xde, 0x00}, nil},
+		{[]byte{'h', 0, 'i', 0, 0x3d, 0xd8, 0x00, 0xde}, []bool{false}},
+	} {
+		if got := string(DecodeUTF16(c.in, c.bigEndian...)); got != "hi😀" {
+			t.Errorf("DecodeUTF16(%x) = %q", c.in, got)
+		}
+	}
+	if got := string(DecodeUTF16([]byte{0, 'a', 0})); got != "a�" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestDetectCharset(t *testing.T) {
+	for _, c := range []struct {
+		in     []byte
+		expect string
+	}{
+		{[]byte("plain ascii"), CharsetUTF8},
+		{[]byte(charsetGBKText), CharsetUTF8},
+		{[]byte{'a', 0, 'b', 0, 'c', 0}, CharsetUTF16LE},
+		{[]byte{0, 'a', 0, 'b', 0, 'c'}, CharsetUTF16BE},
+		{mustHex(charsetGBKHex), CharsetGBK},
+		{mustHex(charsetBig5Hex), CharsetBig5},
+	} {
+		if got := DetectCharset(c.in); got != c.expect {
+			t.Errorf("DetectCharset(%x) = %s, expect %s", c.in, got, c.expect)
+		}
+	}
+	out, cs := ToUTF8(mustHex(charsetBig5Hex))
+	if cs != CharsetBig5 || string(out) != charsetBig5Text {
+		t.Fatalf("ToUTF8: %s %q", cs, out)
+	}
+	if _, err := DecodeCharset(nil, "ebcdic"); err != ErrUnknownCharset {
+		t.Fatal(err)
+	}
+	if out, _ := DecodeCharset(mustHex(charsetGBKHex), "GB2312"); string(out) != charsetGBKText {
+		t.Fatalf("DecodeCharset: %q", out)
+	}
+}
@@ -0,0 +1,112 @@
+package goutil
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+	"os"
+	"sync/atomic"
+)
+
+// Chunk is a chunk of the file read by ChunkReader.
+type Chunk struct {
+	Index  int
+	Offset int64
+	Data   []byte
+	// Checksum is the hex digest of Data.
+	Checksum string
+}
+
+// ChunkOptions is the options of NewChunkReader.
+type ChunkOptions struct {
+	// Offset is where the reading resumes from, rounded down to the chunk boundary.
+	Offset int64
+	// Hash creates the hash of the chunk checksums, SHA-256 by default.
+	Hash func() hash.Hash
+	// Progress is called after each chunk with the end offset of the chunk and the file size.
+	Progress func(done, total int64)
+}
+
+// ChunkReader reads a file in the fixed-size chunks.
+type ChunkReader struct {
+	next      int64 // first for the 64-bit alignment of atomic
+	f         *os.File
+	size      int64
+	chunkSize int64
+	opts      ChunkOptions
+}
+
+// NewChunkReader opens the file to read in chunkSize chunks. opts may be nil.
+func NewChunkReader(filename string, chunkSize int, opts *ChunkOptions) (*ChunkReader, error) {
+	if chunkSize <= 0 {
+		return nil, errors.New("goutil: chunk size must be positive")
+	}
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	r := &ChunkReader{f: f, size: fi.Size(), chunkSize: int64(chunkSize)}
+	if opts != nil {
+		r.opts = *opts
+	}
+	if r.opts.Hash == nil {
+		r.opts.Hash = sha256.New
+	}
+	if r.opts.Offset > 0 {
+		r.next = r.opts.Offset / r.chunkSize
+	}
+	return r, nil
+}
+
+// Size returns the file size.
+func (r *ChunkReader) Size() int64 {
+	return r.size
+}
+
+// NumChunks returns the number of the chunks.
+func (r *ChunkReader) NumChunks() int {
+	return int((r.size + r.chunkSize - 1) / r.chunkSize)
+}
+
+// Next reads the next chunk, and returns io.EOF after the last one.
+// It is safe for concurrent use, and each call returns a distinct chunk.
+func (r *ChunkReader) Next() (*Chunk, error) {
+	i := atomic.AddInt64(&r.next, 1) - 1
+	return r.ReadChunk(int(i))
+}
+
+// ReadChunk reads the chunk at the index, which is safe for concurrent use
+// for the parallel transfers.
+func (r *ChunkReader) ReadChunk(index int) (*Chunk, error) {
+	off := int64(index) * r.chunkSize
+	if index < 0 || off >= r.size {
+		return nil, io.EOF
+	}
+	n := r.chunkSize
+	if off+n > r.size {
+		n = r.size - off
+	}
+	data := make([]byte, n)
+	if _, err := r.f.ReadAt(data, off); err != nil {
+		return nil, err
+	}
+	h := r.opts.Hash()
+	h.Write(data)
+	c := &Chunk{Index: index, Offset: off, Data: data, Checksum: hex.EncodeToString(h.Sum(nil))}
+	if r.opts.Progress != nil {
+		r.opts.Progress(off+n, r.size)
+	}
+	return c, nil
+}
+
+// Close closes the file.
+func (r *ChunkReader) Close() error {
+	return r.f.Close()
+}
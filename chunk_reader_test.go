@@ -0,0 +1,68 @@
+package goutil
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestChunkReader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chunk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "f")
+	data := []byte("0123456789abcdefghij")
+	ioutil.WriteFile(file, data, 0644)
+
+	var done int64
+	r, err := NewChunkReader(file, 8, &ChunkOptions{Hash: md5.New, Progress: func(n, total int64) {
+		if total != 20 {
+			t.Fatalf("got total %d", total)
+		}
+		done = n
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if r.NumChunks() != 3 || r.Size() != 20 {
+		t.Fatalf("got %d chunks of %d", r.NumChunks(), r.Size())
+	}
+	var got []byte
+	for {
+		c, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		sum := md5.Sum(c.Data)
+		if c.Checksum != hex.EncodeToString(sum[:]) || c.Offset != int64(c.Index*8) {
+			t.Fatalf("got %+v", c)
+		}
+		got = append(got, c.Data...)
+	}
+	if string(got) != string(data) || done != 20 {
+		t.Fatalf("got %q, %d", got, done)
+	}
+
+	r2, err := NewChunkReader(file, 8, &ChunkOptions{Offset: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r2.Close()
+	c, err := r2.Next()
+	if err != nil || c.Index != 1 || string(c.Data) != "89abcdef" {
+		t.Fatalf("got %+v, %v", c, err)
+	}
+	if _, err = r2.ReadChunk(3); err != io.EOF {
+		t.Fatalf("got %v", err)
+	}
+}
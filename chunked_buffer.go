@@ -0,0 +1,135 @@
+package goutil
+
+import (
+	"io"
+)
+
+// DefaultChunkSize is the default chunk size of ChunkedBuffer.
+const DefaultChunkSize = 64 << 10
+
+// ChunkedBuffer is a buffer for very large string assembly.
+// It grows in fixed-size chunks, so the written data is never re-copied
+// like doubling a contiguous buffer does,
+// and WriteTo writes the chunks to an io.Writer without a final contiguous allocation.
+// The zero value is an empty buffer with DefaultChunkSize ready to use.
+// It is not safe for concurrent use.
+type ChunkedBuffer struct {
+	chunkSize int
+	chunks    [][]byte
+	cur       int // index of the chunk being written
+	size      int
+}
+
+var (
+	_ io.Writer       = new(ChunkedBuffer)
+	_ io.StringWriter = new(ChunkedBuffer)
+	_ io.WriterTo     = new(ChunkedBuffer)
+)
+
+// NewChunkedBuffer creates a new *ChunkedBuffer.
+// If chunkSize<=0, will use DefaultChunkSize.
+func NewChunkedBuffer(chunkSize int) *ChunkedBuffer {
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+	return &ChunkedBuffer{chunkSize: chunkSize}
+}
+
+// Write appends p to the buffer, it always returns len(p), nil.
+func (b *ChunkedBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		chunk := b.chunk()
+		m := copy(chunk[len(chunk):cap(chunk)], p)
+		b.chunks[b.cur] = chunk[:len(chunk)+m]
+		p = p[m:]
+	}
+	b.size += n
+	return n, nil
+}
+
+// WriteString appends s to the buffer, it always returns len(s), nil.
+func (b *ChunkedBuffer) WriteString(s string) (int, error) {
+	n := len(s)
+	for len(s) > 0 {
+		chunk := b.chunk()
+		m := copy(chunk[len(chunk):cap(chunk)], s)
+		b.chunks[b.cur] = chunk[:len(chunk)+m]
+		s = s[m:]
+	}
+	b.size += n
+	return n, nil
+}
+
+// WriteByte appends c to the buffer, it always returns nil.
+func (b *ChunkedBuffer) WriteByte(c byte) error {
+	chunk := b.chunk()
+	b.chunks[b.cur] = append(chunk, c)
+	b.size++
+	return nil
+}
+
+// chunk returns the current chunk which has free space.
+func (b *ChunkedBuffer) chunk() []byte {
+	if b.chunkSize <= 0 {
+		b.chunkSize = DefaultChunkSize
+	}
+	if len(b.chunks) == 0 {
+		b.chunks = append(b.chunks, make([]byte, 0, b.chunkSize))
+		b.cur = 0
+	}
+	chunk := b.chunks[b.cur]
+	if len(chunk) < cap(chunk) {
+		return chunk
+	}
+	b.cur++
+	if b.cur == len(b.chunks) {
+		b.chunks = append(b.chunks, make([]byte, 0, b.chunkSize))
+	}
+	return b.chunks[b.cur]
+}
+
+// Len returns the number of written bytes.
+func (b *ChunkedBuffer) Len() int {
+	return b.size
+}
+
+// WriteTo writes all the data to w chunk by chunk, it implements io.WriterTo.
+// The buffer is not drained, call Reset to reuse it.
+func (b *ChunkedBuffer) WriteTo(w io.Writer) (int64, error) {
+	var total int64
+	for _, chunk := range b.chunks {
+		if len(chunk) == 0 {
+			break
+		}
+		n, err := w.Write(chunk)
+		total += int64(n)
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// Bytes returns a contiguous copy of the data.
+func (b *ChunkedBuffer) Bytes() []byte {
+	out := make([]byte, 0, b.size)
+	for _, chunk := range b.chunks {
+		out = append(out, chunk...)
+	}
+	return out
+}
+
+// String returns a contiguous copy of the data as string.
+func (b *ChunkedBuffer) String() string {
+	return BytesToString(b.Bytes())
+}
+
+// Reset resets the buffer to be empty, but it retains the allocated chunks.
+func (b *ChunkedBuffer) Reset() {
+	for i := range b.chunks {
+		b.chunks[i] = b.chunks[i][:0]
+	}
+	b.cur = 0
+	b.size = 0
+}
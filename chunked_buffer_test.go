@@ -0,0 +1,42 @@
+package goutil
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestChunkedBuffer(t *testing.T) {
+	b := NewChunkedBuffer(4)
+	var want bytes.Buffer
+	for i := 0; i < 10; i++ {
+		b.WriteString("hello")
+		b.WriteByte(' ')
+		b.Write([]byte("世界"))
+		want.WriteString("hello 世界")
+	}
+	if b.Len() != want.Len() || b.String() != want.String() {
+		t.Fatalf("unexpected data: %q", b.String())
+	}
+	var out strings.Builder
+	n, err := b.WriteTo(&out)
+	if err != nil || n != int64(want.Len()) || out.String() != want.String() {
+		t.Fatalf("WriteTo: %d, %v, %q", n, err, out.String())
+	}
+	chunks := len(b.chunks)
+	b.Reset()
+	b.WriteString("abc")
+	if b.String() != "abc" || len(b.chunks) != chunks {
+		t.Fatalf("unexpected data after Reset: %q", b.String())
+	}
+}
+
+func TestChunkedBufferZero(t *testing.T) {
+	var b ChunkedBuffer
+	b.Write([]byte("hello "))
+	b.WriteString("world")
+	b.WriteByte('!')
+	if b.String() != "hello world!" || cap(b.chunks[0]) != DefaultChunkSize {
+		t.Fatalf("unexpected data: %q", b.String())
+	}
+}
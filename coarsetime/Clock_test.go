@@ -0,0 +1,55 @@
+package coarsetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClock(t *testing.T) {
+	c := NewClock(5 * time.Millisecond)
+	defer c.Stop()
+	first := c.UnixNano()
+	time.Sleep(50 * time.Millisecond)
+	now := c.Now()
+	if now.UnixNano() <= first {
+		t.Fatal("the clock is not updated")
+	}
+	if d := time.Since(now); d < 0 || d > time.Second {
+		t.Fatalf("the clock lags %v", d)
+	}
+	c.Stop()
+	c.Stop()
+	time.Sleep(20 * time.Millisecond)
+	stopped := c.UnixNano()
+	time.Sleep(20 * time.Millisecond)
+	if c.UnixNano() != stopped {
+		t.Fatal("the stopped clock is updated")
+	}
+}
+
+func TestCoarseNow(t *testing.T) {
+	if d := time.Since(CoarseNow()); d < -time.Second || d > time.Second {
+		t.Fatalf("CoarseNow lags %v", d)
+	}
+	if CoarseUnixNano() <= 0 {
+		t.Fatal("invalid CoarseUnixNano")
+	}
+}
+
+func BenchmarkCoarseNow(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		CoarseNow()
+	}
+}
+
+func TestClockOffset(t *testing.T) {
+	c := NewClock(time.Millisecond)
+	defer c.Stop()
+	c.SetOffset(time.Hour)
+	if c.Offset() != time.Hour {
+		t.Fatalf("got %v", c.Offset())
+	}
+	if d := c.Now().Sub(time.Now()); d < 59*time.Minute || d > 61*time.Minute {
+		t.Fatalf("got %v", d)
+	}
+}
@@ -0,0 +1,135 @@
+package codec
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"sync"
+)
+
+// Compressor compresses and decompresses the bytes.
+type Compressor interface {
+	// Compress appends the compressed src to dst and returns the result.
+	Compress(dst, src []byte) ([]byte, error)
+	// Decompress appends the decompressed src to dst and returns the result.
+	Decompress(dst, src []byte) ([]byte, error)
+}
+
+var (
+	compressorsMu sync.RWMutex
+	compressors   = map[string]Compressor{"gzip": gzipCompressor{}}
+)
+
+// ErrUnknownCompressor is returned when the compressor is not registered.
+var ErrUnknownCompressor = errors.New("codec: unknown compressor")
+
+// RegisterCompressor registers the compressor by name, replacing the existing one.
+// "gzip" is always registered, "snappy" and "zstd" are registered when building
+// with the tags of the same names.
+func RegisterCompressor(name string, c Compressor) {
+	compressorsMu.Lock()
+	compressors[name] = c
+	compressorsMu.Unlock()
+}
+
+// GetCompressor returns the compressor registered by name.
+func GetCompressor(name string) (Compressor, bool) {
+	compressorsMu.RLock()
+	c, ok := compressors[name]
+	compressorsMu.RUnlock()
+	return c, ok
+}
+
+// Compress compresses b with the compressor registered by name.
+func Compress(name string, b []byte) ([]byte, error) {
+	c, ok := GetCompressor(name)
+	if !ok {
+		return nil, ErrUnknownCompressor
+	}
+	return c.Compress(nil, b)
+}
+
+// Decompress decompresses b with the compressor registered by name.
+func Decompress(name string, b []byte) ([]byte, error) {
+	c, ok := GetCompressor(name)
+	if !ok {
+		return nil, ErrUnknownCompressor
+	}
+	return c.Decompress(nil, b)
+}
+
+// gzipWriterPools are indexed by level-gzip.HuffmanOnly.
+var gzipWriterPools [gzip.BestCompression - gzip.HuffmanOnly + 1]sync.Pool
+
+var gzipReaderPool sync.Pool
+
+// GzipBytes compresses b with gzip, using the pooled writers.
+// The level is gzip.DefaultCompression by default.
+func GzipBytes(b []byte, level ...int) ([]byte, error) {
+	lv := gzip.DefaultCompression
+	if len(level) > 0 {
+		lv = level[0]
+	}
+	return gzipAppend(nil, b, lv)
+}
+
+// GunzipBytes decompresses the gzip data, using the pooled readers.
+func GunzipBytes(b []byte) ([]byte, error) {
+	return gunzipAppend(nil, b)
+}
+
+func gzipAppend(dst, src []byte, level int) ([]byte, error) {
+	if level < gzip.HuffmanOnly || level > gzip.BestCompression {
+		return nil, errors.New("codec: invalid gzip level")
+	}
+	buf := bytes.NewBuffer(dst)
+	pool := &gzipWriterPools[level-gzip.HuffmanOnly]
+	zw, _ := pool.Get().(*gzip.Writer)
+	if zw == nil {
+		zw, _ = gzip.NewWriterLevel(buf, level)
+	} else {
+		zw.Reset(buf)
+	}
+	defer pool.Put(zw)
+	if _, err := zw.Write(src); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gunzipAppend(dst, src []byte) ([]byte, error) {
+	r := bytes.NewReader(src)
+	zr, _ := gzipReaderPool.Get().(*gzip.Reader)
+	var err error
+	if zr == nil {
+		zr, err = gzip.NewReader(r)
+	} else {
+		err = zr.Reset(r)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReaderPool.Put(zr)
+	buf := bytes.NewBuffer(dst)
+	if _, err = io.Copy(buf, zr); err != nil {
+		return nil, err
+	}
+	if err = zr.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type gzipCompressor struct{}
+
+func (gzipCompressor) Compress(dst, src []byte) ([]byte, error) {
+	return gzipAppend(dst, src, gzip.DefaultCompression)
+}
+
+func (gzipCompressor) Decompress(dst, src []byte) ([]byte, error) {
+	return gunzipAppend(dst, src)
+}
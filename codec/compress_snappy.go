@@ -0,0 +1,24 @@
+//go:build snappy
+// +build snappy
+
+package codec
+
+import "github.com/golang/snappy"
+
+func init() {
+	RegisterCompressor("snappy", snappyCompressor{})
+}
+
+type snappyCompressor struct{}
+
+func (snappyCompressor) Compress(dst, src []byte) ([]byte, error) {
+	return append(dst, snappy.Encode(nil, src)...), nil
+}
+
+func (snappyCompressor) Decompress(dst, src []byte) ([]byte, error) {
+	b, err := snappy.Decode(nil, src)
+	if err != nil {
+		return nil, err
+	}
+	return append(dst, b...), nil
+}
@@ -0,0 +1,52 @@
+package codec
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+)
+
+func TestGzipBytes(t *testing.T) {
+	data := bytes.Repeat([]byte("goutil compress "), 100)
+	for _, level := range []int{gzip.DefaultCompression, gzip.BestSpeed, gzip.BestCompression} {
+		for i := 0; i < 3; i++ { // reuse the pooled writers and readers
+			z, err := GzipBytes(data, level)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(z) >= len(data) {
+				t.Fatalf("level %d: not compressed", level)
+			}
+			got, err := GunzipBytes(z)
+			if err != nil || !bytes.Equal(got, data) {
+				t.Fatalf("level %d: %v", level, err)
+			}
+		}
+	}
+	if _, err := GzipBytes(data, 100); err == nil {
+		t.Fatal("expect invalid level error")
+	}
+	if _, err := GunzipBytes([]byte("not gzip")); err == nil {
+		t.Fatal("expect invalid gzip error")
+	}
+}
+
+func TestCompress(t *testing.T) {
+	data := []byte("hello hello hello hello")
+	z, err := Compress("gzip", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Decompress("gzip", z)
+	if err != nil || !bytes.Equal(got, data) {
+		t.Fatal(got, err)
+	}
+	if _, err = Compress("lz4", data); err != ErrUnknownCompressor {
+		t.Fatal(err)
+	}
+	c, _ := GetCompressor("gzip")
+	prefixed, err := c.Compress([]byte("prefix"), data)
+	if err != nil || !bytes.HasPrefix(prefixed, []byte("prefix")) {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,39 @@
+//go:build zstd
+// +build zstd
+
+package codec
+
+import (
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+func init() {
+	RegisterCompressor("zstd", zstdCompressor{})
+}
+
+var (
+	zstdEncoderPool = sync.Pool{New: func() interface{} {
+		enc, _ := zstd.NewWriter(nil)
+		return enc
+	}}
+	zstdDecoderPool = sync.Pool{New: func() interface{} {
+		dec, _ := zstd.NewReader(nil)
+		return dec
+	}}
+)
+
+type zstdCompressor struct{}
+
+func (zstdCompressor) Compress(dst, src []byte) ([]byte, error) {
+	enc := zstdEncoderPool.Get().(*zstd.Encoder)
+	defer zstdEncoderPool.Put(enc)
+	return enc.EncodeAll(src, dst), nil
+}
+
+func (zstdCompressor) Decompress(dst, src []byte) ([]byte, error) {
+	dec := zstdDecoderPool.Get().(*zstd.Decoder)
+	defer zstdDecoderPool.Put(dec)
+	return dec.DecodeAll(src, dst)
+}
@@ -0,0 +1,86 @@
+package codec
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/gob"
+	"errors"
+	"io/ioutil"
+)
+
+// GobVersion is the version of the header written by EncodeGob.
+const GobVersion byte = 1
+
+const gobFlagGzip byte = 1 << 0
+
+var gobMagic = [...]byte{'G', 'O', 'B', 0}
+
+var (
+	// ErrGobHeader is returned when the data does not start with the EncodeGob header.
+	ErrGobHeader = errors.New("codec: invalid gob header")
+	// ErrGobVersion is returned when the data is written by a newer version.
+	ErrGobVersion = errors.New("codec: unsupported gob version")
+)
+
+// RegisterGob registers the concrete types of the values for gob,
+// which is required to encode them as interface values.
+func RegisterGob(values ...interface{}) {
+	for _, v := range values {
+		gob.Register(v)
+	}
+}
+
+// EncodeGob encodes v with gob, prefixed with a header recording the version
+// and flags. If compress is true, the gob stream is gzipped.
+func EncodeGob(v interface{}, compress ...bool) ([]byte, error) {
+	var buf bytes.Buffer
+	var flags byte
+	if len(compress) > 0 && compress[0] {
+		flags |= gobFlagGzip
+	}
+	buf.Write(gobMagic[:])
+	buf.WriteByte(GobVersion)
+	buf.WriteByte(flags)
+	if flags&gobFlagGzip == 0 {
+		if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	zw := gzip.NewWriter(&buf)
+	if err := gob.NewEncoder(zw).Encode(v); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeGob decodes the data written by EncodeGob into the value which v points to,
+// detecting the compression from the header.
+func DecodeGob(data []byte, v interface{}) error {
+	n := len(gobMagic)
+	if len(data) < n+2 || !bytes.Equal(data[:n], gobMagic[:]) {
+		return ErrGobHeader
+	}
+	if data[n] == 0 || data[n] > GobVersion {
+		return ErrGobVersion
+	}
+	flags := data[n+1]
+	r := bytes.NewReader(data[n+2:])
+	if flags&gobFlagGzip == 0 {
+		return gob.NewDecoder(r).Decode(v)
+	}
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	if err = gob.NewDecoder(zr).Decode(v); err != nil {
+		return err
+	}
+	// verify the gzip checksum
+	_, err = ioutil.ReadAll(zr)
+	return err
+}
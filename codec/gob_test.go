@@ -0,0 +1,47 @@
+package codec
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type gobPoint struct {
+	X, Y int
+}
+
+func TestGob(t *testing.T) {
+	RegisterGob(gobPoint{})
+	in := map[string]interface{}{
+		"p":    gobPoint{1, 2},
+		"name": strings.Repeat("goutil", 100),
+	}
+	for _, compress := range []bool{false, true} {
+		data, err := EncodeGob(in, compress)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out map[string]interface{}
+		if err = DecodeGob(data, &out); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(in, out) {
+			t.Fatalf("compress=%v: got %v", compress, out)
+		}
+	}
+	plain, _ := EncodeGob(in)
+	zipped, _ := EncodeGob(in, true)
+	if len(zipped) >= len(plain) {
+		t.Fatalf("gzip is not applied: %d >= %d", len(zipped), len(plain))
+	}
+
+	var out map[string]interface{}
+	if err := DecodeGob([]byte("nope"), &out); err != ErrGobHeader {
+		t.Fatalf("expect ErrGobHeader, got %v", err)
+	}
+	newer := append([]byte(nil), plain...)
+	newer[len(gobMagic)] = GobVersion + 1
+	if err := DecodeGob(newer, &out); err != ErrGobVersion {
+		t.Fatalf("expect ErrGobVersion, got %v", err)
+	}
+}
@@ -0,0 +1,752 @@
+// codec package encodes and decodes values in compact wire formats.
+package codec
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// ErrShortBuffer is returned when the MessagePack data ends unexpectedly.
+var ErrShortBuffer = errors.New("codec: unexpected end of msgpack data")
+
+// ErrMaxDepth is returned when the MessagePack arrays and maps nest deeper than MaxDepth.
+var ErrMaxDepth = errors.New("codec: msgpack data exceeds max nesting depth")
+
+// MaxDepth is the max nesting depth of the arrays and maps accepted by Unmarshal.
+// Marshal applies it to the pointers, slices and maps it descends into,
+// so that a self-referential value fails with ErrMaxDepth instead of overflowing the stack.
+const MaxDepth = 1000
+
+// Marshal returns the MessagePack encoding of v.
+// The structs are encoded as maps named by the `json` tags,
+// following the encoding/json rules of '-', 'omitempty' and embedded structs.
+// time.Time is encoded as the timestamp extension type -1.
+func Marshal(v interface{}) ([]byte, error) {
+	e := &encoder{buf: make([]byte, 0, 64)}
+	if err := e.encode(reflect.ValueOf(v)); err != nil {
+		return nil, err
+	}
+	return e.buf, nil
+}
+
+// Unmarshal decodes the MessagePack data into the value which v points to.
+// When decoding into interface{}, it uses nil, bool, int64, uint64, float32, float64,
+// string, []byte, time.Time, []interface{} and map[string]interface{}
+// (or map[interface{}]interface{} if the keys are not all strings).
+func Unmarshal(data []byte, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.New("codec: Unmarshal requires a non-nil pointer")
+	}
+	d := &decoder{data: data}
+	x, err := d.decode()
+	if err != nil {
+		return err
+	}
+	if d.pos != len(d.data) {
+		return fmt.Errorf("codec: %d trailing bytes after msgpack data", len(d.data)-d.pos)
+	}
+	return assign(rv.Elem(), x)
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+type field struct {
+	name      string
+	index     []int
+	omitempty bool
+}
+
+// structFields returns the fields of the struct type named by the json tags.
+func structFields(t reflect.Type) []field {
+	var fields []field
+	var walk func(t reflect.Type, index []int)
+	walk = func(t reflect.Type, index []int) {
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			tag := sf.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			parts := strings.Split(tag, ",")
+			idx := append(append([]int(nil), index...), i)
+			if sf.Anonymous && parts[0] == "" {
+				ft := sf.Type
+				if ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				if ft.Kind() == reflect.Struct {
+					walk(ft, idx)
+					continue
+				}
+			}
+			if sf.PkgPath != "" {
+				continue
+			}
+			f := field{name: parts[0], index: idx}
+			if f.name == "" {
+				f.name = sf.Name
+			}
+			for _, p := range parts[1:] {
+				if p == "omitempty" {
+					f.omitempty = true
+				}
+			}
+			fields = append(fields, f)
+		}
+	}
+	walk(t, nil)
+	return fields
+}
+
+// fieldByIndex is like reflect.Value.FieldByIndex, but returns false for nil embedded pointers,
+// or allocates them if alloc is true.
+func fieldByIndex(v reflect.Value, index []int, alloc bool) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !alloc || !v.CanSet() {
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
+
+type encoder struct {
+	buf   []byte
+	depth int
+}
+
+func (e *encoder) encode(v reflect.Value) error {
+	if !v.IsValid() {
+		e.buf = append(e.buf, 0xc0)
+		return nil
+	}
+	if v.Type() == timeType {
+		e.encodeTime(v.Interface().(time.Time))
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map:
+		if e.depth >= MaxDepth {
+			return ErrMaxDepth
+		}
+		e.depth++
+		defer func() { e.depth-- }()
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			e.buf = append(e.buf, 0xc0)
+			return nil
+		}
+		return e.encode(v.Elem())
+	case reflect.Bool:
+		if v.Bool() {
+			e.buf = append(e.buf, 0xc3)
+		} else {
+			e.buf = append(e.buf, 0xc2)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.encodeInt(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		e.encodeUint(v.Uint())
+	case reflect.Float32:
+		e.buf = append(e.buf, 0xca)
+		e.buf = appendUint32(e.buf, math.Float32bits(float32(v.Float())))
+	case reflect.Float64:
+		e.buf = append(e.buf, 0xcb)
+		e.buf = appendUint64(e.buf, math.Float64bits(v.Float()))
+	case reflect.String:
+		e.encodeString(v.String())
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			e.buf = append(e.buf, 0xc0)
+			return nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			e.encodeBytes(v)
+			return nil
+		}
+		e.encodeLen(v.Len(), 0x90, 15, 0xdc)
+		for i := 0; i < v.Len(); i++ {
+			if err := e.encode(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			e.buf = append(e.buf, 0xc0)
+			return nil
+		}
+		e.encodeLen(v.Len(), 0x80, 15, 0xde)
+		iter := v.MapRange()
+		for iter.Next() {
+			if err := e.encode(iter.Key()); err != nil {
+				return err
+			}
+			if err := e.encode(iter.Value()); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		var values []reflect.Value
+		var names []string
+		for _, f := range structFields(v.Type()) {
+			fv, ok := fieldByIndex(v, f.index, false)
+			if !ok || (f.omitempty && isEmptyValue(fv)) {
+				continue
+			}
+			values = append(values, fv)
+			names = append(names, f.name)
+		}
+		e.encodeLen(len(values), 0x80, 15, 0xde)
+		for i, fv := range values {
+			e.encodeString(names[i])
+			if err := e.encode(fv); err != nil {
+				return err
+			}
+		}
+	default:
+		return errors.New("codec: unsupported type " + v.Type().String())
+	}
+	return nil
+}
+
+func (e *encoder) encodeInt(n int64) {
+	switch {
+	case n >= 0:
+		e.encodeUint(uint64(n))
+	case n >= -32:
+		e.buf = append(e.buf, byte(n))
+	case n >= math.MinInt8:
+		e.buf = append(e.buf, 0xd0, byte(n))
+	case n >= math.MinInt16:
+		e.buf = append(e.buf, 0xd1)
+		e.buf = appendUint16(e.buf, uint16(n))
+	case n >= math.MinInt32:
+		e.buf = append(e.buf, 0xd2)
+		e.buf = appendUint32(e.buf, uint32(n))
+	default:
+		e.buf = append(e.buf, 0xd3)
+		e.buf = appendUint64(e.buf, uint64(n))
+	}
+}
+
+func (e *encoder) encodeUint(n uint64) {
+	switch {
+	case n <= 0x7f:
+		e.buf = append(e.buf, byte(n))
+	case n <= math.MaxUint8:
+		e.buf = append(e.buf, 0xcc, byte(n))
+	case n <= math.MaxUint16:
+		e.buf = append(e.buf, 0xcd)
+		e.buf = appendUint16(e.buf, uint16(n))
+	case n <= math.MaxUint32:
+		e.buf = append(e.buf, 0xce)
+		e.buf = appendUint32(e.buf, uint32(n))
+	default:
+		e.buf = append(e.buf, 0xcf)
+		e.buf = appendUint64(e.buf, n)
+	}
+}
+
+// encodeLen writes the header of array or map, code16 is followed by code32.
+func (e *encoder) encodeLen(n int, fixCode byte, fixMax int, code16 byte) {
+	switch {
+	case n <= fixMax:
+		e.buf = append(e.buf, fixCode|byte(n))
+	case n <= math.MaxUint16:
+		e.buf = append(e.buf, code16)
+		e.buf = appendUint16(e.buf, uint16(n))
+	default:
+		e.buf = append(e.buf, code16+1)
+		e.buf = appendUint32(e.buf, uint32(n))
+	}
+}
+
+func (e *encoder) encodeString(s string) {
+	n := len(s)
+	switch {
+	case n <= 31:
+		e.buf = append(e.buf, 0xa0|byte(n))
+	case n <= math.MaxUint8:
+		e.buf = append(e.buf, 0xd9, byte(n))
+	default:
+		e.encodeLen(n, 0, -1, 0xda)
+	}
+	e.buf = append(e.buf, s...)
+}
+
+func (e *encoder) encodeBytes(v reflect.Value) {
+	n := v.Len()
+	switch {
+	case n <= math.MaxUint8:
+		e.buf = append(e.buf, 0xc4, byte(n))
+	case n <= math.MaxUint16:
+		e.buf = append(e.buf, 0xc5)
+		e.buf = appendUint16(e.buf, uint16(n))
+	default:
+		e.buf = append(e.buf, 0xc6)
+		e.buf = appendUint32(e.buf, uint32(n))
+	}
+	if v.Kind() == reflect.Slice {
+		e.buf = append(e.buf, v.Bytes()...)
+		return
+	}
+	for i := 0; i < n; i++ {
+		e.buf = append(e.buf, byte(v.Index(i).Uint()))
+	}
+}
+
+func (e *encoder) encodeTime(t time.Time) {
+	sec, nsec := t.Unix(), uint32(t.Nanosecond())
+	switch {
+	case sec>>34 == 0 && nsec == 0:
+		e.buf = append(e.buf, 0xd6, 0xff)
+		e.buf = appendUint32(e.buf, uint32(sec))
+	case sec>>34 == 0:
+		e.buf = append(e.buf, 0xd7, 0xff)
+		e.buf = appendUint64(e.buf, uint64(nsec)<<34|uint64(sec))
+	default:
+		e.buf = append(e.buf, 0xc7, 12, 0xff)
+		e.buf = appendUint32(e.buf, nsec)
+		e.buf = appendUint64(e.buf, uint64(sec))
+	}
+}
+
+type decoder struct {
+	data  []byte
+	pos   int
+	depth int
+}
+
+func (d *decoder) read(n int) ([]byte, error) {
+	if n < 0 || len(d.data)-d.pos < n {
+		return nil, ErrShortBuffer
+	}
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+func (d *decoder) readUint(size int) (uint64, error) {
+	b, err := d.read(size)
+	if err != nil {
+		return 0, err
+	}
+	switch size {
+	case 1:
+		return uint64(b[0]), nil
+	case 2:
+		return uint64(binary.BigEndian.Uint16(b)), nil
+	case 4:
+		return uint64(binary.BigEndian.Uint32(b)), nil
+	}
+	return binary.BigEndian.Uint64(b), nil
+}
+
+// readLen reads a length of the given size, and checks it against the remaining data
+// before converting it to int, which may be 32-bit.
+func (d *decoder) readLen(size int) (int, error) {
+	n, err := d.readUint(size)
+	if err != nil {
+		return 0, err
+	}
+	if n > uint64(len(d.data)-d.pos) {
+		return 0, ErrShortBuffer
+	}
+	return int(n), nil
+}
+
+func (d *decoder) decode() (interface{}, error) {
+	b, err := d.read(1)
+	if err != nil {
+		return nil, err
+	}
+	c := b[0]
+	switch {
+	case c <= 0x7f:
+		return int64(c), nil
+	case c >= 0xe0:
+		return int64(int8(c)), nil
+	case c&0xf0 == 0x80:
+		return d.decodeMap(int(c & 0x0f))
+	case c&0xf0 == 0x90:
+		return d.decodeArray(int(c & 0x0f))
+	case c&0xe0 == 0xa0:
+		return d.decodeString(int(c & 0x1f))
+	}
+	switch c {
+	case 0xc0:
+		return nil, nil
+	case 0xc2:
+		return false, nil
+	case 0xc3:
+		return true, nil
+	case 0xc4, 0xc5, 0xc6:
+		n, err := d.readLen(1 << (c - 0xc4))
+		if err != nil {
+			return nil, err
+		}
+		b, err := d.read(n)
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte(nil), b...), nil
+	case 0xc7, 0xc8, 0xc9:
+		n, err := d.readLen(1 << (c - 0xc7))
+		if err != nil {
+			return nil, err
+		}
+		return d.decodeExt(n)
+	case 0xca:
+		n, err := d.readUint(4)
+		return math.Float32frombits(uint32(n)), err
+	case 0xcb:
+		n, err := d.readUint(8)
+		return math.Float64frombits(n), err
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		return d.readUint(1 << (c - 0xcc))
+	case 0xd0, 0xd1, 0xd2, 0xd3:
+		size := 1 << (c - 0xd0)
+		n, err := d.readUint(size)
+		if err != nil {
+			return nil, err
+		}
+		shift := uint(64 - size*8)
+		return int64(n<<shift) >> shift, nil
+	case 0xd4, 0xd5, 0xd6, 0xd7, 0xd8:
+		return d.decodeExt(1 << (c - 0xd4))
+	case 0xd9, 0xda, 0xdb:
+		n, err := d.readLen(1 << (c - 0xd9))
+		if err != nil {
+			return nil, err
+		}
+		return d.decodeString(n)
+	case 0xdc, 0xdd:
+		n, err := d.readLen(2 << (c - 0xdc))
+		if err != nil {
+			return nil, err
+		}
+		return d.decodeArray(n)
+	case 0xde, 0xdf:
+		n, err := d.readLen(2 << (c - 0xde))
+		if err != nil {
+			return nil, err
+		}
+		return d.decodeMap(n)
+	}
+	return nil, fmt.Errorf("codec: invalid msgpack code 0x%x", c)
+}
+
+func (d *decoder) decodeString(n int) (interface{}, error) {
+	b, err := d.read(n)
+	return string(b), err
+}
+
+// enter increases the nesting depth, and the returned func restores it.
+func (d *decoder) enter() (func(), error) {
+	if d.depth >= MaxDepth {
+		return nil, ErrMaxDepth
+	}
+	d.depth++
+	return func() { d.depth-- }, nil
+}
+
+func (d *decoder) decodeArray(n int) (interface{}, error) {
+	if n > len(d.data)-d.pos {
+		return nil, ErrShortBuffer
+	}
+	leave, err := d.enter()
+	if err != nil {
+		return nil, err
+	}
+	defer leave()
+	a := make([]interface{}, n)
+	for i := range a {
+		x, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+		a[i] = x
+	}
+	return a, nil
+}
+
+func (d *decoder) decodeMap(n int) (interface{}, error) {
+	if n > len(d.data)-d.pos {
+		return nil, ErrShortBuffer
+	}
+	leave, err := d.enter()
+	if err != nil {
+		return nil, err
+	}
+	defer leave()
+	m := make(map[string]interface{}, n)
+	var im map[interface{}]interface{}
+	for i := 0; i < n; i++ {
+		k, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+		v, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+		if b, ok := k.([]byte); ok {
+			k = string(b)
+		}
+		if s, ok := k.(string); ok && im == nil {
+			m[s] = v
+			continue
+		}
+		if im == nil {
+			im = make(map[interface{}]interface{}, n)
+			for mk, mv := range m {
+				im[mk] = mv
+			}
+		}
+		if k != nil && !reflect.TypeOf(k).Comparable() {
+			return nil, fmt.Errorf("codec: unhashable msgpack map key %T", k)
+		}
+		im[k] = v
+	}
+	if im != nil {
+		return im, nil
+	}
+	return m, nil
+}
+
+func (d *decoder) decodeExt(n int) (interface{}, error) {
+	typ, err := d.read(1)
+	if err != nil {
+		return nil, err
+	}
+	b, err := d.read(n)
+	if err != nil {
+		return nil, err
+	}
+	if int8(typ[0]) != -1 {
+		return nil, fmt.Errorf("codec: unsupported msgpack extension type %d", int8(typ[0]))
+	}
+	switch n {
+	case 4:
+		return time.Unix(int64(binary.BigEndian.Uint32(b)), 0), nil
+	case 8:
+		x := binary.BigEndian.Uint64(b)
+		return time.Unix(int64(x&(1<<34-1)), int64(x>>34)), nil
+	case 12:
+		return time.Unix(int64(binary.BigEndian.Uint64(b[4:])), int64(binary.BigEndian.Uint32(b))), nil
+	}
+	return nil, fmt.Errorf("codec: invalid msgpack timestamp length %d", n)
+}
+
+// assign sets the decoded generic value x into v.
+func assign(v reflect.Value, x interface{}) error {
+	if x == nil {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+	xv := reflect.ValueOf(x)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return assign(v.Elem(), x)
+	}
+	if v.Kind() == reflect.Interface && v.NumMethod() == 0 {
+		v.Set(xv)
+		return nil
+	}
+	if v.Type() == timeType {
+		if t, ok := x.(time.Time); ok {
+			v.Set(reflect.ValueOf(t))
+			return nil
+		}
+		return typeError(x, v)
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		b, ok := x.(bool)
+		if !ok {
+			return typeError(x, v)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		switch i := x.(type) {
+		case int64:
+			n = i
+		case uint64:
+			if i > math.MaxInt64 {
+				return typeError(x, v)
+			}
+			n = int64(i)
+		default:
+			return typeError(x, v)
+		}
+		if v.OverflowInt(n) {
+			return typeError(x, v)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var n uint64
+		switch i := x.(type) {
+		case uint64:
+			n = i
+		case int64:
+			if i < 0 {
+				return typeError(x, v)
+			}
+			n = uint64(i)
+		default:
+			return typeError(x, v)
+		}
+		if v.OverflowUint(n) {
+			return typeError(x, v)
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		switch f := x.(type) {
+		case float64:
+			v.SetFloat(f)
+		case float32:
+			v.SetFloat(float64(f))
+		case int64:
+			v.SetFloat(float64(f))
+		case uint64:
+			v.SetFloat(float64(f))
+		default:
+			return typeError(x, v)
+		}
+	case reflect.String:
+		switch s := x.(type) {
+		case string:
+			v.SetString(s)
+		case []byte:
+			v.SetString(string(s))
+		default:
+			return typeError(x, v)
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			switch b := x.(type) {
+			case []byte:
+				v.SetBytes(b)
+				return nil
+			case string:
+				v.SetBytes([]byte(b))
+				return nil
+			}
+		}
+		a, ok := x.([]interface{})
+		if !ok {
+			return typeError(x, v)
+		}
+		s := reflect.MakeSlice(v.Type(), len(a), len(a))
+		for i, e := range a {
+			if err := assign(s.Index(i), e); err != nil {
+				return err
+			}
+		}
+		v.Set(s)
+	case reflect.Array:
+		if b, ok := x.([]byte); ok && v.Type().Elem().Kind() == reflect.Uint8 {
+			reflect.Copy(v, reflect.ValueOf(b))
+			return nil
+		}
+		a, ok := x.([]interface{})
+		if !ok || len(a) > v.Len() {
+			return typeError(x, v)
+		}
+		for i, e := range a {
+			if err := assign(v.Index(i), e); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if xv.Kind() != reflect.Map {
+			return typeError(x, v)
+		}
+		m := reflect.MakeMapWithSize(v.Type(), xv.Len())
+		iter := xv.MapRange()
+		for iter.Next() {
+			k := reflect.New(v.Type().Key()).Elem()
+			if err := assign(k, iter.Key().Interface()); err != nil {
+				return err
+			}
+			e := reflect.New(v.Type().Elem()).Elem()
+			if err := assign(e, iter.Value().Interface()); err != nil {
+				return err
+			}
+			m.SetMapIndex(k, e)
+		}
+		v.Set(m)
+	case reflect.Struct:
+		m, ok := x.(map[string]interface{})
+		if !ok {
+			return typeError(x, v)
+		}
+		for _, f := range structFields(v.Type()) {
+			e, ok := m[f.name]
+			if !ok {
+				continue
+			}
+			fv, ok := fieldByIndex(v, f.index, true)
+			if !ok {
+				continue
+			}
+			if err := assign(fv, e); err != nil {
+				return fmt.Errorf("codec: field %q: %v", f.name, err)
+			}
+		}
+	default:
+		return typeError(x, v)
+	}
+	return nil
+}
+
+func typeError(x interface{}, v reflect.Value) error {
+	return fmt.Errorf("codec: cannot unmarshal %T into %s", x, v.Type())
+}
+
+func appendUint16(b []byte, n uint16) []byte {
+	return append(b, byte(n>>8), byte(n))
+}
+
+func appendUint32(b []byte, n uint32) []byte {
+	return append(b, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+}
+
+func appendUint64(b []byte, n uint64) []byte {
+	return appendUint32(appendUint32(b, uint32(n>>32)), uint32(n))
+}
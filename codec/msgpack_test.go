@@ -0,0 +1,146 @@
+package codec
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type Base struct {
+	ID uint32 `json:"id"`
+}
+
+type Item struct {
+	Base
+	Name   string            `json:"name"`
+	Price  float64           `json:"price,omitempty"`
+	Tags   []string          `json:"tags"`
+	Attrs  map[string]int64  `json:"attrs"`
+	Raw    []byte            `json:"raw"`
+	At     time.Time         `json:"at"`
+	Next   *Item             `json:"next,omitempty"`
+	Any    interface{}       `json:"any"`
+	Skip   string            `json:"-"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+func TestMarshalEncoding(t *testing.T) {
+	for _, c := range []struct {
+		in     interface{}
+		expect []byte
+	}{
+		{nil, []byte{0xc0}},
+		{true, []byte{0xc3}},
+		{1, []byte{0x01}},
+		{-1, []byte{0xff}},
+		{-33, []byte{0xd0, 0xdf}},
+		{200, []byte{0xcc, 0xc8}},
+		{70000, []byte{0xce, 0x00, 0x01, 0x11, 0x70}},
+		{1.5, []byte{0xcb, 0x3f, 0xf8, 0, 0, 0, 0, 0, 0}},
+		{"abc", []byte{0xa3, 'a', 'b', 'c'}},
+		{[]byte{1, 2}, []byte{0xc4, 0x02, 0x01, 0x02}},
+		{[]int{1, 2}, []byte{0x92, 0x01, 0x02}},
+		{map[string]bool{"a": true}, []byte{0x81, 0xa1, 'a', 0xc3}},
+		{time.Unix(1, 0), []byte{0xd6, 0xff, 0, 0, 0, 1}},
+	} {
+		got, err := Marshal(c.in)
+		if err != nil || !bytes.Equal(got, c.expect) {
+			t.Errorf("Marshal(%#v) = %x, %v; expect %x", c.in, got, err, c.expect)
+		}
+	}
+	if _, err := Marshal(make(chan int)); err == nil {
+		t.Error("expect unsupported type error")
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	in := Item{
+		Base:  Base{ID: 7},
+		Name:  "widget",
+		Price: 9.99,
+		Tags:  []string{"a", "b"},
+		Attrs: map[string]int64{"x": -300, "y": 1 << 40},
+		Raw:   bytes.Repeat([]byte{0xab}, 300),
+		At:    time.Date(2018, 1, 2, 3, 4, 5, 6, time.UTC),
+		Next:  &Item{Name: "child", At: time.Date(2600, 1, 1, 0, 0, 0, 1, time.UTC)},
+		Any:   []interface{}{int64(1), "two", map[string]interface{}{"k": nil}},
+		Skip:  "skip",
+	}
+	data, err := Marshal(&in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out Item
+	if err = Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	in.Skip = ""
+	if !out.At.Equal(in.At) || !out.Next.At.Equal(in.Next.At) {
+		t.Fatalf("time mismatch: %v %v", out.At, out.Next.At)
+	}
+	out.At, out.Next.At = in.At, in.Next.At
+	if !reflect.DeepEqual(in, out) {
+		t.Fatalf("got %+v\nexpect %+v", out, in)
+	}
+
+	var generic interface{}
+	if err = Unmarshal(data, &generic); err != nil {
+		t.Fatal(err)
+	}
+	if m := generic.(map[string]interface{}); m["id"] != int64(7) || m["name"] != "widget" {
+		t.Fatalf("generic: %v", m)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	var n int8
+	if err := Unmarshal([]byte{0xcd, 0x01, 0x00}, &n); err == nil {
+		t.Error("expect overflow error")
+	}
+	var s string
+	if err := Unmarshal([]byte{0xa3, 'a'}, &s); err != ErrShortBuffer {
+		t.Errorf("expect ErrShortBuffer, got %v", err)
+	}
+	if err := Unmarshal([]byte{0x01, 0x02}, &n); err == nil {
+		t.Error("expect trailing bytes error")
+	}
+	if err := Unmarshal([]byte{0x01}, n); err == nil {
+		t.Error("expect non-pointer error")
+	}
+	var x interface{}
+	nested := append(bytes.Repeat([]byte{0x91}, MaxDepth), 0xc0)
+	if err := Unmarshal(nested, &x); err != nil {
+		t.Errorf("depth %d: %v", MaxDepth, err)
+	}
+	nested = append(bytes.Repeat([]byte{0x81, 0xa1, 'k'}, MaxDepth+1), 0xc0)
+	if err := Unmarshal(nested, &x); err != ErrMaxDepth {
+		t.Errorf("expect ErrMaxDepth, got %v", err)
+	}
+	if _, err := Marshal(x); err != nil {
+		t.Errorf("Marshal depth %d: %v", MaxDepth, err)
+	}
+	// 32-bit array length beyond the data, which is negative as int on 32-bit platforms
+	if err := Unmarshal([]byte{0xdd, 0xff, 0xff, 0xff, 0xff}, &x); err != ErrShortBuffer {
+		t.Errorf("expect ErrShortBuffer, got %v", err)
+	}
+	if err := Unmarshal([]byte{0xdb, 0xff, 0xff, 0xff, 0xff}, &x); err != ErrShortBuffer {
+		t.Errorf("expect ErrShortBuffer, got %v", err)
+	}
+}
+
+func TestMarshalCycle(t *testing.T) {
+	type node struct {
+		Next *node
+	}
+	n := &node{}
+	n.Next = n
+	if _, err := Marshal(n); err != ErrMaxDepth {
+		t.Errorf("expect ErrMaxDepth, got %v", err)
+	}
+	m := map[string]interface{}{}
+	m["self"] = m
+	if _, err := Marshal(m); err != ErrMaxDepth {
+		t.Errorf("expect ErrMaxDepth, got %v", err)
+	}
+}
@@ -0,0 +1,132 @@
+package goutil
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+)
+
+// CommandOptions are the options of RunCommandWith.
+type CommandOptions struct {
+	// Dir is the working directory, the current one by default.
+	Dir string
+	// Env are the "KEY=value" variables added to the environment of the current process.
+	Env []string
+	// Stdin is piped to the standard input.
+	Stdin io.Reader
+	// Timeout kills the whole process group if it runs longer, 0 means no timeout.
+	Timeout time.Duration
+	// OnOutput is called serially with each line (without the newline) of stdout and stderr.
+	OnOutput func(line []byte, stderr bool)
+}
+
+// CommandResult is the result of the command.
+type CommandResult struct {
+	Stdout   []byte
+	Stderr   []byte
+	ExitCode int
+	Duration time.Duration
+}
+
+// RunCommand runs the command and captures its output,
+// the whole process group is killed when the ctx is done.
+func RunCommand(ctx context.Context, name string, args ...string) (*CommandResult, error) {
+	return RunCommandWith(ctx, nil, name, args...)
+}
+
+// RunCommandWith runs the command with the options and captures its output.
+// The result is always returned, with the exit code -1 if it didn't exit normally.
+// The error is *exec.ExitError for the non-zero exit code, or the ctx error if it is killed
+// because the ctx is done or timed out. opts may be nil.
+func RunCommandWith(ctx context.Context, opts *CommandOptions, name string, args ...string) (*CommandResult, error) {
+	if opts == nil {
+		opts = new(CommandOptions)
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+	cmd := exec.Command(name, args...)
+	cmd.Dir = opts.Dir
+	if len(opts.Env) > 0 {
+		cmd.Env = append(os.Environ(), opts.Env...)
+	}
+	cmd.Stdin = opts.Stdin
+	setProcessGroup(cmd)
+
+	var stdout, stderr bytes.Buffer
+	var wg sync.WaitGroup
+	var closers []io.Closer
+	if opts.OnOutput == nil {
+		cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	} else {
+		var mu sync.Mutex
+		for _, s := range []struct {
+			buf    *bytes.Buffer
+			dst    *io.Writer
+			stderr bool
+		}{{&stdout, &cmd.Stdout, false}, {&stderr, &cmd.Stderr, true}} {
+			pr, pw := io.Pipe()
+			*s.dst = pw
+			closers = append(closers, pw)
+			wg.Add(1)
+			go func(buf *bytes.Buffer, isStderr bool) {
+				defer wg.Done()
+				r := bufio.NewReader(pr)
+				for {
+					line, err := r.ReadBytes('\n')
+					if len(line) > 0 {
+						mu.Lock()
+						buf.Write(line)
+						opts.OnOutput(bytes.TrimRight(line, "\r\n"), isStderr)
+						mu.Unlock()
+					}
+					if err != nil {
+						pr.CloseWithError(err)
+						return
+					}
+				}
+			}(s.buf, s.stderr)
+		}
+	}
+
+	res := &CommandResult{ExitCode: -1}
+	start := time.Now()
+	if err := cmd.Start(); err != nil {
+		for _, c := range closers {
+			c.Close()
+		}
+		wg.Wait()
+		return res, err
+	}
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			killProcessGroup(cmd)
+		case <-done:
+		}
+	}()
+	err := cmd.Wait()
+	close(done)
+	for _, c := range closers {
+		c.Close()
+	}
+	wg.Wait()
+	res.Duration = time.Since(start)
+	res.Stdout, res.Stderr = stdout.Bytes(), stderr.Bytes()
+	// the ctx may be done while Wait is returning, before the process group is killed
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return res, ctxErr
+	}
+	if cmd.ProcessState != nil && cmd.ProcessState.Exited() {
+		res.ExitCode = cmd.ProcessState.ExitCode()
+	}
+	return res, err
+}
@@ -0,0 +1,57 @@
+package goutil
+
+import (
+	"context"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRunCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+	ctx := context.Background()
+	res, err := RunCommand(ctx, "sh", "-c", "echo out; echo err >&2; exit 3")
+	if _, ok := err.(*exec.ExitError); !ok {
+		t.Fatalf("expect exit error, got %v", err)
+	}
+	if string(res.Stdout) != "out\n" || string(res.Stderr) != "err\n" || res.ExitCode != 3 {
+		t.Fatalf("got %+v", res)
+	}
+
+	var mu sync.Mutex
+	var lines []string
+	res, err = RunCommandWith(ctx, &CommandOptions{
+		Env:   []string{"GOUTIL_CMD=injected"},
+		Stdin: strings.NewReader("piped\n"),
+		OnOutput: func(line []byte, stderr bool) {
+			mu.Lock()
+			lines = append(lines, string(line))
+			mu.Unlock()
+		},
+	}, "sh", "-c", "echo $GOUTIL_CMD; cat; echo e >&2")
+	if err != nil || res.ExitCode != 0 {
+		t.Fatal(res, err)
+	}
+	if string(res.Stdout) != "injected\npiped\n" || len(lines) != 3 {
+		t.Fatalf("got %q %q", res.Stdout, lines)
+	}
+
+	// the grandchild holding the pipe is killed with the group
+	start := time.Now()
+	res, err = RunCommandWith(ctx, &CommandOptions{Timeout: 100 * time.Millisecond}, "sh", "-c", "sleep 10 & sleep 10")
+	if err != context.DeadlineExceeded || res.ExitCode != -1 {
+		t.Fatalf("expect deadline, got %v %+v", err, res)
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Fatalf("took %v", d)
+	}
+
+	if _, err = RunCommand(ctx, "goutil-not-exist-command"); err == nil {
+		t.Fatal("expect start error")
+	}
+}
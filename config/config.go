@@ -0,0 +1,345 @@
+// config package loads the configuration into structs from the defaults,
+// JSON/YAML/TOML files and environment variables.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/henrylee2cn/goutil"
+)
+
+// Source provides a layer of the configuration.
+type Source interface {
+	// Load returns the configuration as a nested map,
+	// target is the pointer to the config struct.
+	Load(target interface{}) (map[string]interface{}, error)
+}
+
+// SourceFunc is an adapter to use the function as a Source.
+type SourceFunc func(target interface{}) (map[string]interface{}, error)
+
+// Load calls f(target).
+func (f SourceFunc) Load(target interface{}) (map[string]interface{}, error) {
+	return f(target)
+}
+
+// Decoder decodes the file content into a nested map.
+type Decoder func(data []byte) (map[string]interface{}, error)
+
+var (
+	decoders = map[string]Decoder{
+		".json": decodeJSON,
+		".yaml": parseYAML,
+		".yml":  parseYAML,
+		".toml": parseTOML,
+	}
+	decodersLock sync.RWMutex
+)
+
+// RegisterDecoder registers the decoder of the file extension, e.g. ".ini".
+func RegisterDecoder(ext string, dec Decoder) {
+	decodersLock.Lock()
+	decoders[strings.ToLower(ext)] = dec
+	decodersLock.Unlock()
+}
+
+func decodeJSON(data []byte) (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&m); err != nil {
+		return nil, fmt.Errorf("config: json: %v", err)
+	}
+	return m, nil
+}
+
+// DecodeFile decodes the file by the decoder registered for its extension.
+func DecodeFile(path string) (map[string]interface{}, error) {
+	ext := strings.ToLower(filepath.Ext(path))
+	decodersLock.RLock()
+	dec, ok := decoders[ext]
+	decodersLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("config: unsupported file format %q", ext)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	m, err := dec(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return m, nil
+}
+
+// File is the source of the file decoded by its extension.
+func File(path string) Source {
+	return SourceFunc(func(interface{}) (map[string]interface{}, error) {
+		return DecodeFile(path)
+	})
+}
+
+// OptionalFile is like File, but the missing file is ignored.
+func OptionalFile(path string) Source {
+	return SourceFunc(func(interface{}) (map[string]interface{}, error) {
+		m, err := DecodeFile(path)
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return m, err
+	})
+}
+
+// Map is the source of the literal configuration.
+func Map(m map[string]interface{}) Source {
+	return SourceFunc(func(interface{}) (map[string]interface{}, error) {
+		return m, nil
+	})
+}
+
+// Env is the source of the environment variables named by the prefix and the key path,
+// upper-cased and joined by '_', e.g. APP_DB_HOST for the field db.host with the prefix "APP".
+// The values of the slice fields are separated by commas.
+func Env(prefix string) Source {
+	return SourceFunc(func(target interface{}) (map[string]interface{}, error) {
+		t, err := structType(target)
+		if err != nil {
+			return nil, err
+		}
+		return envMap(t, prefix, nil), nil
+	})
+}
+
+// Load loads the configuration into the struct which ptr points to,
+// later sources override earlier ones, and the `default` tag values are the lowest layer.
+// The fields are named by the json tags, the nested maps are merged deeply,
+// and the values are coerced into the field types (e.g. "8080" to int, "5s" to time.Duration).
+// Finally, the fields with `required:"true"` tag must not be zero.
+func Load(ptr interface{}, sources ...Source) error {
+	t, err := structType(ptr)
+	if err != nil {
+		return err
+	}
+	layers := []map[string]interface{}{defaultsMap(t, nil)}
+	for _, src := range sources {
+		m, err := src.Load(ptr)
+		if err != nil {
+			return err
+		}
+		if m != nil {
+			layers = append(layers, m)
+		}
+	}
+	merged := goutil.MergeMapsDeep(goutil.MergeReplaceArrays, layers...)
+	if err = goutil.Map2Struct(merged, ptr); err != nil {
+		return fmt.Errorf("config: %v", strings.TrimPrefix(err.Error(), "goutil: "))
+	}
+	return Validate(ptr)
+}
+
+// Validate checks the fields with `required:"true"` tag are not zero.
+func Validate(ptr interface{}) error {
+	rv := reflect.ValueOf(ptr)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return errors.New("config: nil config")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return errors.New("config: config must be a struct")
+	}
+	var missing []string
+	checkRequired(rv, "", &missing)
+	if len(missing) > 0 {
+		return fmt.Errorf("config: missing required fields: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func structType(ptr interface{}) (reflect.Type, error) {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return nil, errors.New("config: target must be a non-nil pointer to struct")
+	}
+	return rv.Elem().Type(), nil
+}
+
+// structField is an exported field of the config struct.
+type structField struct {
+	reflect.StructField
+	name string
+	// nested is the struct type of the field (or the pointer field), nil for the leaf.
+	nested reflect.Type
+}
+
+// structFields returns the fields named by the json tags, flattening the untagged embedded structs.
+func structFields(t reflect.Type) []structField {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("json")
+		name := tag
+		if j := strings.IndexByte(tag, ','); j >= 0 {
+			name = tag[:j]
+		}
+		if name == "-" {
+			continue
+		}
+		ft := sf.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if sf.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			for _, sub := range structFields(ft) {
+				sub.Index = append([]int{i}, sub.Index...)
+				fields = append(fields, sub)
+			}
+			continue
+		}
+		if sf.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		f := structField{StructField: sf, name: name}
+		if ft.Kind() == reflect.Struct && ft != timeType {
+			f.nested = ft
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+func containsType(path []reflect.Type, t reflect.Type) bool {
+	for _, p := range path {
+		if p == t {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultsMap collects the `default` tag values, path guards the recursive types.
+func defaultsMap(t reflect.Type, path []reflect.Type) map[string]interface{} {
+	m := make(map[string]interface{})
+	path = append(path, t)
+	for _, f := range structFields(t) {
+		if f.nested != nil {
+			if !containsType(path, f.nested) {
+				if sub := defaultsMap(f.nested, path); len(sub) > 0 {
+					m[f.name] = sub
+				}
+			}
+			continue
+		}
+		if def, ok := f.Tag.Lookup("default"); ok {
+			m[f.name] = tagValue(f.Type, def)
+		}
+	}
+	return m
+}
+
+// tagValue returns the string value, or its comma separated parts for the slice type.
+func tagValue(t reflect.Type, s string) interface{} {
+	if t.Kind() != reflect.Slice || t.Elem().Kind() == reflect.Uint8 {
+		return s
+	}
+	if s == "" {
+		return []interface{}{}
+	}
+	parts := strings.Split(s, ",")
+	a := make([]interface{}, len(parts))
+	for i, p := range parts {
+		a[i] = strings.TrimSpace(p)
+	}
+	return a
+}
+
+func envName(prefix, name string) string {
+	name = strings.ToUpper(strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, name))
+	if prefix == "" {
+		return name
+	}
+	return prefix + "_" + name
+}
+
+func envMap(t reflect.Type, prefix string, path []reflect.Type) map[string]interface{} {
+	m := make(map[string]interface{})
+	path = append(path, t)
+	for _, f := range structFields(t) {
+		name := envName(prefix, f.name)
+		if f.nested != nil {
+			if !containsType(path, f.nested) {
+				if sub := envMap(f.nested, name, path); len(sub) > 0 {
+					m[f.name] = sub
+				}
+			}
+			continue
+		}
+		if v, ok := os.LookupEnv(name); ok {
+			m[f.name] = tagValue(f.Type, v)
+		}
+	}
+	return m
+}
+
+func checkRequired(rv reflect.Value, prefix string, missing *[]string) {
+	for _, f := range structFields(rv.Type()) {
+		fv, ok := fieldByIndex(rv, f.Index)
+		if !ok {
+			continue
+		}
+		name := f.name
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+		if f.Tag.Get("required") == "true" && fv.IsZero() {
+			*missing = append(*missing, name)
+			continue
+		}
+		if f.nested == nil {
+			continue
+		}
+		if fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+		checkRequired(fv, name, missing)
+	}
+}
+
+// fieldByIndex is like reflect.Value.FieldByIndex, but reports false at the nil embedded pointer.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
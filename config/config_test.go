@@ -0,0 +1,116 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testDB struct {
+	Host    string        `json:"host" default:"localhost"`
+	Port    int           `json:"port" default:"5432"`
+	Timeout time.Duration `json:"timeout" default:"3s"`
+	User    string        `json:"user" required:"true"`
+}
+
+type testConfig struct {
+	Name  string   `json:"name" required:"true"`
+	Debug bool     `json:"debug"`
+	Tags  []string `json:"tags" default:"a, b"`
+	DB    testDB   `json:"db"`
+	Cache *testDB  `json:"cache"`
+}
+
+func writeTestFile(t *testing.T, dir, name, content string) string {
+	p := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goutil_config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	jsonFile := writeTestFile(t, dir, "app.json", `{"name": "from-json", "db": {"port": 6432, "user": "json"}}`)
+	yamlFile := writeTestFile(t, dir, "app.yaml", "debug: true\ndb:\n  host: yaml-host\n")
+	tomlFile := writeTestFile(t, dir, "app.toml", "tags = [\"x\"]\n[cache]\nhost = \"redis\"\nuser = \"r\"\n")
+
+	os.Setenv("GOUTIL_TEST_DB_USER", "env-user")
+	os.Setenv("GOUTIL_TEST_DB_TIMEOUT", "10s")
+	defer os.Unsetenv("GOUTIL_TEST_DB_USER")
+	defer os.Unsetenv("GOUTIL_TEST_DB_TIMEOUT")
+
+	var cfg testConfig
+	err = Load(&cfg,
+		File(jsonFile),
+		File(yamlFile),
+		File(tomlFile),
+		OptionalFile(filepath.Join(dir, "missing.yaml")),
+		Env("GOUTIL_TEST"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := testConfig{
+		Name:  "from-json",
+		Debug: true,
+		Tags:  []string{"x"},
+		DB:    testDB{Host: "yaml-host", Port: 6432, Timeout: 10 * time.Second, User: "env-user"},
+		Cache: &testDB{Host: "redis", Port: 5432, Timeout: 3 * time.Second, User: "r"},
+	}
+	if !reflect.DeepEqual(cfg, expect) {
+		t.Fatalf("got %+v %+v\nexpect %+v %+v", cfg, cfg.Cache, expect, expect.Cache)
+	}
+
+	var cfg2 testConfig
+	err = Load(&cfg2, Map(map[string]interface{}{"db": map[string]interface{}{"port": "x"}}))
+	if err == nil || !strings.HasPrefix(err.Error(), "config: ") {
+		t.Fatalf("expect coerce error, got %v", err)
+	}
+	err = Load(&cfg2)
+	if err == nil || !strings.Contains(err.Error(), "name, db.user") {
+		t.Fatalf("expect required error, got %v", err)
+	}
+	if cfg2.Tags[1] != "b" || cfg2.DB.Port != 5432 {
+		t.Fatalf("defaults not applied: %+v", cfg2)
+	}
+	if err = Load(&cfg2, File(filepath.Join(dir, "missing.yaml"))); !os.IsNotExist(err) {
+		t.Fatalf("expect not exist, got %v", err)
+	}
+	if err = Load(&cfg2, File(writeTestFile(t, dir, "app.ini", ""))); err == nil {
+		t.Fatal("expect unsupported format")
+	}
+	if err = Load(cfg2); err == nil {
+		t.Fatal("expect non-pointer error")
+	}
+}
+
+func TestLoadYAMLScalars(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goutil_config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	var cfg struct {
+		Version string  `json:"version"`
+		Zip     string  `json:"zip"`
+		Port    int     `json:"port"`
+		Ratio   float64 `json:"ratio"`
+		Mode    int     `json:"mode"`
+	}
+	yamlFile := writeTestFile(t, dir, "app.yaml", "version: 1.10\nzip: 01234\nport: 08080\nratio: 1.10\nmode: 0o755\n")
+	if err = Load(&cfg, File(yamlFile)); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Version != "1.10" || cfg.Zip != "01234" || cfg.Port != 8080 || cfg.Ratio != 1.1 || cfg.Mode != 0755 {
+		t.Fatalf("got %+v", cfg)
+	}
+}
@@ -0,0 +1,230 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// LoadDotEnv sets the environment variables from the .env files (".env" by default),
+// the existing variables are not overridden, and neither are the ones set by the earlier files.
+// Call it before ParseEnv or Load with the Env source.
+func LoadDotEnv(paths ...string) error {
+	return loadDotEnv(false, paths)
+}
+
+// OverloadDotEnv is like LoadDotEnv, but overrides the existing variables,
+// so the later files take precedence.
+func OverloadDotEnv(paths ...string) error {
+	return loadDotEnv(true, paths)
+}
+
+func loadDotEnv(overload bool, paths []string) error {
+	if len(paths) == 0 {
+		paths = []string{".env"}
+	}
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		vars, keys, err := parseDotEnv(string(data), func(name string) (string, bool) {
+			return os.LookupEnv(name)
+		}, overload)
+		if err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+		for _, k := range keys {
+			if _, ok := os.LookupEnv(k); ok && !overload {
+				continue
+			}
+			if err = os.Setenv(k, vars[k]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ParseDotEnv parses the .env content without changing the environment.
+// The lines are like `[export] KEY=VALUE`, where the value may be single-quoted (literal),
+// double-quoted (with escapes), or unquoted (with the trailing comment stripped),
+// and the quoted values may span multiple lines.
+// $NAME, ${NAME} and ${NAME:-default} in the double-quoted and unquoted values are expanded
+// by the earlier keys of the content, then by the environment.
+func ParseDotEnv(data []byte) (map[string]string, error) {
+	vars, _, err := parseDotEnv(string(data), os.LookupEnv, true)
+	return vars, err
+}
+
+// parseDotEnv returns the variables and the keys in order.
+// If overload is false, the expansion prefers the environment to the earlier keys,
+// as the environment wins when loading.
+func parseDotEnv(s string, env func(string) (string, bool), overload bool) (map[string]string, []string, error) {
+	vars := make(map[string]string)
+	var keys []string
+	lookup := func(name string) (string, bool) {
+		if !overload {
+			if v, ok := env(name); ok {
+				return v, true
+			}
+		}
+		if v, ok := vars[name]; ok {
+			return v, true
+		}
+		return env(name)
+	}
+	line := 1
+	for pos := 0; pos < len(s); {
+		// the beginning of a line
+		end := strings.IndexByte(s[pos:], '\n')
+		if end < 0 {
+			end = len(s)
+		} else {
+			end += pos
+		}
+		text := strings.TrimSpace(s[pos:end])
+		if text == "" || text[0] == '#' {
+			pos = end + 1
+			line++
+			continue
+		}
+		eq := strings.IndexByte(s[pos:end], '=')
+		if eq < 0 {
+			return nil, nil, fmt.Errorf("line %d: expected '='", line)
+		}
+		key := strings.TrimSpace(s[pos : pos+eq])
+		if strings.HasPrefix(key, "export ") || strings.HasPrefix(key, "export\t") {
+			key = strings.TrimSpace(key[len("export"):])
+		}
+		if !isEnvKey(key) {
+			return nil, nil, fmt.Errorf("line %d: invalid key %q", line, key)
+		}
+		pos += eq + 1
+		for pos < len(s) && (s[pos] == ' ' || s[pos] == '\t') {
+			pos++
+		}
+		var value string
+		if pos < len(s) && (s[pos] == '"' || s[pos] == '\'') {
+			q := s[pos]
+			closing := -1
+			for i := pos + 1; i < len(s); i++ {
+				if q == '"' && s[i] == '\\' {
+					i++
+					continue
+				}
+				if s[i] == q {
+					closing = i
+					break
+				}
+			}
+			if closing < 0 {
+				return nil, nil, fmt.Errorf("line %d: unterminated quoted value", line)
+			}
+			raw := s[pos+1 : closing]
+			line += strings.Count(raw, "\n")
+			if q == '\'' {
+				value = raw
+			} else {
+				value = expandDotEnv(raw, true, lookup)
+			}
+			pos = closing + 1
+			end = strings.IndexByte(s[pos:], '\n')
+			if end < 0 {
+				end = len(s)
+			} else {
+				end += pos
+			}
+			if rest := strings.TrimSpace(s[pos:end]); rest != "" && rest[0] != '#' {
+				return nil, nil, fmt.Errorf("line %d: unexpected %q after the quoted value", line, rest)
+			}
+		} else {
+			raw := strings.TrimRight(s[pos:end], "\r")
+			for i := 0; i < len(raw); i++ {
+				if raw[i] == '#' && (i == 0 || raw[i-1] == ' ' || raw[i-1] == '\t') {
+					raw = raw[:i]
+					break
+				}
+			}
+			value = expandDotEnv(strings.TrimSpace(raw), false, lookup)
+		}
+		if _, ok := vars[key]; !ok {
+			keys = append(keys, key)
+		}
+		vars[key] = value
+		pos = end + 1
+		line++
+	}
+	return vars, keys, nil
+}
+
+func isEnvKey(key string) bool {
+	if key == "" || key[0] >= '0' && key[0] <= '9' {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '.') {
+			return false
+		}
+	}
+	return true
+}
+
+// expandDotEnv expands the variables, and the escapes if unescape is true.
+func expandDotEnv(s string, unescape bool, lookup func(string) (string, bool)) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case unescape && c == '\\' && i+1 < len(s):
+			i++
+			switch s[i] {
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case '"', '\\', '$':
+				b.WriteByte(s[i])
+			default:
+				b.WriteByte('\\')
+				b.WriteByte(s[i])
+			}
+		case c == '$' && i+1 < len(s) && s[i+1] == '{':
+			end := strings.IndexByte(s[i+2:], '}')
+			if end < 0 {
+				b.WriteString(s[i:])
+				return b.String()
+			}
+			expr := s[i+2 : i+2+end]
+			name, def, hasDef := expr, "", false
+			if j := strings.Index(expr, ":-"); j >= 0 {
+				name, def, hasDef = expr[:j], expr[j+2:], true
+			}
+			v, _ := lookup(name)
+			if v == "" && hasDef {
+				v = def
+			}
+			b.WriteString(v)
+			i += end + 2
+		case c == '$' && i+1 < len(s) && isEnvNameStart(s[i+1]):
+			j := i + 1
+			for j < len(s) && (isEnvNameStart(s[j]) || s[j] >= '0' && s[j] <= '9') {
+				j++
+			}
+			v, _ := lookup(s[i+1 : j])
+			b.WriteString(v)
+			i = j - 1
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+func isEnvNameStart(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+}
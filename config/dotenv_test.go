@@ -0,0 +1,84 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseDotEnv(t *testing.T) {
+	os.Setenv("GOUTIL_DOTENV_HOME", "/home/u")
+	defer os.Unsetenv("GOUTIL_DOTENV_HOME")
+	src := `
+# comment
+export NAME=demo app # trailing
+EMPTY=
+HOST = localhost
+URL=http://${HOST}:${PORT:-8080}/$NAME
+DIR="$GOUTIL_DOTENV_HOME/data"
+LITERAL='$HOST \n # kept'
+ESCAPED="a\tb \"q\" \$HOST\nline"
+MULTI="first
+second"
+HASH=a#b
+`
+	m, err := ParseDotEnv([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := map[string]string{
+		"NAME":    "demo app",
+		"EMPTY":   "",
+		"HOST":    "localhost",
+		"URL":     "http://localhost:8080/demo app",
+		"DIR":     "/home/u/data",
+		"LITERAL": `$HOST \n # kept`,
+		"ESCAPED": "a\tb \"q\" $HOST\nline",
+		"MULTI":   "first\nsecond",
+		"HASH":    "a#b",
+	}
+	if !reflect.DeepEqual(m, expect) {
+		t.Fatalf("got %q", m)
+	}
+	for _, bad := range []string{"NOEQ", "1A=1", `A="x`, `A="x" y`} {
+		if _, err = ParseDotEnv([]byte(bad)); err == nil {
+			t.Errorf("expect error for %q", bad)
+		}
+	}
+}
+
+func TestLoadDotEnv(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goutil_dotenv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	a := filepath.Join(dir, "a.env")
+	b := filepath.Join(dir, "b.env")
+	ioutil.WriteFile(a, []byte("GOUTIL_DE_X=a\nGOUTIL_DE_Y=$GOUTIL_DE_X-y\n"), 0644)
+	ioutil.WriteFile(b, []byte("GOUTIL_DE_X=b\nGOUTIL_DE_Z=z\n"), 0644)
+	os.Setenv("GOUTIL_DE_X", "env")
+	defer func() {
+		for _, k := range []string{"GOUTIL_DE_X", "GOUTIL_DE_Y", "GOUTIL_DE_Z"} {
+			os.Unsetenv(k)
+		}
+	}()
+
+	if err = LoadDotEnv(a, b); err != nil {
+		t.Fatal(err)
+	}
+	if x, y, z := os.Getenv("GOUTIL_DE_X"), os.Getenv("GOUTIL_DE_Y"), os.Getenv("GOUTIL_DE_Z"); x != "env" || y != "env-y" || z != "z" {
+		t.Fatalf("load: %s %s %s", x, y, z)
+	}
+	if err = OverloadDotEnv(a, b); err != nil {
+		t.Fatal(err)
+	}
+	if x, y := os.Getenv("GOUTIL_DE_X"), os.Getenv("GOUTIL_DE_Y"); x != "b" || y != "a-y" {
+		t.Fatalf("overload: %s %s", x, y)
+	}
+	if err = LoadDotEnv(filepath.Join(dir, "missing")); !os.IsNotExist(err) {
+		t.Fatalf("expect not exist, got %v", err)
+	}
+}
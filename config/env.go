@@ -0,0 +1,94 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/henrylee2cn/goutil"
+)
+
+type envTag struct {
+	name       string
+	def        string
+	hasDefault bool
+	required   bool
+}
+
+// parseEnvTag parses `env:"NAME,default=...,required"`,
+// the default value may contain commas.
+func parseEnvTag(tag string) envTag {
+	parts := strings.Split(tag, ",")
+	t := envTag{name: strings.TrimSpace(parts[0])}
+	inDefault := false
+	for _, p := range parts[1:] {
+		switch {
+		case p == "required":
+			t.required, inDefault = true, false
+		case strings.HasPrefix(p, "default="):
+			t.def, t.hasDefault, inDefault = p[len("default="):], true, true
+		case inDefault:
+			t.def += "," + p
+		}
+	}
+	return t
+}
+
+// ParseEnv fills the struct which ptr points to with the environment variables named by the `env` tags,
+// e.g. `env:"PORT,default=8080,required"`, the fields without the tag are untouched.
+// The values are coerced into the field types like Load, and those of the slices are separated by commas.
+// The `env` tag of a nested struct field is the prefix of its fields, joined by '_'.
+// The required variables must be set unless they have the defaults.
+func ParseEnv(ptr interface{}) error {
+	t, err := structType(ptr)
+	if err != nil {
+		return err
+	}
+	var missing []string
+	m := envTagMap(t, "", nil, &missing)
+	if len(missing) > 0 {
+		return fmt.Errorf("config: missing required environment variables: %s", strings.Join(missing, ", "))
+	}
+	if err = goutil.Map2Struct(m, ptr); err != nil {
+		return fmt.Errorf("config: %v", strings.TrimPrefix(err.Error(), "goutil: "))
+	}
+	return nil
+}
+
+func envTagMap(t reflect.Type, prefix string, path []reflect.Type, missing *[]string) map[string]interface{} {
+	m := make(map[string]interface{})
+	path = append(path, t)
+	for _, f := range structFields(t) {
+		tag := parseEnvTag(f.Tag.Get("env"))
+		if f.nested != nil {
+			if containsType(path, f.nested) {
+				continue
+			}
+			sub := prefix
+			if tag.name != "" {
+				sub += tag.name + "_"
+			}
+			if sm := envTagMap(f.nested, sub, path, missing); len(sm) > 0 {
+				m[f.name] = sm
+			}
+			continue
+		}
+		if tag.name == "" {
+			continue
+		}
+		name := prefix + tag.name
+		v, ok := os.LookupEnv(name)
+		if !ok {
+			if !tag.hasDefault {
+				if tag.required {
+					*missing = append(*missing, name)
+				}
+				continue
+			}
+			v = tag.def
+		}
+		m[f.name] = tagValue(f.Type, v)
+	}
+	return m
+}
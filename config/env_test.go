@@ -0,0 +1,64 @@
+package config
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseEnv(t *testing.T) {
+	type db struct {
+		Host string `env:"HOST,default=localhost"`
+		Port int    `env:"PORT,required"`
+	}
+	type cfg struct {
+		Name    string        `env:"APP_NAME"`
+		Hosts   []string      `env:"HOSTS,default=a,b,required"`
+		Timeout time.Duration `env:"TIMEOUT,default=5s"`
+		Debug   bool          `env:"DEBUG"`
+		Kept    string
+		DB      db  `env:"DB"`
+		Cache   *db `env:"CACHE"`
+	}
+	for k, v := range map[string]string{
+		"APP_NAME":   "demo",
+		"DEBUG":      "true",
+		"DB_PORT":    "5432",
+		"CACHE_PORT": "6379",
+		"CACHE_HOST": "redis",
+	} {
+		os.Setenv(k, v)
+		defer os.Unsetenv(k)
+	}
+	c := cfg{Kept: "kept"}
+	if err := ParseEnv(&c); err != nil {
+		t.Fatal(err)
+	}
+	expect := cfg{
+		Name:    "demo",
+		Hosts:   []string{"a", "b"},
+		Timeout: 5 * time.Second,
+		Debug:   true,
+		Kept:    "kept",
+		DB:      db{Host: "localhost", Port: 5432},
+		Cache:   &db{Host: "redis", Port: 6379},
+	}
+	if !reflect.DeepEqual(c, expect) {
+		t.Fatalf("got %+v %+v\nexpect %+v %+v", c, c.Cache, expect, expect.Cache)
+	}
+
+	os.Unsetenv("DB_PORT")
+	os.Unsetenv("CACHE_PORT")
+	err := ParseEnv(&c)
+	if err == nil || !strings.Contains(err.Error(), "DB_PORT, CACHE_PORT") {
+		t.Fatalf("expect missing error, got %v", err)
+	}
+	os.Setenv("DB_PORT", "x")
+	os.Setenv("CACHE_PORT", "1")
+	defer os.Unsetenv("DB_PORT")
+	if err = ParseEnv(&c); err == nil {
+		t.Fatal("expect invalid int error")
+	}
+}
@@ -0,0 +1,512 @@
+package config
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// parseTOML parses the TOML v1.0 document.
+// The offset date-times, the local date-times and dates are decoded as time.Time
+// (the local ones in time.Local), and the local times as strings.
+func parseTOML(data []byte) (map[string]interface{}, error) {
+	p := &tomlParser{s: string(data), line: 1}
+	root := make(map[string]interface{})
+	cur := root
+	for {
+		p.skip(true)
+		if p.pos >= len(p.s) {
+			return root, nil
+		}
+		var err error
+		switch {
+		case strings.HasPrefix(p.s[p.pos:], "[["):
+			p.pos += 2
+			var keys []string
+			if keys, err = p.parseKey(); err != nil {
+				return nil, err
+			}
+			if !p.consume("]]") {
+				return nil, p.errorf("expected ']]'")
+			}
+			if cur, err = tomlArrayTable(root, keys); err != nil {
+				return nil, p.errorf("%v", err)
+			}
+		case p.s[p.pos] == '[':
+			p.pos++
+			var keys []string
+			if keys, err = p.parseKey(); err != nil {
+				return nil, err
+			}
+			if !p.consume("]") {
+				return nil, p.errorf("expected ']'")
+			}
+			if cur, err = tomlTable(root, keys); err != nil {
+				return nil, p.errorf("%v", err)
+			}
+		default:
+			if err = p.parseKeyValue(cur); err != nil {
+				return nil, err
+			}
+		}
+		p.skip(false)
+		if p.pos < len(p.s) && p.s[p.pos] != '\n' && !strings.HasPrefix(p.s[p.pos:], "\r\n") {
+			return nil, p.errorf("expected the end of line")
+		}
+	}
+}
+
+type tomlParser struct {
+	s    string
+	pos  int
+	line int
+}
+
+func (p *tomlParser) errorf(format string, a ...interface{}) error {
+	return fmt.Errorf("config: toml line %d: %s", p.line, fmt.Sprintf(format, a...))
+}
+
+// skip skips the spaces and the comment, and the newlines if multiline is true.
+func (p *tomlParser) skip(multiline bool) {
+	for p.pos < len(p.s) {
+		switch p.s[p.pos] {
+		case ' ', '\t':
+			p.pos++
+		case '\r':
+			if !multiline {
+				return
+			}
+			p.pos++
+		case '\n':
+			if !multiline {
+				return
+			}
+			p.line++
+			p.pos++
+		case '#':
+			for p.pos < len(p.s) && p.s[p.pos] != '\n' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (p *tomlParser) skipSpaces() {
+	for p.pos < len(p.s) && (p.s[p.pos] == ' ' || p.s[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *tomlParser) consume(s string) bool {
+	p.skipSpaces()
+	if strings.HasPrefix(p.s[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *tomlParser) parseKeyValue(m map[string]interface{}) error {
+	keys, err := p.parseKey()
+	if err != nil {
+		return err
+	}
+	if !p.consume("=") {
+		return p.errorf("expected '='")
+	}
+	p.skipSpaces()
+	v, err := p.parseValue()
+	if err != nil {
+		return err
+	}
+	if err = tomlSet(m, keys, v); err != nil {
+		return p.errorf("%v", err)
+	}
+	return nil
+}
+
+func (p *tomlParser) parseKey() ([]string, error) {
+	var keys []string
+	for {
+		p.skipSpaces()
+		if p.pos >= len(p.s) {
+			return nil, p.errorf("expected a key")
+		}
+		var key string
+		var err error
+		switch p.s[p.pos] {
+		case '"':
+			key, err = p.parseBasicString()
+		case '\'':
+			key, err = p.parseLiteralString()
+		default:
+			start := p.pos
+			for p.pos < len(p.s) && isTOMLBareKeyChar(p.s[p.pos]) {
+				p.pos++
+			}
+			if p.pos == start {
+				return nil, p.errorf("invalid key character %q", p.s[p.pos])
+			}
+			key = p.s[start:p.pos]
+		}
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+		p.skipSpaces()
+		if p.pos < len(p.s) && p.s[p.pos] == '.' {
+			p.pos++
+			continue
+		}
+		return keys, nil
+	}
+}
+
+func isTOMLBareKeyChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-'
+}
+
+func (p *tomlParser) parseValue() (interface{}, error) {
+	if p.pos >= len(p.s) {
+		return nil, p.errorf("expected a value")
+	}
+	rest := p.s[p.pos:]
+	switch {
+	case strings.HasPrefix(rest, `"""`):
+		return p.parseMultilineString(`"""`)
+	case strings.HasPrefix(rest, "'''"):
+		return p.parseMultilineString("'''")
+	case rest[0] == '"':
+		return p.parseBasicString()
+	case rest[0] == '\'':
+		return p.parseLiteralString()
+	case rest[0] == '[':
+		return p.parseArray()
+	case rest[0] == '{':
+		return p.parseInlineTable()
+	case strings.HasPrefix(rest, "true"):
+		p.pos += 4
+		return true, nil
+	case strings.HasPrefix(rest, "false"):
+		p.pos += 5
+		return false, nil
+	}
+	start := p.pos
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n,]}#", p.s[p.pos]) < 0 {
+		p.pos++
+	}
+	// a date-time may separate the date and time by a space
+	if p.pos-start == 10 && p.pos+1 < len(p.s) && p.s[p.pos] == ' ' && p.s[p.pos+1] >= '0' && p.s[p.pos+1] <= '9' {
+		p.pos++
+		for p.pos < len(p.s) && strings.IndexByte(" \t\r\n,]}#", p.s[p.pos]) < 0 {
+			p.pos++
+		}
+	}
+	v, err := parseTOMLScalar(p.s[start:p.pos])
+	if err != nil {
+		return nil, p.errorf("%v", err)
+	}
+	return v, nil
+}
+
+func parseTOMLScalar(tok string) (interface{}, error) {
+	switch tok {
+	case "inf", "+inf":
+		return math.Inf(1), nil
+	case "-inf":
+		return math.Inf(-1), nil
+	case "nan", "+nan", "-nan":
+		return math.NaN(), nil
+	case "":
+		return nil, fmt.Errorf("expected a value")
+	}
+	if len(tok) >= 8 && (tok[2] == ':' || len(tok) >= 10 && tok[4] == '-' && tok[7] == '-') {
+		return parseTOMLDateTime(tok)
+	}
+	clean := strings.Replace(tok, "_", "", -1)
+	body := strings.TrimLeft(clean, "+-")
+	if strings.HasPrefix(body, "0x") || strings.HasPrefix(body, "0o") || strings.HasPrefix(body, "0b") {
+		i, err := strconv.ParseInt(body, 0, 64)
+		if err == nil && strings.HasPrefix(clean, "-") {
+			i = -i
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer %s", tok)
+		}
+		return i, nil
+	}
+	if strings.ContainsAny(clean, ".eE") {
+		f, err := strconv.ParseFloat(clean, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid float %s", tok)
+		}
+		return f, nil
+	}
+	i, err := strconv.ParseInt(clean, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %s", tok)
+	}
+	return i, nil
+}
+
+func parseTOMLDateTime(tok string) (interface{}, error) {
+	if tok[2] == ':' {
+		if _, err := time.Parse("15:04:05.999999999", tok); err != nil {
+			return nil, fmt.Errorf("invalid time %s", tok)
+		}
+		return tok, nil
+	}
+	s := tok
+	if len(s) > 10 && (s[10] == ' ' || s[10] == 't') {
+		s = s[:10] + "T" + s[11:]
+	}
+	if t, err := time.Parse(time.RFC3339Nano, strings.Replace(s, "z", "Z", 1)); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{"2006-01-02T15:04:05.999999999", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid date-time %s", tok)
+}
+
+func (p *tomlParser) parseArray() (interface{}, error) {
+	p.pos++
+	arr := make([]interface{}, 0)
+	for {
+		p.skip(true)
+		if p.pos >= len(p.s) {
+			return nil, p.errorf("unterminated array")
+		}
+		if p.s[p.pos] == ']' {
+			p.pos++
+			return arr, nil
+		}
+		v, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		arr = append(arr, v)
+		p.skip(true)
+		if p.pos < len(p.s) && p.s[p.pos] == ',' {
+			p.pos++
+		} else if p.pos >= len(p.s) || p.s[p.pos] != ']' {
+			return nil, p.errorf("expected ',' or ']' in array")
+		}
+	}
+}
+
+func (p *tomlParser) parseInlineTable() (interface{}, error) {
+	p.pos++
+	m := make(map[string]interface{})
+	p.skipSpaces()
+	if p.pos < len(p.s) && p.s[p.pos] == '}' {
+		p.pos++
+		return m, nil
+	}
+	for {
+		if err := p.parseKeyValue(m); err != nil {
+			return nil, err
+		}
+		p.skipSpaces()
+		if p.pos >= len(p.s) {
+			return nil, p.errorf("unterminated inline table")
+		}
+		switch p.s[p.pos] {
+		case ',':
+			p.pos++
+		case '}':
+			p.pos++
+			return m, nil
+		default:
+			return nil, p.errorf("expected ',' or '}' in inline table")
+		}
+	}
+}
+
+func (p *tomlParser) parseLiteralString() (string, error) {
+	end := strings.IndexAny(p.s[p.pos+1:], "'\n")
+	if end < 0 || p.s[p.pos+1+end] != '\'' {
+		return "", p.errorf("unterminated string")
+	}
+	s := p.s[p.pos+1 : p.pos+1+end]
+	p.pos += end + 2
+	return s, nil
+}
+
+func (p *tomlParser) parseBasicString() (string, error) {
+	var b strings.Builder
+	for i := p.pos + 1; i < len(p.s); i++ {
+		switch c := p.s[i]; c {
+		case '"':
+			p.pos = i + 1
+			return b.String(), nil
+		case '\n':
+			return "", p.errorf("unterminated string")
+		case '\\':
+			n, err := p.unescape(&b, i)
+			if err != nil {
+				return "", err
+			}
+			i += n
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+func (p *tomlParser) parseMultilineString(delim string) (string, error) {
+	i := p.pos + 3
+	if strings.HasPrefix(p.s[i:], "\r\n") {
+		i += 2
+	} else if strings.HasPrefix(p.s[i:], "\n") {
+		i++
+	}
+	if i > p.pos+3 {
+		p.line++
+	}
+	var b strings.Builder
+	for i < len(p.s) {
+		if strings.HasPrefix(p.s[i:], delim) {
+			// up to two quotes are allowed right before the delimiter
+			for k := 0; k < 2 && strings.HasPrefix(p.s[i+1:], delim); k++ {
+				b.WriteByte(delim[0])
+				i++
+			}
+			p.pos = i + 3
+			return b.String(), nil
+		}
+		c := p.s[i]
+		if c == '\n' {
+			p.line++
+		}
+		if c == '\\' && delim == `"""` {
+			// a line ending backslash trims the following whitespace
+			j := i + 1
+			for j < len(p.s) && (p.s[j] == ' ' || p.s[j] == '\t' || p.s[j] == '\r') {
+				j++
+			}
+			if j < len(p.s) && p.s[j] == '\n' {
+				for j < len(p.s) && strings.IndexByte(" \t\r\n", p.s[j]) >= 0 {
+					if p.s[j] == '\n' {
+						p.line++
+					}
+					j++
+				}
+				i = j
+				continue
+			}
+			n, err := p.unescape(&b, i)
+			if err != nil {
+				return "", err
+			}
+			i += n + 1
+			continue
+		}
+		b.WriteByte(c)
+		i++
+	}
+	return "", p.errorf("unterminated multi-line string")
+}
+
+// unescape writes the escape sequence at s[i] and returns the length after the backslash.
+func (p *tomlParser) unescape(b *strings.Builder, i int) (int, error) {
+	if i+1 >= len(p.s) {
+		return 0, p.errorf("invalid escape")
+	}
+	switch c := p.s[i+1]; c {
+	case 'b':
+		b.WriteByte('\b')
+	case 't':
+		b.WriteByte('\t')
+	case 'n':
+		b.WriteByte('\n')
+	case 'f':
+		b.WriteByte('\f')
+	case 'r':
+		b.WriteByte('\r')
+	case '"', '\\':
+		b.WriteByte(c)
+	case 'u', 'U':
+		n := 4
+		if c == 'U' {
+			n = 8
+		}
+		if i+2+n > len(p.s) {
+			return 0, p.errorf("invalid unicode escape")
+		}
+		r, err := strconv.ParseUint(p.s[i+2:i+2+n], 16, 32)
+		if err != nil || !utf8.ValidRune(rune(r)) {
+			return 0, p.errorf("invalid unicode escape")
+		}
+		b.WriteRune(rune(r))
+		return n + 1, nil
+	default:
+		return 0, p.errorf("invalid escape \\%c", c)
+	}
+	return 1, nil
+}
+
+func tomlTable(root map[string]interface{}, keys []string) (map[string]interface{}, error) {
+	m := root
+	for _, k := range keys {
+		switch x := m[k].(type) {
+		case nil:
+			t := make(map[string]interface{})
+			m[k] = t
+			m = t
+		case map[string]interface{}:
+			m = x
+		case []interface{}:
+			if len(x) == 0 {
+				return nil, fmt.Errorf("key %q is not a table", k)
+			}
+			t, ok := x[len(x)-1].(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("key %q is not a table", k)
+			}
+			m = t
+		default:
+			return nil, fmt.Errorf("key %q is not a table", k)
+		}
+	}
+	return m, nil
+}
+
+func tomlArrayTable(root map[string]interface{}, keys []string) (map[string]interface{}, error) {
+	parent, err := tomlTable(root, keys[:len(keys)-1])
+	if err != nil {
+		return nil, err
+	}
+	k := keys[len(keys)-1]
+	t := make(map[string]interface{})
+	switch x := parent[k].(type) {
+	case nil:
+		parent[k] = []interface{}{t}
+	case []interface{}:
+		parent[k] = append(x, t)
+	default:
+		return nil, fmt.Errorf("key %q is not an array of tables", k)
+	}
+	return t, nil
+}
+
+func tomlSet(m map[string]interface{}, keys []string, v interface{}) error {
+	m, err := tomlTable(m, keys[:len(keys)-1])
+	if err != nil {
+		return err
+	}
+	k := keys[len(keys)-1]
+	if _, ok := m[k]; ok {
+		return fmt.Errorf("duplicate key %q", k)
+	}
+	m[k] = v
+	return nil
+}
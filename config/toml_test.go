@@ -0,0 +1,104 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseTOML(t *testing.T) {
+	src := `
+# comment
+title = "TOML \"example\" \u00e9"
+literal = 'C:\path'
+count = 1_000
+hex = 0xff
+neg = -17
+pi = 3.14
+exp = 5e+2
+on = true
+born = 1979-05-27T07:32:00Z
+day = 1979-05-27
+at = 07:32:00
+site."google.com" = true
+multi = """
+one \
+  two
+"quoted" """
+raw = '''
+a\b'''
+ports = [ 8001, 8002,
+  8003, # comment
+]
+point = { x = 1, y = { z = "deep" } }
+
+[database]
+server = "192.168.1.1"
+enabled = false
+
+[servers.alpha]
+ip = "10.0.0.1"
+
+[[products]]
+name = "Hammer"
+
+[[products]]
+name = "Nail"
+sku = 284758393
+
+[products.meta]
+color = "gray"
+`
+	m, err := parseTOML([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := map[string]interface{}{
+		"title":   "TOML \"example\" \u00e9",
+		"literal": `C:\path`,
+		"count":   int64(1000),
+		"hex":     int64(255),
+		"neg":     int64(-17),
+		"pi":      3.14,
+		"exp":     500.0,
+		"on":      true,
+		"born":    time.Date(1979, 5, 27, 7, 32, 0, 0, time.UTC),
+		"day":     time.Date(1979, 5, 27, 0, 0, 0, 0, time.Local),
+		"at":      "07:32:00",
+		"site":    map[string]interface{}{"google.com": true},
+		"multi":   "one two\n\"quoted\" ",
+		"raw":     `a\b`,
+		"ports":   []interface{}{int64(8001), int64(8002), int64(8003)},
+		"point":   map[string]interface{}{"x": int64(1), "y": map[string]interface{}{"z": "deep"}},
+		"database": map[string]interface{}{
+			"server":  "192.168.1.1",
+			"enabled": false,
+		},
+		"servers": map[string]interface{}{"alpha": map[string]interface{}{"ip": "10.0.0.1"}},
+		"products": []interface{}{
+			map[string]interface{}{"name": "Hammer"},
+			map[string]interface{}{"name": "Nail", "sku": int64(284758393), "meta": map[string]interface{}{"color": "gray"}},
+		},
+	}
+	for k, v := range expect {
+		got := m[k]
+		if tv, ok := v.(time.Time); ok {
+			if gt, ok := got.(time.Time); !ok || !gt.Equal(tv) {
+				t.Errorf("%s: got %#v, expect %v", k, got, v)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(got, v) {
+			t.Errorf("%s: got %#v, expect %#v", k, got, v)
+		}
+	}
+	if len(m) != len(expect) {
+		t.Errorf("got %d keys, expect %d", len(m), len(expect))
+	}
+
+	for _, bad := range []string{"a = 1\na = 2", "a = ", "a = \"x", "[t\nb = 1", "a = 1 b = 2", "a = [1 2]", "a = 1\n[a]"} {
+		if _, err = parseTOML([]byte(bad)); err == nil {
+			t.Errorf("expect error for %q", bad)
+		}
+	}
+}
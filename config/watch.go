@@ -0,0 +1,122 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/henrylee2cn/goutil"
+	"github.com/henrylee2cn/goutil/graceful"
+)
+
+// WatchDebounce is the debounce window of the file changes for Watch.
+var WatchDebounce = 100 * time.Millisecond
+
+// Watcher reloads the configuration when the file changes or on SIGHUP.
+type Watcher struct {
+	typ         reflect.Type
+	sources     []Source
+	current     atomic.Value
+	fw          *goutil.Watcher
+	mu          sync.Mutex
+	subscribers []func(old, new interface{})
+	onError     func(error)
+	removeHook  func()
+	closed      bool
+}
+
+// Watch loads the configuration into ptr from the file and the extra sources like Load,
+// then reloads it into a new struct of the same type when the file changes or SIGHUP arrives
+// (see graceful.AddReloadHook).
+// The reloaded configuration is validated, atomically swapped as the Current pointer,
+// and passed to onChange (may be nil) and the subscribers;
+// if it fails, the current one is kept and the error is passed to the OnError handler.
+func Watch(ptr interface{}, path string, onChange func(old, new interface{}), sources ...Source) (*Watcher, error) {
+	t, err := structType(ptr)
+	if err != nil {
+		return nil, err
+	}
+	w := &Watcher{
+		typ:     t,
+		sources: append([]Source{File(path)}, sources...),
+	}
+	if onChange != nil {
+		w.subscribers = append(w.subscribers, onChange)
+	}
+	if err = Load(ptr, w.sources...); err != nil {
+		return nil, err
+	}
+	w.current.Store(ptr)
+	w.fw, err = goutil.WatchPath(path, WatchDebounce, func(events []goutil.WatchEvent) {
+		if err := w.Reload(); err != nil {
+			w.mu.Lock()
+			fn := w.onError
+			w.mu.Unlock()
+			if fn != nil {
+				fn(err)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	w.removeHook = graceful.AddReloadHook(w.Reload)
+	return w, nil
+}
+
+// Current returns the pointer to the current configuration, which should be treated as read-only.
+func (w *Watcher) Current() interface{} {
+	return w.current.Load()
+}
+
+// Subscribe adds the function called with the old and new configurations after each reload,
+// which may call the methods of the Watcher.
+func (w *Watcher) Subscribe(fn func(old, new interface{})) {
+	w.mu.Lock()
+	w.subscribers = append(w.subscribers, fn)
+	w.mu.Unlock()
+}
+
+// OnError sets the handler of the reload errors caused by the file changes.
+func (w *Watcher) OnError(fn func(error)) {
+	w.mu.Lock()
+	w.onError = fn
+	w.mu.Unlock()
+}
+
+// Reload loads the configuration again and swaps it if it is valid.
+func (w *Watcher) Reload() error {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return nil
+	}
+	ptr := reflect.New(w.typ).Interface()
+	if err := Load(ptr, w.sources...); err != nil {
+		w.mu.Unlock()
+		return fmt.Errorf("config: reload: %v", err)
+	}
+	old := w.current.Load()
+	w.current.Store(ptr)
+	subscribers := append(([]func(old, new interface{}))(nil), w.subscribers...)
+	w.mu.Unlock()
+	for _, fn := range subscribers {
+		fn(old, ptr)
+	}
+	return nil
+}
+
+// Close stops watching and removes the reload hook.
+func (w *Watcher) Close() error {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return nil
+	}
+	w.closed = true
+	w.mu.Unlock()
+	w.removeHook()
+	return w.fw.Close()
+}
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goutil_config_watch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	type cfg struct {
+		Name string `json:"name" required:"true"`
+		Port int    `json:"port" default:"80"`
+	}
+	path := writeTestFile(t, dir, "app.yaml", "name: v1\n")
+
+	changes := make(chan *cfg, 10)
+	errs := make(chan error, 10)
+	var c cfg
+	w, err := Watch(&c, path, func(old, new interface{}) {
+		if old.(*cfg).Name == new.(*cfg).Name {
+			t.Errorf("unexpected change %v -> %v", old, new)
+		}
+		changes <- new.(*cfg)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	w.OnError(func(err error) { errs <- err })
+	if c.Name != "v1" || c.Port != 80 || w.Current().(*cfg) != &c {
+		t.Fatalf("initial: %+v", c)
+	}
+
+	writeTestFile(t, dir, "app.yaml.tmp", "name: v2\nport: 8080\n")
+	os.Rename(filepath.Join(dir, "app.yaml.tmp"), path)
+	select {
+	case n := <-changes:
+		if n.Name != "v2" || n.Port != 8080 || w.Current().(*cfg) != n {
+			t.Fatalf("reloaded: %+v", n)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("timeout waiting for reload")
+	}
+	if c.Name != "v1" {
+		t.Fatal("the initial config should not be modified")
+	}
+
+	writeTestFile(t, dir, "app.yaml", "port: 1\n")
+	select {
+	case err = <-errs:
+		if err == nil {
+			t.Fatal("expect validation error")
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("timeout waiting for error")
+	}
+	if w.Current().(*cfg).Name != "v2" {
+		t.Fatal("invalid config should not be swapped")
+	}
+
+	var subscribed bool
+	w.Subscribe(func(old, new interface{}) {
+		// the subscribers are called without holding the lock
+		w.OnError(func(err error) { errs <- err })
+		subscribed = true
+	})
+	writeTestFile(t, dir, "app.yaml", "name: v3\n")
+	time.Sleep(10 * time.Millisecond)
+	if err = w.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if !subscribed || w.Current().(*cfg).Name != "v3" {
+		t.Fatal("manual reload failed")
+	}
+	if _, err = Watch(&c, filepath.Join(dir, "missing.yaml"), nil); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expect not exist, got %v", err)
+	}
+}
@@ -0,0 +1,501 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseYAML parses the commonly used subset of YAML: block mappings and sequences,
+// flow collections, plain and quoted scalars, literal and folded block scalars.
+// The decimal numbers are kept as json.Number like the JSON files, so 010 is 10 for an int field
+// and "010" for a string field, while the ones prefixed by 0x or 0o (YAML 1.2) are int64.
+// Anchors, aliases and merge keys are rejected as unsupported,
+// tags and multiple documents are not supported either.
+func parseYAML(data []byte) (map[string]interface{}, error) {
+	p := new(yamlParser)
+	for n, raw := range strings.Split(string(data), "\n") {
+		raw = strings.TrimRight(raw, "\r")
+		trimmed := strings.TrimLeft(raw, " ")
+		text := strings.TrimRight(stripYAMLComment(trimmed), " \t")
+		if strings.HasPrefix(text, "\t") {
+			return nil, fmt.Errorf("config: yaml line %d: tab indentation", n+1)
+		}
+		if len(trimmed) == len(raw) && (text == "---" || text == "...") {
+			text = ""
+		}
+		p.lines = append(p.lines, yamlLine{num: n + 1, indent: len(raw) - len(trimmed), text: text, raw: raw})
+	}
+	p.skipBlank()
+	if p.i >= len(p.lines) {
+		return make(map[string]interface{}), nil
+	}
+	v, err := p.parseBlock(p.lines[p.i].indent)
+	if err != nil {
+		return nil, err
+	}
+	p.skipBlank()
+	if p.i < len(p.lines) {
+		return nil, p.errorf(p.lines[p.i], "unexpected content")
+	}
+	switch m := v.(type) {
+	case map[string]interface{}:
+		return m, nil
+	case nil:
+		return make(map[string]interface{}), nil
+	}
+	return nil, fmt.Errorf("config: yaml root must be a mapping")
+}
+
+type yamlLine struct {
+	num    int
+	indent int
+	text   string // the content without the indentation and comment
+	raw    string
+}
+
+type yamlParser struct {
+	lines []yamlLine
+	i     int
+}
+
+func (p *yamlParser) errorf(l yamlLine, format string, a ...interface{}) error {
+	return fmt.Errorf("config: yaml line %d: %s", l.num, fmt.Sprintf(format, a...))
+}
+
+func (p *yamlParser) skipBlank() {
+	for p.i < len(p.lines) && p.lines[p.i].text == "" {
+		p.i++
+	}
+}
+
+func (p *yamlParser) parseBlock(indent int) (interface{}, error) {
+	l := p.lines[p.i]
+	if isYAMLSeqItem(l.text) {
+		return p.parseSeq(indent)
+	}
+	if _, _, ok := splitYAMLKey(l.text); ok {
+		return p.parseMap(indent)
+	}
+	p.i++
+	return parseYAMLValue(l)
+}
+
+func (p *yamlParser) parseMap(indent int) (interface{}, error) {
+	m := make(map[string]interface{})
+	for {
+		p.skipBlank()
+		if p.i >= len(p.lines) {
+			return m, nil
+		}
+		l := p.lines[p.i]
+		if l.indent < indent {
+			return m, nil
+		}
+		if l.indent > indent {
+			return nil, p.errorf(l, "bad indentation")
+		}
+		key, rest, ok := splitYAMLKey(l.text)
+		if !ok {
+			return nil, p.errorf(l, "expected a mapping key")
+		}
+		if key == "<<" {
+			return nil, p.errorf(l, "unsupported YAML feature: merge key")
+		}
+		if err := checkYAMLIndicator(key); err != nil {
+			return nil, p.errorf(l, "%v", err)
+		}
+		if _, ok = m[key]; ok {
+			return nil, p.errorf(l, "duplicate key %q", key)
+		}
+		p.i++
+		v, err := p.parseValueAfterKey(indent, rest, l)
+		if err != nil {
+			return nil, err
+		}
+		m[key] = v
+	}
+}
+
+func (p *yamlParser) parseValueAfterKey(indent int, rest string, l yamlLine) (interface{}, error) {
+	switch {
+	case rest == "":
+		p.skipBlank()
+		if p.i < len(p.lines) {
+			next := p.lines[p.i]
+			if next.indent > indent {
+				return p.parseBlock(next.indent)
+			}
+			if next.indent == indent && isYAMLSeqItem(next.text) {
+				return p.parseSeq(indent)
+			}
+		}
+		return nil, nil
+	case rest[0] == '|' || rest[0] == '>':
+		return p.parseBlockScalar(indent, rest), nil
+	}
+	l.text = rest
+	return parseYAMLValue(l)
+}
+
+func (p *yamlParser) parseSeq(indent int) (interface{}, error) {
+	seq := make([]interface{}, 0)
+	for {
+		p.skipBlank()
+		if p.i >= len(p.lines) {
+			return seq, nil
+		}
+		l := p.lines[p.i]
+		if l.indent != indent || !isYAMLSeqItem(l.text) {
+			if l.indent > indent {
+				return nil, p.errorf(l, "bad indentation")
+			}
+			return seq, nil
+		}
+		item := strings.TrimLeft(l.text[1:], " ")
+		if item == "" {
+			p.i++
+			p.skipBlank()
+			if p.i < len(p.lines) && p.lines[p.i].indent > indent {
+				v, err := p.parseBlock(p.lines[p.i].indent)
+				if err != nil {
+					return nil, err
+				}
+				seq = append(seq, v)
+			} else {
+				seq = append(seq, nil)
+			}
+			continue
+		}
+		if item[0] == '|' || item[0] == '>' {
+			p.i++
+			seq = append(seq, p.parseBlockScalar(indent, item))
+			continue
+		}
+		// the item content is parsed as a line indented by the dash and the spaces
+		p.lines[p.i].indent = indent + len(l.text) - len(item)
+		p.lines[p.i].text = item
+		v, err := p.parseBlock(p.lines[p.i].indent)
+		if err != nil {
+			return nil, err
+		}
+		seq = append(seq, v)
+	}
+}
+
+// parseBlockScalar parses the literal (|) or folded (>) block scalar after the header line.
+func (p *yamlParser) parseBlockScalar(indent int, header string) string {
+	folded := header[0] == '>'
+	chomp := byte(0)
+	if strings.ContainsRune(header, '-') {
+		chomp = '-'
+	} else if strings.ContainsRune(header, '+') {
+		chomp = '+'
+	}
+	var body []string
+	contentIndent := -1
+	for ; p.i < len(p.lines); p.i++ {
+		l := p.lines[p.i]
+		if strings.TrimSpace(l.raw) == "" {
+			body = append(body, "")
+			continue
+		}
+		if l.indent <= indent || (contentIndent >= 0 && l.indent < contentIndent) {
+			break
+		}
+		if contentIndent < 0 {
+			contentIndent = l.indent
+		}
+		body = append(body, l.raw[contentIndent:])
+	}
+	trailing := 0
+	for len(body) > 0 && body[len(body)-1] == "" {
+		body = body[:len(body)-1]
+		trailing++
+	}
+	var b strings.Builder
+	for j, line := range body {
+		switch {
+		case !folded:
+			if j > 0 {
+				b.WriteByte('\n')
+			}
+		case line == "":
+			b.WriteByte('\n')
+			continue
+		case j > 0 && body[j-1] != "":
+			b.WriteByte(' ')
+		}
+		b.WriteString(line)
+	}
+	if len(body) == 0 {
+		return ""
+	}
+	switch chomp {
+	case '-':
+	case '+':
+		b.WriteString(strings.Repeat("\n", trailing+1))
+	default:
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func isYAMLSeqItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// splitYAMLKey splits "key: rest", the key may be quoted.
+func splitYAMLKey(text string) (key, rest string, ok bool) {
+	if text == "" || text[0] == '[' || text[0] == '{' {
+		return "", "", false
+	}
+	if text[0] == '"' || text[0] == '\'' {
+		end := yamlQuoteEnd(text, 0)
+		if end < 0 || end+1 >= len(text) || text[end+1] != ':' {
+			return "", "", false
+		}
+		k, err := unquoteYAML(text[:end+1])
+		if err != nil {
+			return "", "", false
+		}
+		rest = text[end+2:]
+		if rest != "" && rest[0] != ' ' {
+			return "", "", false
+		}
+		return k, strings.TrimSpace(rest), true
+	}
+	for i := 0; i < len(text); i++ {
+		if text[i] == ':' && (i+1 == len(text) || text[i+1] == ' ') {
+			return strings.TrimSpace(text[:i]), strings.TrimSpace(text[i+1:]), true
+		}
+	}
+	return "", "", false
+}
+
+// yamlQuoteEnd returns the index of the closing quote of the quoted scalar starting at i.
+func yamlQuoteEnd(s string, i int) int {
+	q := s[i]
+	for j := i + 1; j < len(s); j++ {
+		switch {
+		case q == '"' && s[j] == '\\':
+			j++
+		case s[j] == q:
+			if q == '\'' && j+1 < len(s) && s[j+1] == '\'' {
+				j++
+				continue
+			}
+			return j
+		}
+	}
+	return -1
+}
+
+func unquoteYAML(s string) (string, error) {
+	if s[0] == '\'' {
+		return strings.Replace(s[1:len(s)-1], "''", "'", -1), nil
+	}
+	return strconv.Unquote(s)
+}
+
+func stripYAMLComment(s string) string {
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '#':
+			if i == 0 || s[i-1] == ' ' || s[i-1] == '\t' {
+				return s[:i]
+			}
+		case '"', '\'':
+			if i == 0 || strings.IndexByte(" [{,:-", s[i-1]) >= 0 {
+				if end := yamlQuoteEnd(s, i); end > 0 {
+					i = end
+				}
+			}
+		}
+	}
+	return s
+}
+
+func parseYAMLValue(l yamlLine) (interface{}, error) {
+	s := l.text
+	var v interface{}
+	var err error
+	if s[0] != '[' && s[0] != '{' {
+		v, err = parseYAMLScalar(s)
+	} else {
+		f := &yamlFlow{s: s}
+		v, err = f.value()
+		if err == nil {
+			f.skipSpace()
+			if f.pos < len(f.s) {
+				err = fmt.Errorf("unexpected %q", f.s[f.pos:])
+			}
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("config: yaml line %d: %v", l.num, err)
+	}
+	return v, nil
+}
+
+func parseYAMLScalar(s string) (interface{}, error) {
+	if s[0] == '"' || s[0] == '\'' {
+		if yamlQuoteEnd(s, 0) != len(s)-1 {
+			return nil, fmt.Errorf("invalid quoted scalar %s", s)
+		}
+		return unquoteYAML(s)
+	}
+	if err := checkYAMLIndicator(s); err != nil {
+		return nil, err
+	}
+	switch s {
+	case "~", "null", "Null", "NULL":
+		return nil, nil
+	case "true", "True", "TRUE":
+		return true, nil
+	case "false", "False", "FALSE":
+		return false, nil
+	case ".inf", "+.inf", ".Inf", "+.Inf":
+		return strconv.ParseFloat("+Inf", 64)
+	case "-.inf", "-.Inf":
+		return strconv.ParseFloat("-Inf", 64)
+	case ".nan", ".NaN":
+		return strconv.ParseFloat("NaN", 64)
+	}
+	if looksNumeric(s) {
+		body := strings.TrimLeft(s, "+-")
+		if strings.HasPrefix(body, "0x") || strings.HasPrefix(body, "0o") {
+			if i, err := strconv.ParseInt(s, 0, 64); err == nil {
+				return i, nil
+			}
+		} else if _, err := strconv.ParseFloat(s, 64); err == nil {
+			// keeps the text like the JSON decoder, so that it is coerced only into a numeric field,
+			// and 1.10 or 01234 is decoded verbatim into a string field
+			return json.Number(s), nil
+		}
+	}
+	return s, nil
+}
+
+// checkYAMLIndicator rejects the anchor (&) and alias (*) which can't start a plain scalar.
+func checkYAMLIndicator(s string) error {
+	if s != "" && (s[0] == '&' || s[0] == '*') {
+		return fmt.Errorf("unsupported YAML feature: anchor or alias %s", s)
+	}
+	return nil
+}
+
+func looksNumeric(s string) bool {
+	if s[0] == '+' || s[0] == '-' {
+		s = s[1:]
+	}
+	if s != "" && s[0] == '.' {
+		s = s[1:]
+	}
+	return s != "" && s[0] >= '0' && s[0] <= '9'
+}
+
+type yamlFlow struct {
+	s   string
+	pos int
+}
+
+func (f *yamlFlow) skipSpace() {
+	for f.pos < len(f.s) && f.s[f.pos] == ' ' {
+		f.pos++
+	}
+}
+
+func (f *yamlFlow) peek() byte {
+	if f.pos < len(f.s) {
+		return f.s[f.pos]
+	}
+	return 0
+}
+
+func (f *yamlFlow) value() (interface{}, error) {
+	f.skipSpace()
+	switch f.peek() {
+	case '[':
+		f.pos++
+		seq := make([]interface{}, 0)
+		for {
+			f.skipSpace()
+			if f.peek() == ']' {
+				f.pos++
+				return seq, nil
+			}
+			v, err := f.value()
+			if err != nil {
+				return nil, err
+			}
+			seq = append(seq, v)
+			f.skipSpace()
+			switch f.peek() {
+			case ',':
+				f.pos++
+			case ']':
+			default:
+				return nil, fmt.Errorf("expected ',' or ']' in flow sequence")
+			}
+		}
+	case '{':
+		f.pos++
+		m := make(map[string]interface{})
+		for {
+			f.skipSpace()
+			if f.peek() == '}' {
+				f.pos++
+				return m, nil
+			}
+			k, err := f.token(":,}")
+			if err != nil {
+				return nil, err
+			}
+			key, ok := k.(string)
+			if !ok {
+				key = fmt.Sprint(k)
+			}
+			var v interface{}
+			if f.peek() == ':' {
+				f.pos++
+				if v, err = f.value(); err != nil {
+					return nil, err
+				}
+			}
+			m[key] = v
+			f.skipSpace()
+			switch f.peek() {
+			case ',':
+				f.pos++
+			case '}':
+			default:
+				return nil, fmt.Errorf("expected ',' or '}' in flow mapping")
+			}
+		}
+	}
+	return f.token(",]}")
+}
+
+// token reads a quoted or plain scalar ending before one of the stops.
+func (f *yamlFlow) token(stops string) (interface{}, error) {
+	f.skipSpace()
+	start := f.pos
+	if c := f.peek(); c == '"' || c == '\'' {
+		end := yamlQuoteEnd(f.s, f.pos)
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated quoted scalar")
+		}
+		f.pos = end + 1
+		return unquoteYAML(f.s[start:f.pos])
+	}
+	for f.pos < len(f.s) && strings.IndexByte(stops, f.s[f.pos]) < 0 {
+		f.pos++
+	}
+	tok := strings.TrimSpace(f.s[start:f.pos])
+	if tok == "" {
+		return nil, fmt.Errorf("empty flow entry")
+	}
+	return parseYAMLScalar(tok)
+}
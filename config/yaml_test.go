@@ -0,0 +1,112 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseYAML(t *testing.T) {
+	src := `
+# comment
+name: demo app   # trailing comment
+port: 8080
+ratio: 0.5
+debug: true
+empty:
+nothing: ~
+mode: 0755
+octal: 0o755
+hex: -0x1f
+url: http://example.com/a#b
+quoted: "a: \"b\" # not comment"
+single: 'it''s'
+tags: [a, "b c", 3]
+inline: {x: 1, y: [true, null]}
+db:
+  host: localhost
+  replicas:
+    - host: r1
+      port: 1
+    - host: r2
+  users:
+  - alice
+  - bob
+matrix:
+  - - 1
+    - 2
+  - []
+script: |
+  echo 1
+    indented
+
+  echo 2
+folded: >-
+  hello
+  world
+
+  bye
+last: end
+`
+	m, err := parseYAML([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := map[string]interface{}{
+		"name":    "demo app",
+		"port":    json.Number("8080"),
+		"ratio":   json.Number("0.5"),
+		"debug":   true,
+		"empty":   nil,
+		"nothing": nil,
+		"mode":    json.Number("0755"),
+		"octal":   int64(0755),
+		"hex":     int64(-0x1f),
+		"url":     "http://example.com/a#b",
+		"quoted":  `a: "b" # not comment`,
+		"single":  "it's",
+		"tags":    []interface{}{"a", "b c", json.Number("3")},
+		"inline":  map[string]interface{}{"x": json.Number("1"), "y": []interface{}{true, nil}},
+		"db": map[string]interface{}{
+			"host": "localhost",
+			"replicas": []interface{}{
+				map[string]interface{}{"host": "r1", "port": json.Number("1")},
+				map[string]interface{}{"host": "r2"},
+			},
+			"users": []interface{}{"alice", "bob"},
+		},
+		"matrix": []interface{}{[]interface{}{json.Number("1"), json.Number("2")}, []interface{}{}},
+		"script": "echo 1\n  indented\n\necho 2\n",
+		"folded": "hello world\nbye",
+		"last":   "end",
+	}
+	for k, v := range expect {
+		if !reflect.DeepEqual(m[k], v) {
+			t.Errorf("%s: got %#v, expect %#v", k, m[k], v)
+		}
+	}
+	if len(m) != len(expect) {
+		t.Errorf("got %d keys, expect %d", len(m), len(expect))
+	}
+
+	for _, bad := range []string{"a: 1\n  b: 2", "a: 1\na: 2", "- a", "a: [1, 2", "\ta: 1"} {
+		if _, err = parseYAML([]byte(bad)); err == nil {
+			t.Errorf("expect error for %q", bad)
+		}
+	}
+	for _, bad := range []string{
+		"base: &base\n  x: 1",
+		"a: 1\nb: *a",
+		"a:\n  <<: {x: 1}",
+		"a: [1, *x]",
+		"a:\n  - &x 1",
+	} {
+		if _, err = parseYAML([]byte(bad)); err == nil || !strings.Contains(err.Error(), "unsupported YAML feature") {
+			t.Errorf("expect unsupported feature error for %q, got %v", bad, err)
+		}
+	}
+	if m, err = parseYAML([]byte("---\n# only comments\n")); err != nil || len(m) != 0 {
+		t.Fatal(m, err)
+	}
+}
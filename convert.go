@@ -0,0 +1,280 @@
+package goutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ToInt64 converts the loosely typed value (such as from JSON or maps) into int64.
+// It accepts nil, numbers, bool, decimal numeric strings (so "010" is 10), []byte and json.Number,
+// and fails if the value overflows or has a fraction.
+func ToInt64(v interface{}) (int64, error) {
+	switch x := v.(type) {
+	case nil:
+		return 0, nil
+	case int64:
+		return x, nil
+	case int:
+		return int64(x), nil
+	case float64:
+		return float2Int64(x)
+	case bool:
+		if x {
+			return 1, nil
+		}
+		return 0, nil
+	case json.Number:
+		return str2Int64(string(x))
+	case string:
+		return str2Int64(x)
+	case []byte:
+		return str2Int64(string(x))
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := rv.Uint()
+		if u > math.MaxInt64 {
+			return 0, fmt.Errorf("goutil: %d overflows int64", u)
+		}
+		return int64(u), nil
+	case reflect.Float32, reflect.Float64:
+		return float2Int64(rv.Float())
+	case reflect.String:
+		return str2Int64(rv.String())
+	}
+	return 0, convertError(v, "int64")
+}
+
+// ToFloat64 converts the loosely typed value into float64.
+// It accepts nil, numbers, bool, numeric strings, []byte and json.Number.
+func ToFloat64(v interface{}) (float64, error) {
+	switch x := v.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return x, nil
+	case bool:
+		if x {
+			return 1, nil
+		}
+		return 0, nil
+	case json.Number:
+		return x.Float64()
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(x), 64)
+	case []byte:
+		return strconv.ParseFloat(strings.TrimSpace(string(x)), 64)
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	case reflect.String:
+		return strconv.ParseFloat(strings.TrimSpace(rv.String()), 64)
+	}
+	return 0, convertError(v, "float64")
+}
+
+// ToBool converts the loosely typed value into bool.
+// Numbers are true if non-zero, and the strings accept the strconv.ParseBool forms
+// plus "yes", "no", "y", "n", "on", "off" and "" (false), ignoring case.
+func ToBool(v interface{}) (bool, error) {
+	switch x := v.(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return x, nil
+	case string:
+		return str2Bool(x)
+	case []byte:
+		return str2Bool(string(x))
+	case json.Number:
+		f, err := x.Float64()
+		return f != 0, err
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Bool:
+		return rv.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int() != 0, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return rv.Uint() != 0, nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float() != 0, nil
+	case reflect.String:
+		return str2Bool(rv.String())
+	}
+	return false, convertError(v, "bool")
+}
+
+// ToString converts the loosely typed value into string.
+// It accepts nil (""), strings, []byte, numbers, bool, time.Time (RFC3339Nano),
+// error and fmt.Stringer.
+func ToString(v interface{}) (string, error) {
+	switch x := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return x, nil
+	case []byte:
+		return string(x), nil
+	case json.Number:
+		return string(x), nil
+	case time.Time:
+		return x.Format(time.RFC3339Nano), nil
+	case error:
+		return x.Error(), nil
+	case fmt.Stringer:
+		return x.String(), nil
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, 32), nil
+	case reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, 64), nil
+	}
+	return "", convertError(v, "string")
+}
+
+// toTimeLayouts are the layouts tried by ToTime in order.
+var toTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+	time.RFC1123Z,
+	time.RFC1123,
+}
+
+// ToTime converts the loosely typed value into time.Time.
+// Numbers (and numeric strings) are treated as unix seconds,
+// and the strings are parsed as RFC3339, "2006-01-02 15:04:05", "2006-01-02" or RFC1123.
+func ToTime(v interface{}) (time.Time, error) {
+	switch x := v.(type) {
+	case nil:
+		return time.Time{}, nil
+	case time.Time:
+		return x, nil
+	case *time.Time:
+		if x == nil {
+			return time.Time{}, nil
+		}
+		return *x, nil
+	case string:
+		return str2Time(x)
+	case []byte:
+		return str2Time(string(x))
+	}
+	f, err := ToFloat64(v)
+	if err != nil {
+		return time.Time{}, convertError(v, "time.Time")
+	}
+	return unixFloat(f), nil
+}
+
+// ToDuration converts the loosely typed value into time.Duration.
+// Numbers are treated as nanoseconds, and the strings are parsed by time.ParseDuration,
+// or as nanoseconds if they have no unit.
+func ToDuration(v interface{}) (time.Duration, error) {
+	switch x := v.(type) {
+	case time.Duration:
+		return x, nil
+	case string:
+		return str2Duration(x)
+	case []byte:
+		return str2Duration(string(x))
+	}
+	n, err := ToInt64(v)
+	if err != nil {
+		return 0, convertError(v, "time.Duration")
+	}
+	return time.Duration(n), nil
+}
+
+func convertError(v interface{}, to string) error {
+	return fmt.Errorf("goutil: cannot convert %#v (%T) to %s", v, v, to)
+}
+
+func float2Int64(f float64) (int64, error) {
+	if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, fmt.Errorf("goutil: cannot convert %v to int64 exactly", f)
+	}
+	return int64(f), nil
+}
+
+func str2Int64(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err == nil {
+		return n, nil
+	}
+	f, ferr := strconv.ParseFloat(s, 64)
+	if ferr != nil {
+		return 0, err
+	}
+	return float2Int64(f)
+}
+
+func str2Bool(s string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "0", "f", "false", "n", "no", "off":
+		return false, nil
+	case "1", "t", "true", "y", "yes", "on":
+		return true, nil
+	}
+	return false, fmt.Errorf("goutil: cannot convert %q to bool", s)
+}
+
+func str2Time(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range toTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return unixFloat(f), nil
+	}
+	return time.Time{}, fmt.Errorf("goutil: cannot convert %q to time.Time", s)
+}
+
+func unixFloat(f float64) time.Time {
+	sec, frac := math.Modf(f)
+	return time.Unix(int64(sec), int64(frac*1e9))
+}
+
+func str2Duration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Duration(n), nil
+	}
+	return time.ParseDuration(s)
+}
@@ -0,0 +1,93 @@
+package goutil
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestToInt64(t *testing.T) {
+	for _, c := range []struct {
+		in     interface{}
+		expect int64
+	}{
+		{nil, 0}, {int8(-3), -3}, {uint32(7), 7}, {float64(12), 12}, {true, 1},
+		{" 42 ", 42}, {"010", 10}, {"1e3", 1000}, {json.Number("5"), 5}, {[]byte("9"), 9},
+	} {
+		got, err := ToInt64(c.in)
+		if err != nil || got != c.expect {
+			t.Errorf("ToInt64(%#v) = %d, %v; expect %d", c.in, got, err, c.expect)
+		}
+	}
+	for _, in := range []interface{}{1.5, "abc", "0x10", uint64(math.MaxUint64), []int{1}} {
+		if _, err := ToInt64(in); err == nil {
+			t.Errorf("ToInt64(%#v) expect error", in)
+		}
+	}
+}
+
+func TestToFloat64(t *testing.T) {
+	if f, err := ToFloat64("1.25"); err != nil || f != 1.25 {
+		t.Fatal(f, err)
+	}
+	if f, err := ToFloat64(uint8(3)); err != nil || f != 3 {
+		t.Fatal(f, err)
+	}
+	if _, err := ToFloat64(struct{}{}); err == nil {
+		t.Fatal("expect error")
+	}
+}
+
+func TestToBool(t *testing.T) {
+	for in, expect := range map[interface{}]bool{"yes": true, "Off": false, "": false, 2: true, 0.0: false, "TRUE": true} {
+		if got, err := ToBool(in); err != nil || got != expect {
+			t.Errorf("ToBool(%#v) = %v, %v", in, got, err)
+		}
+	}
+	if _, err := ToBool("maybe"); err == nil {
+		t.Fatal("expect error")
+	}
+}
+
+func TestToString(t *testing.T) {
+	for _, c := range []struct {
+		in     interface{}
+		expect string
+	}{
+		{nil, ""}, {[]byte("b"), "b"}, {3, "3"}, {1.5, "1.5"}, {false, "false"},
+		{errors.New("e"), "e"}, {time.Second, "1s"},
+	} {
+		if got, err := ToString(c.in); err != nil || got != c.expect {
+			t.Errorf("ToString(%#v) = %q, %v", c.in, got, err)
+		}
+	}
+	if _, err := ToString([]int{1}); err == nil {
+		t.Fatal("expect error")
+	}
+}
+
+func TestToTime(t *testing.T) {
+	expect := time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, in := range []interface{}{"2018-01-02T03:04:05Z", "2018-01-02 03:04:05", expect.Unix(), float64(expect.Unix()), "1514862245"} {
+		got, err := ToTime(in)
+		if err != nil || !got.Equal(expect) {
+			t.Errorf("ToTime(%#v) = %v, %v", in, got, err)
+		}
+	}
+	if _, err := ToTime("yesterday"); err == nil {
+		t.Fatal("expect error")
+	}
+}
+
+func TestToDuration(t *testing.T) {
+	for in, expect := range map[interface{}]time.Duration{"1m30s": 90 * time.Second, "100": 100, 5: 5, time.Hour: time.Hour} {
+		if got, err := ToDuration(in); err != nil || got != expect {
+			t.Errorf("ToDuration(%#v) = %v, %v", in, got, err)
+		}
+	}
+	if _, err := ToDuration("1x"); err == nil {
+		t.Fatal("expect error")
+	}
+}
@@ -0,0 +1,210 @@
+package goutil
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// SymlinkPolicy controls how CopyDir and the archive helpers handle the symbolic links.
+type SymlinkPolicy int
+
+const (
+	// SymlinkCopy recreates the symbolic links as is.
+	SymlinkCopy SymlinkPolicy = iota
+	// SymlinkFollow copies the content the symbolic links point to.
+	SymlinkFollow
+	// SymlinkSkip skips the symbolic links.
+	SymlinkSkip
+)
+
+// CopyOptions are the options of CopyDir.
+type CopyOptions struct {
+	// Symlinks is the symbolic links policy, SymlinkCopy by default.
+	Symlinks SymlinkPolicy
+	// Include are the glob patterns of the files to copy, all files by default.
+	// A pattern matches either the slash-separated relative path or the base name.
+	Include []string
+	// Exclude are the glob patterns of the files and directories to skip.
+	Exclude []string
+	// Progress is called after each file is copied, with the relative path and
+	// the number of bytes written.
+	Progress func(rel string, written int64)
+}
+
+// CopyDir copies the directory tree src to dst recursively,
+// preserving the permissions and modification times.
+// The existing files in dst are overwritten. opts may be nil.
+// It fails if dst is src or inside it, or if a followed symbolic link
+// points to one of its parent directories.
+func CopyDir(src, dst string, opts *CopyOptions) error {
+	if opts == nil {
+		opts = new(CopyOptions)
+	}
+	fi, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	if !fi.IsDir() {
+		return errors.New("goutil: CopyDir source is not a directory: " + src)
+	}
+	absSrc, err := filepath.Abs(src)
+	if err != nil {
+		return err
+	}
+	absDst, err := filepath.Abs(dst)
+	if err != nil {
+		return err
+	}
+	if r, err := filepath.Rel(absSrc, absDst); err == nil &&
+		r != ".." && !strings.HasPrefix(r, ".."+string(filepath.Separator)) {
+		return errors.New("goutil: CopyDir destination is inside the source: " + dst)
+	}
+	return copyDir(src, dst, "", fi, opts, nil)
+}
+
+// copyDir copies the directory src, parents are the directories being copied above it,
+// to detect the cycles of the followed symbolic links.
+func copyDir(src, dst, rel string, fi os.FileInfo, opts *CopyOptions, parents []os.FileInfo) error {
+	for _, p := range parents {
+		if os.SameFile(p, fi) {
+			return errors.New("goutil: CopyDir symbolic link cycle at " + src)
+		}
+	}
+	parents = append(parents, fi)
+	if err := os.MkdirAll(dst, fi.Mode().Perm()|0700); err != nil {
+		return err
+	}
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	names, err := f.Readdirnames(-1)
+	f.Close()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		s, d := filepath.Join(src, name), filepath.Join(dst, name)
+		r := path.Join(rel, name)
+		if matchCopyPatterns(opts.Exclude, r) {
+			continue
+		}
+		cfi, err := os.Lstat(s)
+		if err != nil {
+			return err
+		}
+		if cfi.Mode()&os.ModeSymlink != 0 {
+			switch opts.Symlinks {
+			case SymlinkSkip:
+				continue
+			case SymlinkCopy:
+				if len(opts.Include) > 0 && !matchCopyPatterns(opts.Include, r) {
+					continue
+				}
+				if err = copySymlink(s, d); err != nil {
+					return err
+				}
+				continue
+			}
+			if cfi, err = os.Stat(s); err != nil {
+				return err
+			}
+		}
+		if cfi.IsDir() {
+			if err = copyDir(s, d, r, cfi, opts, parents); err != nil {
+				return err
+			}
+			continue
+		}
+		if len(opts.Include) > 0 && !matchCopyPatterns(opts.Include, r) {
+			continue
+		}
+		if !cfi.Mode().IsRegular() {
+			continue
+		}
+		n, err := copyFile(s, d, cfi)
+		if err != nil {
+			return err
+		}
+		if opts.Progress != nil {
+			opts.Progress(r, n)
+		}
+	}
+	// restore the directory attributes after its content is written
+	if err = os.Chmod(dst, fi.Mode().Perm()); err != nil {
+		return err
+	}
+	if err = copyACL(src, dst); err != nil {
+		return err
+	}
+	return os.Chtimes(dst, fi.ModTime(), fi.ModTime())
+}
+
+func matchCopyPatterns(patterns []string, rel string) bool {
+	base := path.Base(rel)
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, rel); ok {
+			return true
+		}
+		if ok, _ := path.Match(p, base); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func copySymlink(src, dst string) error {
+	target, err := os.Readlink(src)
+	if err != nil {
+		return err
+	}
+	if _, err = os.Lstat(dst); err == nil {
+		if err = os.Remove(dst); err != nil {
+			return err
+		}
+	}
+	return os.Symlink(target, dst)
+}
+
+// CopyFile copies the regular file src to dst, preserving the permissions (including the ACL
+// on Windows) and modification time, and returns the number of bytes written.
+func CopyFile(src, dst string) (int64, error) {
+	fi, err := os.Stat(src)
+	if err != nil {
+		return 0, err
+	}
+	if !fi.Mode().IsRegular() {
+		return 0, errors.New("goutil: CopyFile source is not a regular file: " + src)
+	}
+	return copyFile(src, dst, fi)
+}
+
+func copyFile(src, dst string, fi os.FileInfo) (int64, error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return 0, err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fi.Mode().Perm()|0200)
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(out, in)
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return n, err
+	}
+	if err = os.Chmod(dst, fi.Mode().Perm()); err != nil {
+		return n, err
+	}
+	if err = copyACL(src, dst); err != nil {
+		return n, err
+	}
+	return n, os.Chtimes(dst, fi.ModTime(), fi.ModTime())
+}
@@ -0,0 +1,96 @@
+package goutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestCopyDir(t *testing.T) {
+	root, err := ioutil.TempDir("", "goutil_copy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	src := filepath.Join(root, "src")
+	mtime := time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC)
+	for name, content := range map[string]string{
+		"a.go":          "package a",
+		"a_test.go":     "package a",
+		"sub/b.go":      "package b",
+		"sub/README":    "readme",
+		"vendor/x/x.go": "package x",
+	} {
+		p := filepath.Join(src, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(p), 0755)
+		if err = ioutil.WriteFile(p, []byte(content), 0640); err != nil {
+			t.Fatal(err)
+		}
+		os.Chtimes(p, mtime, mtime)
+	}
+	os.Symlink("a.go", filepath.Join(src, "link.go"))
+
+	dst := filepath.Join(root, "dst")
+	var copied []string
+	err = CopyDir(src, dst, &CopyOptions{
+		Include:  []string{"*.go"},
+		Exclude:  []string{"vendor", "*_test.go"},
+		Progress: func(rel string, n int64) { copied = append(copied, rel) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(copied)
+	if len(copied) != 2 || copied[0] != "a.go" || copied[1] != "sub/b.go" {
+		t.Fatalf("copied %v", copied)
+	}
+	for _, name := range []string{"a_test.go", "sub/README", "vendor"} {
+		if FileExists(filepath.Join(dst, name)) {
+			t.Errorf("%s should be skipped", name)
+		}
+	}
+	fi, err := os.Stat(filepath.Join(dst, "sub", "b.go"))
+	if err != nil || fi.Mode().Perm() != 0640 || !fi.ModTime().Equal(mtime) {
+		t.Fatalf("attributes are not preserved: %v %v", fi, err)
+	}
+	if target, err := os.Readlink(filepath.Join(dst, "link.go")); err != nil || target != "a.go" {
+		t.Fatalf("symlink: %q %v", target, err)
+	}
+
+	followed := filepath.Join(root, "followed")
+	if err = CopyDir(src, followed, &CopyOptions{Symlinks: SymlinkFollow}); err != nil {
+		t.Fatal(err)
+	}
+	if fi, err = os.Lstat(filepath.Join(followed, "link.go")); err != nil || !fi.Mode().IsRegular() {
+		t.Fatalf("symlink should be followed: %v", err)
+	}
+	skipped := filepath.Join(root, "skipped")
+	if err = CopyDir(src, skipped, &CopyOptions{Symlinks: SymlinkSkip}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Lstat(filepath.Join(skipped, "link.go")); !os.IsNotExist(err) {
+		t.Fatalf("symlink should be skipped: %v", err)
+	}
+	if err = CopyDir(filepath.Join(src, "a.go"), dst, nil); err == nil {
+		t.Fatal("expect error for non-directory source")
+	}
+	for _, d := range []string{src, filepath.Join(src, "sub", "copy"), filepath.Join(src, "..", "src", "x")} {
+		if err = CopyDir(src, d, nil); err == nil {
+			t.Fatalf("expect error for destination %s inside the source", d)
+		}
+	}
+	if err = CopyDir(src, filepath.Join(root, "src2"), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// a followed link to the parent directory
+	if err = os.Symlink("..", filepath.Join(src, "sub", "up")); err != nil {
+		t.Skip(err)
+	}
+	if err = CopyDir(src, filepath.Join(root, "cycle"), &CopyOptions{Symlinks: SymlinkFollow}); err == nil {
+		t.Fatal("expect symbolic link cycle error")
+	}
+}
@@ -0,0 +1,80 @@
+package goutil
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// cacheLineSize is used to pad the counter cells, so that no two cells
+// share a CPU cache line.
+const cacheLineSize = 64
+
+// Counter is a striped int64 counter for high-contention increments.
+// It shards the count across padded cells and sums them on read,
+// avoiding the cache-line contention of a single atomic value.
+//
+// Value is not a consistent snapshot while adds are happening concurrently.
+// It is safe for multiple goroutines to call a Counter's methods concurrently.
+// The zero value is ready to use, and a Counter must not be copied after first use.
+type Counter struct {
+	cells []counterCell
+	pool  sync.Pool
+	next  uint32
+	once  sync.Once
+}
+
+type counterCell struct {
+	n int64
+	_ [cacheLineSize - 8]byte
+}
+
+// NewCounter creates a new *Counter with one cell per GOMAXPROCS.
+func NewCounter() *Counter {
+	c := new(Counter)
+	c.once.Do(c.init)
+	return c
+}
+
+func (c *Counter) init() {
+	c.cells = make([]counterCell, runtime.GOMAXPROCS(0))
+	// sync.Pool keeps a per-P cache, so the goroutines running
+	// on the same P keep using the same cell.
+	c.pool.New = func() interface{} {
+		i := atomic.AddUint32(&c.next, 1)
+		return &c.cells[int(i)%len(c.cells)]
+	}
+}
+
+// Add adds delta to the counter.
+func (c *Counter) Add(delta int64) {
+	c.once.Do(c.init)
+	cell := c.pool.Get().(*counterCell)
+	atomic.AddInt64(&cell.n, delta)
+	c.pool.Put(cell)
+}
+
+// Inc increments the counter by 1.
+func (c *Counter) Inc() {
+	c.Add(1)
+}
+
+// Value returns the current value of the counter.
+func (c *Counter) Value() int64 {
+	c.once.Do(c.init)
+	var sum int64
+	for i := range c.cells {
+		sum += atomic.LoadInt64(&c.cells[i].n)
+	}
+	return sum
+}
+
+// Reset sets the counter to zero and returns the value before reset.
+func (c *Counter) Reset() int64 {
+	c.once.Do(c.init)
+	var sum int64
+	for i := range c.cells {
+		sum += atomic.SwapInt64(&c.cells[i].n, 0)
+	}
+	return sum
+}
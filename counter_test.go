@@ -0,0 +1,66 @@
+package goutil
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCounter(t *testing.T) {
+	c := NewCounter()
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				c.Inc()
+			}
+		}()
+	}
+	wg.Wait()
+	c.Add(-10)
+	if v := c.Value(); v != 99990 {
+		t.Fatalf("expect 99990, got %d", v)
+	}
+	if v := c.Reset(); v != 99990 || c.Value() != 0 {
+		t.Fatalf("unexpected reset: %d, %d", v, c.Value())
+	}
+}
+
+func TestCounterZero(t *testing.T) {
+	var c Counter
+	if c.Value() != 0 {
+		t.Fatal("expect 0")
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.Inc()
+		}()
+	}
+	wg.Wait()
+	if v := c.Value(); v != 10 {
+		t.Fatalf("expect 10, got %d", v)
+	}
+}
+
+func BenchmarkCounter(b *testing.B) {
+	c := NewCounter()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			c.Inc()
+		}
+	})
+}
+
+func BenchmarkAtomicInt64(b *testing.B) {
+	var n int64
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			atomic.AddInt64(&n, 1)
+		}
+	})
+}
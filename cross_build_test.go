@@ -0,0 +1,35 @@
+package goutil
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// TestCrossBuild keeps the packages building on the platforms
+// without the unix signals, e.g. the graceful package imported by the temp file sweeper.
+func TestCrossBuild(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping cross build in short mode")
+	}
+	gobin := filepath.Join(runtime.GOROOT(), "bin", "go")
+	if _, err := os.Stat(gobin); err != nil {
+		t.Skip("go tool not found")
+	}
+	for _, target := range [][2]string{
+		{"js", "wasm"},
+		{"wasip1", "wasm"},
+		{"windows", "amd64"},
+		{"plan9", "amd64"},
+		{"darwin", "arm64"},
+		{"linux", "386"},
+	} {
+		cmd := exec.Command(gobin, "build", "./...")
+		cmd.Env = append(os.Environ(), "GOOS="+target[0], "GOARCH="+target[1], "CGO_ENABLED=0")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Errorf("GOOS=%s GOARCH=%s: %v\n%s", target[0], target[1], err, out)
+		}
+	}
+}
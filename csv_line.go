@@ -0,0 +1,94 @@
+package goutil
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrCSVQuote is returned by SplitCSVLine when a quoted field is malformed.
+var ErrCSVQuote = errors.New("goutil: malformed quoted CSV field")
+
+// SplitCSVLine splits a single CSV line into fields, the separator is ',' by default.
+// A field may be quoted with '"' to contain separators and quotes,
+// and a quote in the quoted field is escaped as "".
+func SplitCSVLine(line string, sep ...rune) ([]string, error) {
+	comma := csvSep(sep)
+	line = strings.TrimRight(line, "\r\n")
+	var fields []string
+	var b strings.Builder
+	for {
+		if !strings.HasPrefix(line, `"`) {
+			i := strings.IndexRune(line, comma)
+			if i < 0 {
+				return append(fields, line), nil
+			}
+			fields = append(fields, line[:i])
+			line = line[i+utf8.RuneLen(comma):]
+			continue
+		}
+		// Quoted field.
+		line = line[1:]
+		b.Reset()
+		for {
+			i := strings.IndexByte(line, '"')
+			if i < 0 {
+				return nil, ErrCSVQuote
+			}
+			b.WriteString(line[:i])
+			line = line[i+1:]
+			if strings.HasPrefix(line, `"`) {
+				b.WriteByte('"')
+				line = line[1:]
+				continue
+			}
+			break
+		}
+		fields = append(fields, b.String())
+		if line == "" {
+			return fields, nil
+		}
+		r, size := utf8.DecodeRuneInString(line)
+		if r != comma {
+			return nil, ErrCSVQuote
+		}
+		line = line[size:]
+	}
+}
+
+// JoinCSVLine joins the fields into a single CSV line, the separator is ',' by default.
+// The fields containing separators, quotes, line breaks or leading spaces are quoted.
+func JoinCSVLine(fields []string, sep ...rune) string {
+	comma := csvSep(sep)
+	var b strings.Builder
+	for i, f := range fields {
+		if i > 0 {
+			b.WriteRune(comma)
+		}
+		if !csvNeedQuote(f, comma) {
+			b.WriteString(f)
+			continue
+		}
+		b.WriteByte('"')
+		b.WriteString(strings.Replace(f, `"`, `""`, -1))
+		b.WriteByte('"')
+	}
+	return b.String()
+}
+
+func csvSep(sep []rune) rune {
+	if len(sep) > 0 && sep[0] != '"' && sep[0] != '\r' && sep[0] != '\n' && utf8.ValidRune(sep[0]) {
+		return sep[0]
+	}
+	return ','
+}
+
+func csvNeedQuote(f string, comma rune) bool {
+	if f == "" {
+		return false
+	}
+	if f[0] == ' ' || f[0] == '\t' {
+		return true
+	}
+	return strings.ContainsRune(f, comma) || strings.ContainsAny(f, "\"\r\n")
+}
@@ -0,0 +1,42 @@
+package goutil
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitCSVLine(t *testing.T) {
+	cases := []struct {
+		line   string
+		sep    []rune
+		fields []string
+		err    bool
+	}{
+		{`a,b,c`, nil, []string{"a", "b", "c"}, false},
+		{`a,"b,c","say ""hi"""`, nil, []string{"a", "b,c", `say "hi"`}, false},
+		{`,,`, nil, []string{"", "", ""}, false},
+		{"x;\"y;z\"\r\n", []rune{';'}, []string{"x", "y;z"}, false},
+		{`a|"b"`, []rune{'|'}, []string{"a", "b"}, false},
+		{`"unterminated`, nil, nil, true},
+		{`"a"b,c`, nil, nil, true},
+		{``, nil, []string{""}, false},
+	}
+	for _, c := range cases {
+		fields, err := SplitCSVLine(c.line, c.sep...)
+		if (err != nil) != c.err || !reflect.DeepEqual(fields, c.fields) {
+			t.Errorf("SplitCSVLine(%q) = %q, %v, expect %q", c.line, fields, err, c.fields)
+		}
+	}
+}
+
+func TestJoinCSVLine(t *testing.T) {
+	fields := []string{"a", "b,c", `say "hi"`, " lead", "line\nbreak", ""}
+	line := JoinCSVLine(fields)
+	if line != `a,"b,c","say ""hi"""," lead","line`+"\n"+`break",` {
+		t.Fatalf("unexpected line: %q", line)
+	}
+	got, err := SplitCSVLine(JoinCSVLine(fields[:4], '\t'), '\t')
+	if err != nil || !reflect.DeepEqual(got, fields[:4]) {
+		t.Fatalf("round trip: %q, %v", got, err)
+	}
+}
@@ -0,0 +1,98 @@
+package goutil
+
+import (
+	"errors"
+	"os"
+	"strconv"
+)
+
+// ErrDaemonUnsupported is returned by Daemonize on the unsupported platforms.
+var ErrDaemonUnsupported = errors.New("goutil: daemonize is not supported on this platform")
+
+// daemonEnv marks the detached child process.
+const daemonEnv = "GOUTIL_DAEMONIZED"
+
+// The special values of DaemonOptions.Umask.
+const (
+	// UmaskInherit keeps the inherited umask.
+	UmaskInherit = -1
+	// UmaskZero sets the umask to 0, making the new files world-writable by default.
+	UmaskZero = -2
+)
+
+// defaultUmask is the umask used when DaemonOptions.Umask is 0.
+const defaultUmask = 022
+
+// DaemonOptions is the options of Daemonize.
+type DaemonOptions struct {
+	// PidFile is written by the daemon if not empty.
+	PidFile string
+	// Stdout and Stderr are the files that the standard output and error are appended to,
+	// os.DevNull by default.
+	Stdout string
+	Stderr string
+	// Dir is the working directory of the daemon, "/" by default.
+	Dir string
+	// Umask is set by the daemon, 022 if it is 0. UmaskInherit keeps the inherited one,
+	// and UmaskZero sets it to 0 explicitly.
+	Umask int
+	// Args is the arguments of the daemon, os.Args[1:] by default.
+	Args []string
+	// Env is the environment of the daemon, os.Environ() by default.
+	Env []string
+}
+
+// Daemonize re-executes the program detached from the terminal in a new session, and exits
+// the original process. In the daemon, it sets the umask, writes the pid file and returns nil,
+// so it should be called at the beginning of main.
+func Daemonize(opts DaemonOptions) error {
+	if os.Getenv(daemonEnv) == "1" {
+		os.Unsetenv(daemonEnv)
+		if mask, ok := daemonUmask(opts.Umask); ok {
+			setUmask(mask)
+		}
+		if opts.PidFile != "" {
+			return writePidFile(opts.PidFile)
+		}
+		return nil
+	}
+	if opts.Dir == "" {
+		opts.Dir = "/"
+	}
+	if opts.Args == nil {
+		opts.Args = os.Args[1:]
+	}
+	if opts.Env == nil {
+		opts.Env = os.Environ()
+	}
+	opts.Env = append(opts.Env, daemonEnv+"=1")
+	if err := startDaemon(opts); err != nil {
+		return err
+	}
+	os.Exit(0)
+	return nil
+}
+
+// daemonUmask returns the umask to set, and false to keep the inherited one.
+func daemonUmask(umask int) (int, bool) {
+	switch {
+	case umask == 0:
+		return defaultUmask, true
+	case umask == UmaskZero:
+		return 0, true
+	case umask < 0:
+		return 0, false
+	}
+	return umask, true
+}
+
+func writePidFile(filename string) error {
+	return WriteFileAtomic(filename, []byte(strconv.Itoa(os.Getpid())+"\n"), 0644)
+}
+
+func openDaemonOutput(filename string) (*os.File, error) {
+	if filename == "" {
+		filename = os.DevNull
+	}
+	return os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+}
@@ -0,0 +1,10 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd
+
+package goutil
+
+func setUmask(mask int) {}
+
+func startDaemon(opts DaemonOptions) error {
+	return ErrDaemonUnsupported
+}
@@ -0,0 +1,71 @@
+package goutil
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDaemonize(t *testing.T) {
+	if dir := os.Getenv("GOUTIL_TEST_DAEMON_DIR"); dir != "" {
+		err := Daemonize(DaemonOptions{
+			PidFile: filepath.Join(dir, "daemon.pid"),
+			Stdout:  filepath.Join(dir, "daemon.log"),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		wd, _ := os.Getwd()
+		os.Stdout.WriteString("daemon in " + wd + "\n")
+		return
+	}
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		t.Skip("unsupported")
+	}
+	dir, err := ioutil.TempDir("", "daemon")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cmd := exec.Command(os.Args[0], "-test.run=^TestDaemonize$")
+	cmd.Env = append(os.Environ(), "GOUTIL_TEST_DAEMON_DIR="+dir)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("%v: %s", err, out)
+	}
+	var log string
+	for i := 0; i < 100 && !strings.Contains(log, "PASS"); i++ {
+		time.Sleep(50 * time.Millisecond)
+		b, _ := ioutil.ReadFile(filepath.Join(dir, "daemon.log"))
+		log = string(b)
+	}
+	if !strings.Contains(log, "daemon in /\n") {
+		t.Fatalf("got log %q", log)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dir, "daemon.pid"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	if err != nil || pid == cmd.Process.Pid {
+		t.Fatalf("got pid %q, %v", b, err)
+	}
+}
+
+func TestDaemonUmask(t *testing.T) {
+	for _, c := range []struct {
+		in, mask int
+		set      bool
+	}{
+		{0, 022, true}, {077, 077, true}, {UmaskZero, 0, true}, {UmaskInherit, 0, false},
+	} {
+		if mask, set := daemonUmask(c.in); mask != c.mask || set != c.set {
+			t.Errorf("daemonUmask(%d) = %o, %v", c.in, mask, set)
+		}
+	}
+}
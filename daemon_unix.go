@@ -0,0 +1,47 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+// +build darwin dragonfly freebsd linux netbsd openbsd
+
+package goutil
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+func setUmask(mask int) {
+	syscall.Umask(mask)
+}
+
+func startDaemon(opts DaemonOptions) error {
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	stdin, err := os.Open(os.DevNull)
+	if err != nil {
+		return err
+	}
+	defer stdin.Close()
+	stdout, err := openDaemonOutput(opts.Stdout)
+	if err != nil {
+		return err
+	}
+	defer stdout.Close()
+	stderr := stdout
+	if opts.Stderr != opts.Stdout {
+		if stderr, err = openDaemonOutput(opts.Stderr); err != nil {
+			return err
+		}
+		defer stderr.Close()
+	}
+	cmd := exec.Command(exe, opts.Args...)
+	cmd.Dir = opts.Dir
+	cmd.Env = opts.Env
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = stdin, stdout, stderr
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	if err = cmd.Start(); err != nil {
+		return err
+	}
+	return cmd.Process.Release()
+}
@@ -0,0 +1,154 @@
+package goutil
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+// DeepCopy copies src into the value which dst points to recursively,
+// including nested structs, maps, slices, arrays, pointers and interfaces.
+// The cyclic references are kept cyclic in the copy, and time.Time is copied by value.
+// src may be either the value or a pointer to the value of dst's element type.
+// The unexported fields are left unchanged unless copyUnexported is true,
+// in which case they are copied via unsafe.
+// Note: channels and functions are shared rather than copied.
+func DeepCopy(dst, src interface{}, copyUnexported ...bool) error {
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Ptr || dv.IsNil() {
+		return errors.New("goutil: DeepCopy requires a non-nil pointer dst")
+	}
+	dv = dv.Elem()
+	sv := reflect.ValueOf(src)
+	if !sv.IsValid() {
+		dv.Set(reflect.Zero(dv.Type()))
+		return nil
+	}
+	if sv.Type() != dv.Type() {
+		if sv.Kind() != reflect.Ptr || sv.Type().Elem() != dv.Type() {
+			return fmt.Errorf("goutil: DeepCopy type mismatch: dst %s, src %s", dv.Type(), sv.Type())
+		}
+		if sv.IsNil() {
+			dv.Set(reflect.Zero(dv.Type()))
+			return nil
+		}
+		sv = sv.Elem()
+	}
+	c := &copier{
+		visited:        make(map[visitKey]reflect.Value),
+		copyUnexported: len(copyUnexported) > 0 && copyUnexported[0],
+	}
+	c.copy(dv, sv)
+	return nil
+}
+
+type visitKey struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+}
+
+type copier struct {
+	visited        map[visitKey]reflect.Value
+	copyUnexported bool
+}
+
+func (c *copier) copy(dst, src reflect.Value) {
+	switch src.Kind() {
+	case reflect.Ptr:
+		if src.IsNil() {
+			dst.Set(reflect.Zero(dst.Type()))
+			return
+		}
+		key := visitKey{ptr: src.Pointer(), typ: src.Type()}
+		if v, ok := c.visited[key]; ok {
+			dst.Set(v)
+			return
+		}
+		nv := reflect.New(src.Type().Elem())
+		c.visited[key] = nv
+		c.copy(nv.Elem(), src.Elem())
+		dst.Set(nv)
+
+	case reflect.Interface:
+		if src.IsNil() {
+			dst.Set(reflect.Zero(dst.Type()))
+			return
+		}
+		e := src.Elem()
+		nv := reflect.New(e.Type()).Elem()
+		c.copy(nv, e)
+		dst.Set(nv)
+
+	case reflect.Map:
+		if src.IsNil() {
+			dst.Set(reflect.Zero(dst.Type()))
+			return
+		}
+		key := visitKey{ptr: src.Pointer(), typ: src.Type()}
+		if v, ok := c.visited[key]; ok {
+			dst.Set(v)
+			return
+		}
+		nm := reflect.MakeMapWithSize(src.Type(), src.Len())
+		c.visited[key] = nm
+		iter := src.MapRange()
+		for iter.Next() {
+			k := reflect.New(src.Type().Key()).Elem()
+			c.copy(k, iter.Key())
+			e := reflect.New(src.Type().Elem()).Elem()
+			c.copy(e, iter.Value())
+			nm.SetMapIndex(k, e)
+		}
+		dst.Set(nm)
+
+	case reflect.Slice:
+		if src.IsNil() {
+			dst.Set(reflect.Zero(dst.Type()))
+			return
+		}
+		key := visitKey{ptr: src.Pointer(), typ: src.Type(), len: src.Len()}
+		if v, ok := c.visited[key]; ok {
+			dst.Set(v)
+			return
+		}
+		ns := reflect.MakeSlice(src.Type(), src.Len(), src.Cap())
+		c.visited[key] = ns
+		for i := 0; i < src.Len(); i++ {
+			c.copy(ns.Index(i), src.Index(i))
+		}
+		dst.Set(ns)
+
+	case reflect.Array:
+		for i := 0; i < src.Len(); i++ {
+			c.copy(dst.Index(i), src.Index(i))
+		}
+
+	case reflect.Struct:
+		if src.Type() == timeType {
+			dst.Set(src)
+			return
+		}
+		if c.copyUnexported && !src.CanAddr() {
+			tmp := reflect.New(src.Type()).Elem()
+			tmp.Set(src)
+			src = tmp
+		}
+		t := src.Type()
+		for i := 0; i < t.NumField(); i++ {
+			df, sf := dst.Field(i), src.Field(i)
+			if t.Field(i).PkgPath != "" {
+				if !c.copyUnexported {
+					continue
+				}
+				df = reflect.NewAt(df.Type(), unsafe.Pointer(df.UnsafeAddr())).Elem()
+				sf = reflect.NewAt(sf.Type(), unsafe.Pointer(sf.UnsafeAddr())).Elem()
+			}
+			c.copy(df, sf)
+		}
+
+	default:
+		dst.Set(src)
+	}
+}
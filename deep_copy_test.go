@@ -0,0 +1,65 @@
+package goutil
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type dcNode struct {
+	Name     string
+	Next     *dcNode
+	Children []*dcNode
+	Attrs    map[string]interface{}
+	At       time.Time
+	secret   []int
+}
+
+func TestDeepCopy(t *testing.T) {
+	src := &dcNode{
+		Name:  "root",
+		Attrs: map[string]interface{}{"list": []int{1, 2}, "n": 1},
+		At:    time.Now(),
+	}
+	child := &dcNode{Name: "child", Next: src, secret: []int{9}}
+	src.Next = child
+	src.Children = []*dcNode{child, child}
+
+	var dst dcNode
+	if err := DeepCopy(&dst, src); err != nil {
+		t.Fatal(err)
+	}
+	if dst.Name != "root" || !dst.At.Equal(src.At) {
+		t.Fatalf("got %+v", dst)
+	}
+	if dst.Next == child || dst.Next.Next != dst.Next.Next.Next.Next {
+		t.Fatal("cyclic references are not kept")
+	}
+	if dst.Children[0] != dst.Children[1] || dst.Children[0] != dst.Next {
+		t.Fatal("shared pointers are not kept")
+	}
+	if dst.Next.secret != nil {
+		t.Fatal("unexported field should be skipped")
+	}
+	dst.Attrs["list"].([]int)[0] = 100
+	if src.Attrs["list"].([]int)[0] != 1 {
+		t.Fatal("nested slice is shared")
+	}
+
+	var dst2 dcNode
+	if err := DeepCopy(&dst2, *child, true); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(dst2.secret, []int{9}) {
+		t.Fatalf("unexported field is not copied: %v", dst2.secret)
+	}
+	dst2.secret[0] = 0
+	if child.secret[0] != 9 {
+		t.Fatal("unexported slice is shared")
+	}
+
+	var n int
+	if err := DeepCopy(&n, "x"); err == nil {
+		t.Fatal("expect type mismatch error")
+	}
+}
@@ -0,0 +1,98 @@
+package goutil
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/henrylee2cn/goutil/graceful"
+)
+
+// DeployOptions is the options of DeployBinary.
+type DeployOptions struct {
+	// Checksum is verified by VerifyChecksum if not empty.
+	Checksum string
+	// VersionArgs are the arguments to probe the new binary with, e.g. []string{"-version"},
+	// which must exit with 0. The probe is skipped if empty.
+	VersionArgs []string
+	// ExpectVersion must be contained in the output of the probe if not empty.
+	ExpectVersion string
+	// ProbeTimeout is the timeout of the probe, 10s by default.
+	ProbeTimeout time.Duration
+	// Reboot triggers graceful.Reboot after the switch, with RebootTimeout if it is positive.
+	Reboot        bool
+	RebootTimeout time.Duration
+}
+
+// DeployBinary validates the new binary by the exec bit, checksum and version probe,
+// then atomically switches the symbolic link currentSymlink to it.
+// The process should be started by currentSymlink, so that graceful.Reboot starts the new binary.
+// opts may be nil.
+func DeployBinary(newPath, currentSymlink string, opts *DeployOptions) error {
+	if opts == nil {
+		opts = new(DeployOptions)
+	}
+	newPath, err := filepath.Abs(newPath)
+	if err != nil {
+		return err
+	}
+	fi, err := os.Stat(newPath)
+	if err != nil {
+		return err
+	}
+	if !fi.Mode().IsRegular() {
+		return errors.New("goutil: deploying binary is not a regular file: " + newPath)
+	}
+	if runtime.GOOS != "windows" && fi.Mode().Perm()&0111 == 0 {
+		return errors.New("goutil: deploying binary is not executable: " + newPath)
+	}
+	if opts.Checksum != "" {
+		if err = VerifyChecksum(newPath, opts.Checksum); err != nil {
+			return err
+		}
+	}
+	if len(opts.VersionArgs) > 0 {
+		if err = probeBinary(newPath, opts); err != nil {
+			return err
+		}
+	}
+	tmp := currentSymlink + ".tmp" + strconv.Itoa(os.Getpid())
+	os.Remove(tmp)
+	if err = os.Symlink(newPath, tmp); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, currentSymlink); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if opts.Reboot {
+		if opts.RebootTimeout > 0 {
+			graceful.Reboot(opts.RebootTimeout)
+		} else {
+			graceful.Reboot()
+		}
+	}
+	return nil
+}
+
+func probeBinary(path string, opts *DeployOptions) error {
+	timeout := opts.ProbeTimeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	res, err := RunCommandWith(context.Background(), &CommandOptions{Timeout: timeout}, path, opts.VersionArgs...)
+	if err != nil {
+		return errors.New("goutil: deploying binary probe failed: " + err.Error())
+	}
+	if opts.ExpectVersion != "" &&
+		!strings.Contains(string(res.Stdout), opts.ExpectVersion) &&
+		!strings.Contains(string(res.Stderr), opts.ExpectVersion) {
+		return errors.New("goutil: deploying binary version mismatch, expect " + opts.ExpectVersion)
+	}
+	return nil
+}
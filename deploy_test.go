@@ -0,0 +1,48 @@
+package goutil
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestDeployBinary(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		t.Skip("requires /bin/sh")
+	}
+	dir, err := ioutil.TempDir("", "deploy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	bin := filepath.Join(dir, "app-v2")
+	ioutil.WriteFile(bin, []byte("#!/bin/sh\necho app v2.0.1\n"), 0755)
+	link := filepath.Join(dir, "app")
+	os.Symlink(filepath.Join(dir, "app-v1"), link)
+	sum, _ := FileSHA256(context.Background(), bin)
+
+	err = DeployBinary(bin, link, &DeployOptions{
+		Checksum:      "sha256:" + sum,
+		VersionArgs:   []string{"-version"},
+		ExpectVersion: "v2.0.1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := os.Readlink(link); got != bin {
+		t.Fatalf("got link %q", got)
+	}
+	if err = DeployBinary(bin, link, &DeployOptions{VersionArgs: []string{"-version"}, ExpectVersion: "v3"}); err == nil {
+		t.Fatal("expect version mismatch")
+	}
+	if err = DeployBinary(bin, link, &DeployOptions{Checksum: "sha256:00"}); err == nil {
+		t.Fatal("expect checksum mismatch")
+	}
+	os.Chmod(bin, 0644)
+	if err = DeployBinary(bin, link, nil); err == nil {
+		t.Fatal("expect not executable")
+	}
+}
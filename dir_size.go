@@ -0,0 +1,21 @@
+package goutil
+
+import "os"
+
+// DirSize returns the total bytes and the number of the regular files under the directory,
+// reading the directories in parallel by workers goroutines, runtime.NumCPU() by default.
+// The symbolic links are not followed.
+func DirSize(path string, workers ...int) (size int64, files int, err error) {
+	opts := &WalkOptions{Types: WalkTypeFile}
+	if len(workers) > 0 {
+		opts.Workers = workers[0]
+	}
+	err = WalkDir(path, opts, func(_ string, info os.FileInfo) error {
+		if info.Mode().IsRegular() {
+			size += info.Size()
+			files++
+		}
+		return nil
+	})
+	return size, files, err
+}
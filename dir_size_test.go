@@ -0,0 +1,29 @@
+package goutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDirSize(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dirsize")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	os.MkdirAll(filepath.Join(dir, "a", "b"), 0755)
+	ioutil.WriteFile(filepath.Join(dir, "x"), make([]byte, 100), 0644)
+	ioutil.WriteFile(filepath.Join(dir, "a", "y"), make([]byte, 20), 0644)
+	ioutil.WriteFile(filepath.Join(dir, "a", "b", "z"), make([]byte, 3), 0644)
+	for _, workers := range []int{1, 4} {
+		size, files, err := DirSize(dir, workers)
+		if err != nil || size != 123 || files != 3 {
+			t.Fatalf("got %d, %d, %v", size, files, err)
+		}
+	}
+	if _, _, err = DirSize(filepath.Join(dir, "none")); !os.IsNotExist(err) {
+		t.Fatalf("got %v", err)
+	}
+}
@@ -0,0 +1,49 @@
+package goutil
+
+import "errors"
+
+// ErrDiskUsageUnsupported is returned by DiskUsage on the unsupported platforms.
+var ErrDiskUsageUnsupported = errors.New("goutil: disk usage is not supported on this platform")
+
+// DiskUsageStat is the usage of the file system.
+type DiskUsageStat struct {
+	// Total is the size of the file system in bytes.
+	Total uint64
+	// Free is the free bytes, including the ones reserved for root.
+	Free uint64
+	// Available is the free bytes available to the unprivileged user.
+	Available uint64
+	// Used is Total-Free.
+	Used uint64
+	// Inodes is the total number of the inodes, 0 if unknown (e.g. on Windows).
+	Inodes uint64
+	// InodesFree is the number of the free inodes.
+	InodesFree uint64
+}
+
+// UsedPercent returns the used percent of the space available to the unprivileged user,
+// like the Use% of the df command.
+func (s DiskUsageStat) UsedPercent() float64 {
+	if s.Used+s.Available == 0 {
+		return 0
+	}
+	return float64(s.Used) / float64(s.Used+s.Available) * 100
+}
+
+func newDiskUsageStat(blockSize, blocks, free, avail, files, filesFree uint64) DiskUsageStat {
+	return DiskUsageStat{
+		Total:      blocks * blockSize,
+		Free:       free * blockSize,
+		Available:  avail * blockSize,
+		Used:       (blocks - free) * blockSize,
+		Inodes:     files,
+		InodesFree: filesFree,
+	}
+}
+
+func nonNegative(n int64) uint64 {
+	if n < 0 {
+		return 0
+	}
+	return uint64(n)
+}
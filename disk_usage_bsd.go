@@ -0,0 +1,16 @@
+//go:build darwin || freebsd || dragonfly
+// +build darwin freebsd dragonfly
+
+package goutil
+
+import "syscall"
+
+// DiskUsage returns the usage of the file system containing the path.
+func DiskUsage(path string) (DiskUsageStat, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return DiskUsageStat{}, err
+	}
+	return newDiskUsageStat(uint64(st.Bsize), uint64(st.Blocks), uint64(st.Bfree),
+		nonNegative(int64(st.Bavail)), uint64(st.Files), nonNegative(int64(st.Ffree))), nil
+}
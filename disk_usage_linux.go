@@ -0,0 +1,16 @@
+package goutil
+
+import "syscall"
+
+// DiskUsage returns the usage of the file system containing the path.
+func DiskUsage(path string) (DiskUsageStat, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return DiskUsageStat{}, err
+	}
+	bsize := uint64(st.Frsize)
+	if bsize == 0 {
+		bsize = uint64(st.Bsize)
+	}
+	return newDiskUsageStat(bsize, st.Blocks, st.Bfree, st.Bavail, st.Files, st.Ffree), nil
+}
@@ -0,0 +1,13 @@
+package goutil
+
+import "syscall"
+
+// DiskUsage returns the usage of the file system containing the path.
+func DiskUsage(path string) (DiskUsageStat, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return DiskUsageStat{}, err
+	}
+	return newDiskUsageStat(uint64(st.F_bsize), st.F_blocks, st.F_bfree,
+		nonNegative(st.F_bavail), st.F_files, st.F_ffree), nil
+}
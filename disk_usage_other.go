@@ -0,0 +1,9 @@
+//go:build !linux && !darwin && !freebsd && !dragonfly && !openbsd && !windows
+// +build !linux,!darwin,!freebsd,!dragonfly,!openbsd,!windows
+
+package goutil
+
+// DiskUsage returns ErrDiskUsageUnsupported on this platform.
+func DiskUsage(path string) (DiskUsageStat, error) {
+	return DiskUsageStat{}, ErrDiskUsageUnsupported
+}
@@ -0,0 +1,25 @@
+package goutil
+
+import (
+	"os"
+	"testing"
+)
+
+func TestDiskUsage(t *testing.T) {
+	st, err := DiskUsage(os.TempDir())
+	if err == ErrDiskUsageUnsupported {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Total == 0 || st.Free > st.Total || st.Available > st.Free || st.Used != st.Total-st.Free {
+		t.Fatalf("unexpected usage: %+v", st)
+	}
+	if p := st.UsedPercent(); p < 0 || p > 100 {
+		t.Fatalf("used percent: %v", p)
+	}
+	if _, err = DiskUsage("/not/exist/path"); err == nil {
+		t.Fatal("expect error")
+	}
+}
@@ -0,0 +1,23 @@
+package goutil
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+var procGetDiskFreeSpaceExW = modkernel32.NewProc("GetDiskFreeSpaceExW")
+
+// DiskUsage returns the usage of the volume containing the path.
+func DiskUsage(path string) (DiskUsageStat, error) {
+	p, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return DiskUsageStat{}, err
+	}
+	var avail, total, free uint64
+	r, _, err := procGetDiskFreeSpaceExW.Call(uintptr(unsafe.Pointer(p)),
+		uintptr(unsafe.Pointer(&avail)), uintptr(unsafe.Pointer(&total)), uintptr(unsafe.Pointer(&free)))
+	if r == 0 {
+		return DiskUsageStat{}, err
+	}
+	return newDiskUsageStat(1, total, free, avail, 0, 0), nil
+}
@@ -0,0 +1,194 @@
+package goutil
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DownloadOptions is the options of DownloadFile.
+type DownloadOptions struct {
+	// Client is http.DefaultClient by default.
+	Client *http.Client
+	Header http.Header
+	// Checksum is verified by VerifyChecksum if not empty.
+	Checksum string
+	// Retries is the max number of the retries on the network errors, 5xx and 429 responses.
+	Retries int
+	// RetryDelay is the initial delay of the retries, doubled each time up to 30s, 1s by default.
+	RetryDelay time.Duration
+	// BytesPerSecond limits the bandwidth if positive.
+	BytesPerSecond int64
+	// Progress is called with the downloaded bytes and the total bytes, which is -1 if unknown.
+	Progress func(done, total int64)
+}
+
+type downloadError struct {
+	err       error
+	retryable bool
+}
+
+func (e *downloadError) Error() string {
+	return e.err.Error()
+}
+
+// DownloadFile downloads the url into the file dest.
+// The content is written to dest+".part" first, which is resumed by the Range request
+// on retry or the next call, and renamed to dest after the checksum is verified.
+// opts may be nil.
+func DownloadFile(ctx context.Context, url, dest string, opts *DownloadOptions) error {
+	if opts == nil {
+		opts = new(DownloadOptions)
+	}
+	delay := opts.RetryDelay
+	if delay <= 0 {
+		delay = time.Second
+	}
+	backoff := NewBackoff(&BackoffOptions{Initial: delay, Max: 30 * time.Second})
+	part := dest + ".part"
+	for i := 0; ; i++ {
+		err := downloadOnce(ctx, url, part, opts)
+		if err == nil && opts.Checksum != "" {
+			if err = VerifyChecksum(part, opts.Checksum); err != nil {
+				// the part may be corrupted, so starts over
+				os.Remove(part)
+				err = &downloadError{err: err, retryable: true}
+			}
+		}
+		if err == nil {
+			return os.Rename(part, dest)
+		}
+		de, ok := err.(*downloadError)
+		if !ok {
+			return err
+		}
+		if !de.retryable || i >= opts.Retries || ctx.Err() != nil {
+			return de.err
+		}
+		if err = SleepContext(ctx, backoff.Next()); err != nil {
+			return err
+		}
+	}
+}
+
+func downloadOnce(ctx context.Context, url, part string, opts *DownloadOptions) error {
+	var offset int64
+	if fi, err := os.Stat(part); err == nil {
+		offset = fi.Size()
+	}
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	for k, v := range opts.Header {
+		req.Header[k] = v
+	}
+	if offset > 0 {
+		req.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+	}
+	client := opts.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return &downloadError{err: err, retryable: true}
+	}
+	defer resp.Body.Close()
+
+	flag := os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	total := int64(-1)
+	switch resp.StatusCode {
+	case http.StatusOK:
+		offset = 0
+		flag |= os.O_TRUNC
+		if resp.ContentLength >= 0 {
+			total = resp.ContentLength
+		}
+	case http.StatusPartialContent:
+		start, size := parseContentRange(resp.Header.Get("Content-Range"))
+		if start != offset {
+			os.Remove(part)
+			return &downloadError{err: errors.New("goutil: download got unexpected Content-Range"), retryable: true}
+		}
+		total = size
+	case http.StatusRequestedRangeNotSatisfiable:
+		if offset > 0 {
+			// the part is complete
+			return nil
+		}
+		fallthrough
+	default:
+		code := resp.StatusCode
+		return &downloadError{
+			err:       errors.New("goutil: download got HTTP status " + resp.Status),
+			retryable: code >= 500 || code == http.StatusTooManyRequests,
+		}
+	}
+
+	f, err := os.OpenFile(part, flag, 0644)
+	if err != nil {
+		return err
+	}
+	_, err = copyThrottled(ctx, f, resp.Body, offset, total, opts)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// parseContentRange parses "bytes start-end/size", and the size is -1 if it is "*".
+func parseContentRange(s string) (start, size int64) {
+	s = strings.TrimPrefix(s, "bytes ")
+	i, j := strings.IndexByte(s, '-'), strings.IndexByte(s, '/')
+	if i < 0 || j < i {
+		return -1, -1
+	}
+	start, err := strconv.ParseInt(s[:i], 10, 64)
+	if err != nil {
+		return -1, -1
+	}
+	size, err = strconv.ParseInt(s[j+1:], 10, 64)
+	if err != nil {
+		size = -1
+	}
+	return start, size
+}
+
+func copyThrottled(ctx context.Context, w io.Writer, r io.Reader, done, total int64, opts *DownloadOptions) (int64, error) {
+	buf := make([]byte, 32*1024)
+	start := time.Now()
+	var written int64
+	for {
+		n, rerr := r.Read(buf)
+		if n > 0 {
+			if _, err := w.Write(buf[:n]); err != nil {
+				return written, err
+			}
+			written += int64(n)
+			if opts.Progress != nil {
+				opts.Progress(done+written, total)
+			}
+			if opts.BytesPerSecond > 0 {
+				expect := time.Duration(float64(written) / float64(opts.BytesPerSecond) * float64(time.Second))
+				if d := expect - time.Since(start); d > 0 {
+					if err := SleepContext(ctx, d); err != nil {
+						return written, err
+					}
+				}
+			}
+		}
+		if rerr == io.EOF {
+			return written, nil
+		}
+		if rerr != nil {
+			return written, &downloadError{err: rerr, retryable: true}
+		}
+	}
+}
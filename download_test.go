@@ -0,0 +1,89 @@
+package goutil
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDownloadFile(t *testing.T) {
+	content := strings.Repeat("0123456789", 1000)
+	var fails int32 = 1
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Range") == "" && atomic.AddInt32(&fails, -1) >= 0 {
+			// sends a half and breaks the connection
+			w.Header().Set("Content-Length", "10000")
+			w.Write([]byte(content[:5000]))
+			return
+		}
+		http.ServeContent(w, r, "f", time.Time{}, strings.NewReader(content))
+	}))
+	defer srv.Close()
+
+	dir, err := ioutil.TempDir("", "download")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	dest := filepath.Join(dir, "f")
+	sum := sha256.Sum256([]byte(content))
+	var done, total int64
+	err = DownloadFile(context.Background(), srv.URL, dest, &DownloadOptions{
+		Checksum:   "sha256:" + hex.EncodeToString(sum[:]),
+		Retries:    2,
+		RetryDelay: time.Millisecond,
+		Progress:   func(d, t int64) { done, total = d, t },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := ioutil.ReadFile(dest)
+	if string(b) != content || done != 10000 || total != 10000 {
+		t.Fatalf("got %d bytes, progress %d/%d", len(b), done, total)
+	}
+	if _, err = os.Stat(dest + ".part"); !os.IsNotExist(err) {
+		t.Fatalf("got %v", err)
+	}
+
+	err = DownloadFile(context.Background(), srv.URL+"/x", dest, &DownloadOptions{Checksum: "sha256:00"})
+	if err != ErrChecksumMismatch {
+		t.Fatalf("got %v", err)
+	}
+}
+
+func TestDownloadFileThrottle(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/404" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(make([]byte, 20000))
+	}))
+	defer srv.Close()
+	dir, err := ioutil.TempDir("", "download")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	start := time.Now()
+	err = DownloadFile(context.Background(), srv.URL, filepath.Join(dir, "f"), &DownloadOptions{BytesPerSecond: 100000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d < 150*time.Millisecond {
+		t.Fatalf("not throttled: %v", d)
+	}
+	err = DownloadFile(context.Background(), srv.URL+"/404", filepath.Join(dir, "g"), &DownloadOptions{Retries: 3})
+	if err == nil || !strings.Contains(err.Error(), "404") {
+		t.Fatalf("got %v", err)
+	}
+}
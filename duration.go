@@ -0,0 +1,160 @@
+package goutil
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// ErrInvalidDuration is returned when parsing an invalid duration.
+var ErrInvalidDuration = errors.New("goutil: invalid duration")
+
+const (
+	// Day is 24 hours.
+	Day = 24 * time.Hour
+	// Week is 7 days.
+	Week = 7 * Day
+	// Month is 30 days, as the unit "M" of ParseDuration.
+	Month = 30 * Day
+	// Year is 365 days, as the unit "y" of ParseDuration.
+	Year = 365 * Day
+)
+
+var durationUnits = map[string]time.Duration{
+	"ns": time.Nanosecond,
+	"us": time.Microsecond,
+	"µs": time.Microsecond, // U+00B5
+	"μs": time.Microsecond, // U+03BC
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  Day,
+	"w":  Week,
+	"M":  Month,
+	"y":  Year,
+}
+
+// ParseDuration parses the duration like time.ParseDuration,
+// additionally supporting the units "d" (day), "w" (week), "M" (30 days) and "y" (365 days),
+// e.g. "1d12h", "2w" and "-1.5d".
+func ParseDuration(s string) (time.Duration, error) {
+	var neg bool
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	if s == "0" {
+		return 0, nil
+	}
+	if s == "" {
+		return 0, ErrInvalidDuration
+	}
+	var total uint64
+	for s != "" {
+		// the integer and fraction parts
+		i := 0
+		for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.') {
+			i++
+		}
+		num := s[:i]
+		s = s[i:]
+		intPart, frac := num, ""
+		if dot := strings.IndexByte(num, '.'); dot >= 0 {
+			intPart, frac = num[:dot], num[dot+1:]
+			if strings.IndexByte(frac, '.') >= 0 {
+				return 0, ErrInvalidDuration
+			}
+		}
+		if intPart == "" && frac == "" {
+			return 0, ErrInvalidDuration
+		}
+		// the unit
+		i = 0
+		for i < len(s) && s[i] != '.' && (s[i] < '0' || s[i] > '9') {
+			i++
+		}
+		unit, ok := durationUnits[s[:i]]
+		if !ok {
+			return 0, ErrInvalidDuration
+		}
+		s = s[i:]
+		var v uint64
+		for _, c := range intPart {
+			if v > (1<<63)/10 {
+				return 0, ErrInvalidDuration
+			}
+			v = v*10 + uint64(c-'0')
+		}
+		if v > (1<<63)/uint64(unit) {
+			return 0, ErrInvalidDuration
+		}
+		v *= uint64(unit)
+		if frac != "" {
+			f, scale := float64(0), float64(1)
+			for _, c := range frac {
+				f = f*10 + float64(c-'0')
+				scale *= 10
+			}
+			v += uint64(f * (float64(unit) / scale))
+		}
+		if total += v; total > 1<<63 {
+			return 0, ErrInvalidDuration
+		}
+	}
+	if neg {
+		return -time.Duration(total), nil
+	}
+	if total > 1<<63-1 {
+		return 0, ErrInvalidDuration
+	}
+	return time.Duration(total), nil
+}
+
+var formatUnits = [...]struct {
+	size time.Duration
+	name string
+}{
+	{Year, "y"}, {Week, "w"}, {Day, "d"}, {time.Hour, "h"}, {time.Minute, "m"},
+	{time.Second, "s"}, {time.Millisecond, "ms"}, {time.Microsecond, "us"}, {time.Nanosecond, "ns"},
+}
+
+// FormatDuration formats the duration rounded to the precision, using the units
+// "y" (365 days), "w", "d", "h", "m", "s", "ms", "us" and "ns" and omitting the zero ones,
+// e.g. FormatDuration(36*time.Hour+10*time.Minute, time.Hour) returns "1d12h".
+// The result can be parsed by ParseDuration.
+func FormatDuration(d, precision time.Duration) string {
+	if precision > 0 {
+		d = d.Round(precision)
+	}
+	if d == 0 {
+		return "0s"
+	}
+	var b []byte
+	u := uint64(d)
+	if d < 0 {
+		b = append(b, '-')
+		u = -u
+	}
+	for _, unit := range formatUnits {
+		if n := u / uint64(unit.size); n > 0 {
+			b = appendUint(b, n)
+			b = append(b, unit.name...)
+			u %= uint64(unit.size)
+		}
+	}
+	return string(b)
+}
+
+func appendUint(b []byte, n uint64) []byte {
+	var buf [20]byte
+	i := len(buf)
+	for {
+		i--
+		buf[i] = byte('0' + n%10)
+		if n /= 10; n == 0 {
+			break
+		}
+	}
+	return append(b, buf[i:]...)
+}
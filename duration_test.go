@@ -0,0 +1,55 @@
+package goutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	cases := map[string]time.Duration{
+		"0":       0,
+		"1d12h":   36 * time.Hour,
+		"2w":      14 * Day,
+		"1M":      30 * Day,
+		"1y1m":    Year + time.Minute,
+		"-1.5d":   -36 * time.Hour,
+		"+.5h":    30 * time.Minute,
+		"1h30m5s": time.Hour + 30*time.Minute + 5*time.Second,
+		"250ms":   250 * time.Millisecond,
+		"3µs":     3 * time.Microsecond,
+		"7ns":     7,
+	}
+	for s, want := range cases {
+		if got, err := ParseDuration(s); err != nil || got != want {
+			t.Errorf("%q: got %v, %v, want %v", s, got, err, want)
+		}
+	}
+	for _, s := range []string{"", "-", "d", "1", "1x", "1..5h", "1.2.3h", "300y"} {
+		if _, err := ParseDuration(s); err != ErrInvalidDuration {
+			t.Errorf("%q: got %v", s, err)
+		}
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	cases := []struct {
+		d, precision time.Duration
+		want         string
+	}{
+		{0, 0, "0s"},
+		{36*time.Hour + 10*time.Minute, time.Hour, "1d12h"},
+		{15*Day + time.Second, time.Minute, "2w1d"},
+		{time.Hour + 1500*time.Millisecond, 0, "1h1s500ms"},
+		{-90 * time.Second, time.Second, "-1m30s"},
+		{Year + Day, Day, "1y1d"},
+	}
+	for _, c := range cases {
+		got := FormatDuration(c.d, c.precision)
+		if got != c.want {
+			t.Errorf("%v: got %q, want %q", c.d, got, c.want)
+		}
+		if d, err := ParseDuration(got); err != nil || d != c.d.Round(c.precision) && c.precision > 0 {
+			t.Errorf("%q: parsed %v, %v", got, d, err)
+		}
+	}
+}
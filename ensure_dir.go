@@ -0,0 +1,72 @@
+package goutil
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrSymlinkInPath is returned by EnsureDir when a path component is a symbolic link.
+var ErrSymlinkInPath = errors.New("goutil: symlinked component in path")
+
+// EnsureDir creates the directory with its missing parents, like os.MkdirAll,
+// setting the mode (regardless of the umask) and the owner on the created ones.
+// The uid or gid -1 means not changing it.
+// If fixExisting is true, the existing directory path is also given the mode and owner.
+// For security, it refuses to follow any symbolic link in the path,
+// so resolve the trusted prefix by filepath.EvalSymlinks first if necessary.
+func EnsureDir(path string, mode os.FileMode, uid, gid int, fixExisting ...bool) error {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	vol := filepath.VolumeName(path)
+	cur := vol + string(filepath.Separator)
+	rest := strings.TrimPrefix(path[len(vol):], string(filepath.Separator))
+	parts := strings.Split(rest, string(filepath.Separator))
+	for i, part := range parts {
+		if part == "" {
+			continue
+		}
+		cur = filepath.Join(cur, part)
+		last := i == len(parts)-1
+		info, err := os.Lstat(cur)
+		switch {
+		case os.IsNotExist(err):
+			if err = os.Mkdir(cur, mode); err != nil && !os.IsExist(err) {
+				return err
+			}
+			if info, err = os.Lstat(cur); err != nil {
+				return err
+			}
+			if info.Mode()&os.ModeSymlink != 0 {
+				return &os.PathError{Op: "ensuredir", Path: cur, Err: ErrSymlinkInPath}
+			}
+			if err = setDirOwnerMode(cur, mode, uid, gid); err != nil {
+				return err
+			}
+		case err != nil:
+			return err
+		case info.Mode()&os.ModeSymlink != 0:
+			return &os.PathError{Op: "ensuredir", Path: cur, Err: ErrSymlinkInPath}
+		case !info.IsDir():
+			return &os.PathError{Op: "ensuredir", Path: cur, Err: errors.New("not a directory")}
+		case last && len(fixExisting) > 0 && fixExisting[0]:
+			if err = setDirOwnerMode(cur, mode, uid, gid); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func setDirOwnerMode(path string, mode os.FileMode, uid, gid int) error {
+	if err := os.Chmod(path, mode); err != nil {
+		return err
+	}
+	if uid == -1 && gid == -1 {
+		return nil
+	}
+	return os.Chown(path, uid, gid)
+}
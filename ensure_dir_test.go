@@ -0,0 +1,64 @@
+package goutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestEnsureDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goutil_ensure")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if dir, err = filepath.EvalSymlinks(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	target := filepath.Join(dir, "a", "b", "c")
+	if err = EnsureDir(target, 0700, -1, -1); err != nil {
+		t.Fatal(err)
+	}
+	if runtime.GOOS != "windows" {
+		for _, p := range []string{filepath.Join(dir, "a"), target} {
+			info, err := os.Stat(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if info.Mode().Perm() != 0700 {
+				t.Fatalf("%s: mode %v", p, info.Mode())
+			}
+		}
+		os.Chmod(target, 0755)
+		if err = EnsureDir(target, 0700, -1, -1); err != nil {
+			t.Fatal(err)
+		}
+		if info, _ := os.Stat(target); info.Mode().Perm() != 0755 {
+			t.Fatalf("existing dir should be kept: %v", info.Mode())
+		}
+		if err = EnsureDir(target, 0700, os.Getuid(), -1, true); err != nil {
+			t.Fatal(err)
+		}
+		if info, _ := os.Stat(target); info.Mode().Perm() != 0700 {
+			t.Fatalf("existing dir should be fixed: %v", info.Mode())
+		}
+
+		link := filepath.Join(dir, "link")
+		if err = os.Symlink(filepath.Join(dir, "a"), link); err != nil {
+			t.Fatal(err)
+		}
+		err = EnsureDir(filepath.Join(link, "d"), 0700, -1, -1)
+		if pe, ok := err.(*os.PathError); !ok || pe.Err != ErrSymlinkInPath {
+			t.Fatalf("expect ErrSymlinkInPath, got %v", err)
+		}
+	}
+
+	file := filepath.Join(dir, "file")
+	ioutil.WriteFile(file, nil, 0644)
+	if err = EnsureDir(filepath.Join(file, "x"), 0700, -1, -1); err == nil {
+		t.Fatal("expect not a directory error")
+	}
+}
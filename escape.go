@@ -0,0 +1,134 @@
+package goutil
+
+import (
+	"html"
+	"strings"
+	"unicode/utf8"
+)
+
+// EscapeHTML escapes the special characters <, >, &, ' and " for HTML text and
+// quoted attribute values.
+func EscapeHTML(s string) string {
+	return html.EscapeString(s)
+}
+
+const hexDigits = "0123456789abcdef"
+
+// EscapeJSONString escapes s as the content of a JSON string (without the quotes),
+// the same as encoding/json: <, > and & are escaped for embedding in HTML,
+// and the invalid UTF-8 is replaced with U+FFFD.
+func EscapeJSONString(s string) string {
+	var b []byte
+	start := 0
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			b = append(b, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				b = append(b, '\\', c)
+			case '\n':
+				b = append(b, '\\', 'n')
+			case '\r':
+				b = append(b, '\\', 'r')
+			case '\t':
+				b = append(b, '\\', 't')
+			default:
+				b = append(b, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xf])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			b = append(b, s[start:i]...)
+			b = append(b, "\ufffd"...)
+			i += size
+			start = i
+			continue
+		}
+		// U+2028 and U+2029 are invalid in JavaScript strings
+		if r == '\u2028' || r == '\u2029' {
+			b = append(b, s[start:i]...)
+			b = append(b, '\\', 'u', '2', '0', '2', hexDigits[r&0xf])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	if b == nil {
+		return s
+	}
+	return string(append(b, s[start:]...))
+}
+
+// EscapeSQLLike escapes the wildcards % and _ and the escape character itself
+// in s for the SQL LIKE pattern, the escape character is '\' by default.
+// NOTE: it does not quote the value, use the query parameters for that, e.g.
+//
+//	db.Query("SELECT * FROM t WHERE name LIKE ? ESCAPE '\\'", "%"+EscapeSQLLike(s)+"%")
+func EscapeSQLLike(s string, escape ...byte) string {
+	esc := byte('\\')
+	if len(escape) > 0 {
+		esc = escape[0]
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '%' || c == '_' || c == esc {
+			if b.Len() == 0 && i > 0 {
+				b.WriteString(s[:i])
+			}
+			b.WriteByte(esc)
+			b.WriteByte(c)
+		} else if b.Len() > 0 {
+			b.WriteByte(c)
+		}
+	}
+	if b.Len() == 0 {
+		return s
+	}
+	return b.String()
+}
+
+// ShellQuote quotes s as a single POSIX shell argument,
+// s is returned unchanged if it contains only the safe characters.
+func ShellQuote(s string) string {
+	if s == "" {
+		return "''"
+	}
+	safe := true
+	for i := 0; i < len(s); i++ {
+		if !isShellSafe(s[i]) {
+			safe = false
+			break
+		}
+	}
+	if safe {
+		return s
+	}
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
+
+// ShellJoin quotes each of the args by ShellQuote and joins them with spaces.
+func ShellJoin(args ...string) string {
+	quoted := make([]string, len(args))
+	for i, a := range args {
+		quoted[i] = ShellQuote(a)
+	}
+	return strings.Join(quoted, " ")
+}
+
+func isShellSafe(c byte) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		return true
+	}
+	return strings.IndexByte("_@%+=:,./-", c) >= 0
+}
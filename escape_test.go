@@ -0,0 +1,56 @@
+package goutil
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEscapeHTML(t *testing.T) {
+	if got := EscapeHTML(`<a href="x">'Tom' & Jerry</a>`); got != "&lt;a href=&#34;x&#34;&gt;&#39;Tom&#39; &amp; Jerry&lt;/a&gt;" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestEscapeJSONString(t *testing.T) {
+	for _, s := range []string{"plain", "quote\" back\\slash", "ctrl\n\r\t\x01", "<script>&", "line\u2028sep\u2029", "中文", "bad\xffutf8"} {
+		expect, _ := json.Marshal(s)
+		if got := `"` + EscapeJSONString(s) + `"`; got != string(expect) {
+			t.Errorf("EscapeJSONString(%q) = %s, expect %s", s, got, expect)
+		}
+	}
+}
+
+func TestEscapeSQLLike(t *testing.T) {
+	for s, expect := range map[string]string{
+		"plain":      "plain",
+		"100%":       `100\%`,
+		"a_b\\c":     `a\_b\\c`,
+		"%_":         `\%\_`,
+		"ends with_": `ends with\_`,
+	} {
+		if got := EscapeSQLLike(s); got != expect {
+			t.Errorf("EscapeSQLLike(%q) = %q, expect %q", s, got, expect)
+		}
+	}
+	if got := EscapeSQLLike("a!%", '!'); got != "a!!!%" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestShellQuote(t *testing.T) {
+	for s, expect := range map[string]string{
+		"":            "''",
+		"simple-path": "simple-path",
+		"/usr/bin/go": "/usr/bin/go",
+		"a b":         "'a b'",
+		"it's":        `'it'\''s'`,
+		"$HOME;rm":    "'$HOME;rm'",
+	} {
+		if got := ShellQuote(s); got != expect {
+			t.Errorf("ShellQuote(%q) = %s, expect %s", s, got, expect)
+		}
+	}
+	if got := ShellJoin("echo", "hello world", ""); got != "echo 'hello world' ''" {
+		t.Errorf("got %s", got)
+	}
+}
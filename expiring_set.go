@@ -0,0 +1,121 @@
+package goutil
+
+import (
+	"sync"
+	"time"
+
+	"github.com/henrylee2cn/goutil/timewheel"
+)
+
+// ExpiringSetOptions is the options of NewExpiringSet.
+type ExpiringSetOptions struct {
+	// Wheel drives the expirations, which may be shared by the sets.
+	// A wheel of the 10ms tick owned by the set is created by default.
+	Wheel *timewheel.TimingWheel
+	// OnEvict is called in its own goroutine with the expired key, if not nil.
+	// It is not called for the keys removed by Remove.
+	OnEvict func(key interface{})
+}
+
+// ExpiringSet is a set of the keys expiring after their TTLs, driven by a timing wheel
+// instead of the per-key runtime timers, e.g. for the dedup of the recently seen message ids.
+// It is safe for concurrent use.
+type ExpiringSet struct {
+	mu       sync.Mutex
+	items    map[interface{}]*expiringEntry
+	wheel    *timewheel.TimingWheel
+	ownWheel bool
+	onEvict  func(key interface{})
+}
+
+type expiringEntry struct {
+	deadline time.Time
+	timer    *timewheel.Timer
+}
+
+// NewExpiringSet creates an expiring set. opts may be nil.
+func NewExpiringSet(opts *ExpiringSetOptions) *ExpiringSet {
+	s := &ExpiringSet{items: make(map[interface{}]*expiringEntry)}
+	if opts != nil {
+		s.wheel = opts.Wheel
+		s.onEvict = opts.OnEvict
+	}
+	if s.wheel == nil {
+		s.wheel = timewheel.New(nil)
+		s.ownWheel = true
+	}
+	return s
+}
+
+// Add adds the key expiring after the ttl, or refreshes the ttl if the key exists.
+// It returns true if the key is new or expired.
+func (s *ExpiringSet) Add(key interface{}, ttl time.Duration) bool {
+	now := time.Now()
+	deadline := now.Add(ttl)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.items[key]; ok {
+		expired := !now.Before(e.deadline)
+		e.deadline = deadline
+		e.timer.Reset(ttl)
+		return expired
+	}
+	e := &expiringEntry{deadline: deadline}
+	e.timer = s.wheel.AfterFunc(ttl, func() { s.expire(key, e) })
+	s.items[key] = e
+	return true
+}
+
+func (s *ExpiringSet) expire(key interface{}, e *expiringEntry) {
+	s.mu.Lock()
+	if s.items[key] != e {
+		s.mu.Unlock()
+		return
+	}
+	if d := time.Until(e.deadline); d > 0 {
+		// fired before the refreshed deadline
+		e.timer.Reset(d)
+		s.mu.Unlock()
+		return
+	}
+	delete(s.items, key)
+	s.mu.Unlock()
+	if s.onEvict != nil {
+		s.onEvict(key)
+	}
+}
+
+// Contains reports whether the key is in the set and not expired.
+func (s *ExpiringSet) Contains(key interface{}) bool {
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.items[key]
+	return ok && now.Before(e.deadline)
+}
+
+// Remove removes the key, and returns false if it is not in the set.
+func (s *ExpiringSet) Remove(key interface{}) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.items[key]
+	if ok {
+		e.timer.Stop()
+		delete(s.items, key)
+	}
+	return ok
+}
+
+// Len returns the number of the keys, including the expired ones not evicted yet.
+func (s *ExpiringSet) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.items)
+}
+
+// Close stops the wheel owned by the set, after which the keys are no longer evicted.
+func (s *ExpiringSet) Close() {
+	if s.ownWheel {
+		s.wheel.Stop()
+	}
+}
@@ -0,0 +1,78 @@
+package goutil
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/henrylee2cn/goutil/timewheel"
+)
+
+func TestExpiringSet(t *testing.T) {
+	evicted := make(chan interface{}, 10)
+	s := NewExpiringSet(&ExpiringSetOptions{OnEvict: func(key interface{}) { evicted <- key }})
+	defer s.Close()
+	if !s.Add("a", 30*time.Millisecond) || s.Add("a", 30*time.Millisecond) {
+		t.Fatal("unexpected Add")
+	}
+	s.Add("b", time.Hour)
+	s.Add("c", time.Hour)
+	if !s.Contains("a") || s.Len() != 3 {
+		t.Fatal("unexpected Contains")
+	}
+	if !s.Remove("c") || s.Remove("c") {
+		t.Fatal("unexpected Remove")
+	}
+	select {
+	case key := <-evicted:
+		if key != "a" {
+			t.Fatalf("evicted %v", key)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout")
+	}
+	if s.Contains("a") || !s.Contains("b") || s.Len() != 1 {
+		t.Fatal("a should be evicted")
+	}
+}
+
+func TestExpiringSetRefresh(t *testing.T) {
+	wheel := timewheel.New(&timewheel.Options{Tick: time.Millisecond})
+	defer wheel.Stop()
+	evicted := make(chan interface{}, 1)
+	s := NewExpiringSet(&ExpiringSetOptions{Wheel: wheel, OnEvict: func(key interface{}) { evicted <- key }})
+	defer s.Close()
+	start := time.Now()
+	s.Add(1, 30*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	s.Add(1, 30*time.Millisecond)
+	select {
+	case <-evicted:
+		if d := time.Since(start); d < 50*time.Millisecond {
+			t.Fatalf("evicted after %v, before the refreshed ttl", d)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout")
+	}
+}
+
+func TestExpiringSetConcurrent(t *testing.T) {
+	s := NewExpiringSet(nil)
+	defer s.Close()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				key := j % 16
+				if i%2 == 0 {
+					s.Add(key, time.Duration(j%5)*time.Millisecond)
+				} else {
+					s.Contains(key)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
@@ -11,9 +11,16 @@ import (
 	"strings"
 )
 
-// SelfPath gets compiled executable file absolute path.
+// SelfPath gets compiled executable file absolute path, with the symbolic links resolved.
 func SelfPath() string {
-	path, _ := filepath.Abs(os.Args[0])
+	path, err := os.Executable()
+	if err != nil {
+		path = os.Args[0]
+	}
+	if p, err := filepath.EvalSymlinks(path); err == nil {
+		path = p
+	}
+	path, _ = filepath.Abs(path)
 	return path
 }
 
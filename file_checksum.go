@@ -0,0 +1,103 @@
+package goutil
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// ErrChecksumMismatch is returned by VerifyChecksum when the file doesn't match the sum.
+var ErrChecksumMismatch = errors.New("goutil: checksum mismatch")
+
+var checksumBufPool = sync.Pool{New: func() interface{} {
+	b := make([]byte, 32<<10)
+	return &b
+}}
+
+// FileChecksum streams the file into h with a pooled buffer and returns the sum,
+// it stops early with the ctx error if the ctx is done.
+func FileChecksum(ctx context.Context, path string, h hash.Hash) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	bp := checksumBufPool.Get().(*[]byte)
+	defer checksumBufPool.Put(bp)
+	buf := *bp
+	for {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := f.Read(buf)
+		h.Write(buf[:n])
+		if err == io.EOF {
+			return h.Sum(nil), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// FileMD5 returns the hex MD5 of the file.
+func FileMD5(ctx context.Context, path string) (string, error) {
+	return fileHexSum(ctx, path, md5.New())
+}
+
+// FileSHA256 returns the hex SHA-256 of the file.
+func FileSHA256(ctx context.Context, path string) (string, error) {
+	return fileHexSum(ctx, path, sha256.New())
+}
+
+// FileXXHash returns the hex 64-bit xxHash of the file, in big endian.
+func FileXXHash(ctx context.Context, path string) (string, error) {
+	return fileHexSum(ctx, path, NewXXHash64())
+}
+
+func fileHexSum(ctx context.Context, path string, h hash.Hash) (string, error) {
+	sum, err := FileChecksum(ctx, path, h)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(sum), nil
+}
+
+// VerifyChecksum checks the file against the hex sum, returning ErrChecksumMismatch if not matched.
+// The sum may have an algorithm prefix "md5:", "sha256:" or "xxhash:",
+// otherwise it must be a SHA-256 sum, so the weak or non-cryptographic sums
+// are only used when explicitly asked.
+func VerifyChecksum(path, sum string) error {
+	algo := ""
+	if i := strings.IndexByte(sum, ':'); i >= 0 {
+		algo, sum = strings.ToLower(sum[:i]), sum[i+1:]
+	} else if len(sum) == 2*sha256.Size {
+		algo = "sha256"
+	}
+	var h hash.Hash
+	switch algo {
+	case "md5":
+		h = md5.New()
+	case "sha256":
+		h = sha256.New()
+	case "xxhash":
+		h = NewXXHash64()
+	default:
+		return errors.New("goutil: unknown checksum algorithm of " + sum)
+	}
+	got, err := fileHexSum(context.Background(), path, h)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(got, sum) {
+		return ErrChecksumMismatch
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package goutil
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestFileChecksum(t *testing.T) {
+	f, err := ioutil.TempFile("", "goutil_checksum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("hello world")
+	f.Close()
+
+	ctx := context.Background()
+	md5sum, err := FileMD5(ctx, f.Name())
+	if err != nil || md5sum != "5eb63bbbe01eeed093cb22bb8f5acdc3" {
+		t.Fatal(md5sum, err)
+	}
+	sha, err := FileSHA256(ctx, f.Name())
+	if err != nil || sha != "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9" {
+		t.Fatal(sha, err)
+	}
+	xx, err := FileXXHash(ctx, f.Name())
+	if err != nil || xx != fmt.Sprintf("%016x", XXHash64([]byte("hello world"))) {
+		t.Fatal(xx, err)
+	}
+
+	for _, sum := range []string{"md5:" + md5sum, strings.ToUpper(sha), "xxhash:" + xx, "sha256:" + sha} {
+		if err = VerifyChecksum(f.Name(), sum); err != nil {
+			t.Fatalf("%s: %v", sum, err)
+		}
+	}
+	for _, sum := range []string{md5sum, xx} {
+		if err = VerifyChecksum(f.Name(), sum); err == nil || err == ErrChecksumMismatch {
+			t.Fatalf("%s: expect unknown algorithm error, got %v", sum, err)
+		}
+	}
+	if err = VerifyChecksum(f.Name(), "md5:"+strings.Repeat("0", 32)); err != ErrChecksumMismatch {
+		t.Fatalf("expect mismatch, got %v", err)
+	}
+	if err = VerifyChecksum(f.Name(), "abc"); err == nil {
+		t.Fatal("expect unknown algorithm error")
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err = FileMD5(cancelled, f.Name()); err != context.Canceled {
+		t.Fatalf("expect canceled, got %v", err)
+	}
+}
+
+func TestNewXXHash64(t *testing.T) {
+	data := make([]byte, 300)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	for n := 0; n <= len(data); n += 13 {
+		for _, step := range []int{1, 5, 31, 32, 64} {
+			h := NewXXHash64()
+			for b := data[:n]; len(b) > 0; {
+				c := step
+				if c > len(b) {
+					c = len(b)
+				}
+				h.Write(b[:c])
+				b = b[c:]
+			}
+			if h.Sum64() != XXHash64(data[:n]) {
+				t.Fatalf("len %d step %d: mismatch", n, step)
+			}
+		}
+	}
+}
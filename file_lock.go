@@ -0,0 +1,80 @@
+package goutil
+
+import (
+	"errors"
+	"os"
+	"sync"
+)
+
+// ErrFileLockUnsupported is returned on the platforms without file locking support.
+var ErrFileLockUnsupported = errors.New("goutil: file locking is not supported on this platform")
+
+// FileLock is an advisory exclusive lock on a file, shared across processes,
+// built on flock on Unix and LockFileEx on Windows.
+// The lock file is created if it does not exist, and is kept after unlocking.
+type FileLock struct {
+	path string
+	mu   sync.Mutex
+	f    *os.File
+}
+
+// NewFileLock creates a *FileLock on the file path.
+func NewFileLock(path string) *FileLock {
+	return &FileLock{path: path}
+}
+
+// Path returns the path of the lock file.
+func (l *FileLock) Path() string {
+	return l.path
+}
+
+// Lock blocks until the lock is acquired.
+// It is an error to lock the locked FileLock again.
+func (l *FileLock) Lock() error {
+	return l.lock(true)
+}
+
+// TryLock tries to acquire the lock without blocking,
+// and reports whether the lock is acquired.
+func (l *FileLock) TryLock() (bool, error) {
+	err := l.lock(false)
+	if err == errFileLocked {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+var errFileLocked = errors.New("goutil: file is locked")
+
+func (l *FileLock) lock(block bool) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.f != nil {
+		return errors.New("goutil: FileLock is already locked: " + l.path)
+	}
+	f, err := os.OpenFile(l.path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	if err = lockFile(f, block); err != nil {
+		f.Close()
+		return err
+	}
+	l.f = f
+	return nil
+}
+
+// Unlock releases the lock.
+func (l *FileLock) Unlock() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.f == nil {
+		return errors.New("goutil: FileLock is not locked: " + l.path)
+	}
+	err := unlockFile(l.f)
+	if cerr := l.f.Close(); err == nil {
+		err = cerr
+	}
+	l.f = nil
+	return err
+}
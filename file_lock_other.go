@@ -0,0 +1,14 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !windows
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!windows
+
+package goutil
+
+import "os"
+
+func lockFile(f *os.File, block bool) error {
+	return ErrFileLockUnsupported
+}
+
+func unlockFile(f *os.File) error {
+	return ErrFileLockUnsupported
+}
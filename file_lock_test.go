@@ -0,0 +1,51 @@
+package goutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileLock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goutil_lock")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "data.lock")
+	a, b := NewFileLock(path), NewFileLock(path)
+	if err = a.Lock(); err != nil {
+		t.Fatal(err)
+	}
+	if err = a.Lock(); err == nil {
+		t.Fatal("expect error for locking twice")
+	}
+	if ok, err := b.TryLock(); ok || err != nil {
+		t.Fatalf("TryLock = %v, %v; expect false", ok, err)
+	}
+	locked := make(chan error)
+	go func() { locked <- b.Lock() }()
+	select {
+	case <-locked:
+		t.Fatal("Lock should block")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if err = a.Unlock(); err != nil {
+		t.Fatal(err)
+	}
+	if err = <-locked; err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Unlock(); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Unlock(); err == nil {
+		t.Fatal("expect error for unlocking twice")
+	}
+	if ok, err := a.TryLock(); !ok || err != nil {
+		t.Fatalf("TryLock = %v, %v; expect true", ok, err)
+	}
+	a.Unlock()
+}
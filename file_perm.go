@@ -0,0 +1,55 @@
+package goutil
+
+import "os"
+
+// FilePerm is the permission and ownership of a file, portable across Unix and Windows.
+type FilePerm struct {
+	// Mode is the permission bits. On Windows, only the owner write bit is meaningful,
+	// which is cleared for the read-only files.
+	Mode os.FileMode
+	// Uid and Gid are -1 on Windows.
+	Uid int
+	Gid int
+	// Owner and Group are the user and group names, which are the account names
+	// of the owner and group SIDs on Windows, or empty if unknown.
+	Owner string
+	Group string
+	// sd is the self-relative security descriptor on Windows.
+	sd []byte
+}
+
+// ReadOnly reports whether the owner can't write the file.
+func (p *FilePerm) ReadOnly() bool {
+	return p.Mode&0200 == 0
+}
+
+// GetFilePerm returns the permission and ownership of the file,
+// including the ACL on Windows.
+func GetFilePerm(path string) (*FilePerm, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	p := &FilePerm{Mode: fi.Mode().Perm(), Uid: -1, Gid: -1}
+	return p, getFilePerm(path, fi, p)
+}
+
+// SetFilePerm sets the permission of the file, and the ownership if it differs,
+// which usually requires the root on Unix.
+// On Windows, it sets the read-only attribute and the ACL got by GetFilePerm,
+// and the owner is only kept if the process has the privilege.
+func SetFilePerm(path string, p *FilePerm) error {
+	if err := os.Chmod(path, p.Mode); err != nil {
+		return err
+	}
+	return setFilePerm(path, p)
+}
+
+// CopyFilePerm copies the permission and ownership from src to dst.
+func CopyFilePerm(src, dst string) error {
+	p, err := GetFilePerm(src)
+	if err != nil {
+		return err
+	}
+	return SetFilePerm(dst, p)
+}
@@ -0,0 +1,18 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !windows
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!windows
+
+package goutil
+
+import "os"
+
+func getFilePerm(path string, fi os.FileInfo, p *FilePerm) error {
+	return nil
+}
+
+func setFilePerm(path string, p *FilePerm) error {
+	return nil
+}
+
+func copyACL(src, dst string) error {
+	return nil
+}
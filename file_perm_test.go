@@ -0,0 +1,40 @@
+package goutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestCopyFilePerm(t *testing.T) {
+	dir, err := ioutil.TempDir("", "perm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	src, dst := filepath.Join(dir, "src"), filepath.Join(dir, "dst")
+	ioutil.WriteFile(src, nil, 0444)
+	ioutil.WriteFile(dst, nil, 0666)
+	p, err := GetFilePerm(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.ReadOnly() {
+		t.Fatalf("got %+v", p)
+	}
+	if runtime.GOOS != "windows" && runtime.GOOS != "plan9" && (p.Uid != os.Getuid() || p.Gid < 0) {
+		t.Fatalf("got %+v", p)
+	}
+	if err = CopyFilePerm(src, dst); err != nil {
+		t.Fatal(err)
+	}
+	q, err := GetFilePerm(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Mode != p.Mode || q.Owner != p.Owner || q.Uid != p.Uid {
+		t.Fatalf("got %+v, expect %+v", q, p)
+	}
+}
@@ -0,0 +1,45 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+// +build darwin dragonfly freebsd linux netbsd openbsd
+
+package goutil
+
+import (
+	"os"
+	"os/user"
+	"strconv"
+	"syscall"
+)
+
+func getFilePerm(path string, fi os.FileInfo, p *FilePerm) error {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return nil
+	}
+	p.Uid, p.Gid = int(st.Uid), int(st.Gid)
+	if u, err := user.LookupId(strconv.Itoa(p.Uid)); err == nil {
+		p.Owner = u.Username
+	}
+	if g, err := user.LookupGroupId(strconv.Itoa(p.Gid)); err == nil {
+		p.Group = g.Name
+	}
+	return nil
+}
+
+func setFilePerm(path string, p *FilePerm) error {
+	if p.Uid < 0 && p.Gid < 0 {
+		return nil
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if st, ok := fi.Sys().(*syscall.Stat_t); ok && int(st.Uid) == p.Uid && int(st.Gid) == p.Gid {
+		return nil
+	}
+	return os.Chown(path, p.Uid, p.Gid)
+}
+
+// copyACL is a no-op on Unix, where the permission bits are the whole permission.
+func copyACL(src, dst string) error {
+	return nil
+}
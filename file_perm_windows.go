@@ -0,0 +1,117 @@
+//go:build windows
+// +build windows
+
+package goutil
+
+import (
+	"os"
+	"reflect"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	modadvapi32                     = syscall.NewLazyDLL("advapi32.dll")
+	procGetNamedSecurityInfoW       = modadvapi32.NewProc("GetNamedSecurityInfoW")
+	procSetNamedSecurityInfoW       = modadvapi32.NewProc("SetNamedSecurityInfoW")
+	procGetSecurityDescriptorLength = modadvapi32.NewProc("GetSecurityDescriptorLength")
+	procGetSecurityDescriptorOwner  = modadvapi32.NewProc("GetSecurityDescriptorOwner")
+	procGetSecurityDescriptorGroup  = modadvapi32.NewProc("GetSecurityDescriptorGroup")
+	procGetSecurityDescriptorDacl   = modadvapi32.NewProc("GetSecurityDescriptorDacl")
+)
+
+const (
+	seFileObject              = 1
+	ownerSecurityInformation  = 0x1
+	groupSecurityInformation  = 0x2
+	daclSecurityInformation   = 0x4
+	errorInvalidOwner         = syscall.Errno(1307)
+	errorPrivilegeNotHeld     = syscall.Errno(1314)
+	fileSecurityInformationRW = ownerSecurityInformation | groupSecurityInformation | daclSecurityInformation
+)
+
+// getSecurityDescriptor returns a copy of the self-relative security descriptor of the file.
+func getSecurityDescriptor(path string) ([]byte, error) {
+	name, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return nil, err
+	}
+	var sd *byte
+	r, _, _ := procGetNamedSecurityInfoW.Call(uintptr(unsafe.Pointer(name)), seFileObject,
+		fileSecurityInformationRW, 0, 0, 0, 0, uintptr(unsafe.Pointer(&sd)))
+	if r != 0 {
+		return nil, os.NewSyscallError("GetNamedSecurityInfo", syscall.Errno(r))
+	}
+	defer syscall.LocalFree(syscall.Handle(uintptr(unsafe.Pointer(sd))))
+	n, _, _ := procGetSecurityDescriptorLength.Call(uintptr(unsafe.Pointer(sd)))
+	var src []byte
+	hdr := (*reflect.SliceHeader)(unsafe.Pointer(&src))
+	hdr.Data, hdr.Len, hdr.Cap = uintptr(unsafe.Pointer(sd)), int(n), int(n)
+	return append([]byte(nil), src...), nil
+}
+
+func sdParts(sd []byte) (owner, group *syscall.SID, dacl *byte) {
+	var defaulted, present int32
+	procGetSecurityDescriptorOwner.Call(uintptr(unsafe.Pointer(&sd[0])), uintptr(unsafe.Pointer(&owner)), uintptr(unsafe.Pointer(&defaulted)))
+	procGetSecurityDescriptorGroup.Call(uintptr(unsafe.Pointer(&sd[0])), uintptr(unsafe.Pointer(&group)), uintptr(unsafe.Pointer(&defaulted)))
+	procGetSecurityDescriptorDacl.Call(uintptr(unsafe.Pointer(&sd[0])), uintptr(unsafe.Pointer(&present)), uintptr(unsafe.Pointer(&dacl)), uintptr(unsafe.Pointer(&defaulted)))
+	return
+}
+
+func sidAccount(sid *syscall.SID) string {
+	if sid == nil {
+		return ""
+	}
+	account, domain, _, err := sid.LookupAccount("")
+	if err != nil {
+		s, _ := sid.String()
+		return s
+	}
+	if domain != "" {
+		return domain + `\` + account
+	}
+	return account
+}
+
+func getFilePerm(path string, fi os.FileInfo, p *FilePerm) error {
+	sd, err := getSecurityDescriptor(path)
+	if err != nil {
+		return err
+	}
+	p.sd = sd
+	owner, group, _ := sdParts(sd)
+	p.Owner, p.Group = sidAccount(owner), sidAccount(group)
+	return nil
+}
+
+func setFilePerm(path string, p *FilePerm) error {
+	if len(p.sd) == 0 {
+		return nil
+	}
+	name, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return err
+	}
+	owner, group, dacl := sdParts(p.sd)
+	r, _, _ := procSetNamedSecurityInfoW.Call(uintptr(unsafe.Pointer(name)), seFileObject, fileSecurityInformationRW,
+		uintptr(unsafe.Pointer(owner)), uintptr(unsafe.Pointer(group)), uintptr(unsafe.Pointer(dacl)), 0)
+	switch syscall.Errno(r) {
+	case syscall.ERROR_ACCESS_DENIED, errorInvalidOwner, errorPrivilegeNotHeld:
+		// setting the owner requires the privilege, so only sets the DACL
+		r, _, _ = procSetNamedSecurityInfoW.Call(uintptr(unsafe.Pointer(name)), seFileObject, daclSecurityInformation,
+			0, 0, uintptr(unsafe.Pointer(dacl)), 0)
+	}
+	if r != 0 {
+		return os.NewSyscallError("SetNamedSecurityInfo", syscall.Errno(r))
+	}
+	return nil
+}
+
+// copyACL copies the ACL of src to dst, which the permission bits can't express.
+func copyACL(src, dst string) error {
+	sd, err := getSecurityDescriptor(src)
+	if err != nil {
+		return err
+	}
+	return setFilePerm(dst, &FilePerm{sd: sd})
+}
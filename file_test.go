@@ -1,6 +1,7 @@
 package goutil
 
 import (
+	"os"
 	"path/filepath"
 	"reflect"
 	"testing"
@@ -22,6 +23,8 @@ func TestSelfDir(t *testing.T) {
 }
 
 func TestSelfChdir(t *testing.T) {
+	wd, _ := os.Getwd()
+	defer os.Chdir(wd)
 	SelfChdir()
 	path, err := filepath.Abs("a")
 	t.Logf("SelfChdir: %s %v", path, err)
@@ -0,0 +1,114 @@
+package fs
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func testFS(t *testing.T, fsys FS, root string) {
+	dir := filepath.Join(root, "a", "b")
+	if err := fsys.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	name := filepath.Join(dir, "x.txt")
+	if err := WriteFile(fsys, name, []byte("hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := fsys.OpenFile(name, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Write([]byte(" world"))
+	f.Close()
+	b, err := ReadFile(fsys, name)
+	if err != nil || string(b) != "hello world" {
+		t.Fatalf("got %q, %v", b, err)
+	}
+	f, _ = fsys.Open(name)
+	buf := make([]byte, 5)
+	if n, err := f.ReadAt(buf, 6); n != 5 || string(buf) != "world" {
+		t.Fatalf("got %q, %v", buf, err)
+	}
+	if _, err = f.Write([]byte("x")); err == nil {
+		t.Fatal("expect writing the read-only file failed")
+	}
+	f.Close()
+	if _, err = fsys.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644); !os.IsExist(err) {
+		t.Fatalf("got %v", err)
+	}
+	tmp, err := fsys.TempFile(dir, ".x.tmp*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmp.Write([]byte("new"))
+	tmp.Close()
+	if err = fsys.Rename(tmp.Name(), name); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ = ReadFile(fsys, name); string(b) != "new" {
+		t.Fatalf("got %q", b)
+	}
+	fsys.Mkdir(filepath.Join(dir, "c"), 0755)
+	infos, err := fsys.ReadDir(dir)
+	if err != nil || len(infos) != 2 || infos[0].Name() != "c" || !infos[0].IsDir() || infos[1].Name() != "x.txt" || infos[1].Size() != 3 {
+		t.Fatalf("got %v, %v", infos, err)
+	}
+	d, _ := fsys.Open(dir)
+	if infos, err = d.Readdir(1); len(infos) != 1 || err != nil {
+		t.Fatalf("got %v, %v", infos, err)
+	}
+	d.Readdir(1)
+	if _, err = d.Readdir(1); err != io.EOF {
+		t.Fatalf("got %v", err)
+	}
+	d.Close()
+	if err = fsys.Remove(filepath.Join(root, "a")); err == nil {
+		t.Fatal("expect removing the non-empty directory failed")
+	}
+	if err = fsys.Rename(filepath.Join(root, "a"), filepath.Join(root, "z")); err != nil {
+		t.Fatal(err)
+	}
+	if !Exists(fsys, filepath.Join(root, "z", "b", "x.txt")) || Exists(fsys, name) {
+		t.Fatal("rename did not move the children")
+	}
+	if err = fsys.RemoveAll(filepath.Join(root, "z")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = fsys.Stat(filepath.Join(root, "z", "b")); !os.IsNotExist(err) {
+		t.Fatalf("got %v", err)
+	}
+}
+
+func TestOS(t *testing.T) {
+	root, err := ioutil.TempDir("", "fs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	testFS(t, OS, root)
+}
+
+func TestMemFS(t *testing.T) {
+	testFS(t, NewMemFS(), "/data")
+}
+
+func TestMemFSReaddirRemoved(t *testing.T) {
+	fsys := NewMemFS()
+	fsys.MkdirAll("/d", 0755)
+	for _, name := range []string{"/d/a", "/d/b", "/d/c"} {
+		WriteFile(fsys, name, nil, 0644)
+	}
+	d, _ := fsys.Open("/d")
+	defer d.Close()
+	if infos, err := d.Readdir(2); len(infos) != 2 || err != nil {
+		t.Fatalf("got %v, %v", infos, err)
+	}
+	fsys.Remove("/d/b")
+	fsys.Remove("/d/c")
+	if infos, err := d.Readdir(1); len(infos) != 0 || err != io.EOF {
+		t.Fatalf("got %v, %v", infos, err)
+	}
+}
@@ -0,0 +1,498 @@
+package fs
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	errIsDir       = errors.New("is a directory")
+	errNotDir      = errors.New("not a directory")
+	errNotEmpty    = errors.New("directory not empty")
+	errBadFileMode = errors.New("bad file descriptor")
+)
+
+// MemFS is an in-memory FS for the tests, safe for concurrent use.
+// The paths are slash-cleaned and relative to the root "/", and the symbolic links are not supported.
+type MemFS struct {
+	mu    sync.RWMutex
+	nodes map[string]*memNode
+	seq   uint64
+}
+
+type memNode struct {
+	mode    os.FileMode
+	modTime time.Time
+	data    []byte
+}
+
+// NewMemFS creates an empty *MemFS with the root directory.
+func NewMemFS() *MemFS {
+	return &MemFS{nodes: map[string]*memNode{
+		"/": {mode: os.ModeDir | 0755, modTime: time.Now()},
+	}}
+}
+
+func memPath(name string) string {
+	return path.Clean("/" + filepath.ToSlash(name))
+}
+
+func memErr(op, name string, err error) error {
+	return &os.PathError{Op: op, Path: name, Err: err}
+}
+
+// parentDirLocked checks the parent of p is an existing directory.
+func (m *MemFS) parentDirLocked(op, name, p string) error {
+	parent, ok := m.nodes[path.Dir(p)]
+	if !ok {
+		return memErr(op, name, os.ErrNotExist)
+	}
+	if !parent.mode.IsDir() {
+		return memErr(op, name, errNotDir)
+	}
+	return nil
+}
+
+// Open opens the file for reading.
+func (m *MemFS) Open(name string) (File, error) {
+	return m.OpenFile(name, os.O_RDONLY, 0)
+}
+
+// Create creates or truncates the file.
+func (m *MemFS) Create(name string) (File, error) {
+	return m.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
+}
+
+// OpenFile opens the file with the flag and perm.
+func (m *MemFS) OpenFile(name string, flag int, perm os.FileMode) (File, error) {
+	p := memPath(name)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n, ok := m.nodes[p]
+	switch {
+	case ok && flag&(os.O_CREATE|os.O_EXCL) == os.O_CREATE|os.O_EXCL:
+		return nil, memErr("open", name, os.ErrExist)
+	case !ok && flag&os.O_CREATE == 0:
+		return nil, memErr("open", name, os.ErrNotExist)
+	case !ok:
+		if err := m.parentDirLocked("open", name, p); err != nil {
+			return nil, err
+		}
+		n = &memNode{mode: perm.Perm(), modTime: time.Now()}
+		m.nodes[p] = n
+	case n.mode.IsDir() && flag&(os.O_WRONLY|os.O_RDWR) != 0:
+		return nil, memErr("open", name, errIsDir)
+	}
+	if flag&os.O_TRUNC != 0 && !n.mode.IsDir() {
+		n.data = nil
+		n.modTime = time.Now()
+	}
+	return &memFile{fs: m, node: n, name: name, path: p, flag: flag}, nil
+}
+
+// Stat returns the FileInfo of the file.
+func (m *MemFS) Stat(name string) (os.FileInfo, error) {
+	p := memPath(name)
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	n, ok := m.nodes[p]
+	if !ok {
+		return nil, memErr("stat", name, os.ErrNotExist)
+	}
+	return n.info(path.Base(p)), nil
+}
+
+// Lstat is the same as Stat.
+func (m *MemFS) Lstat(name string) (os.FileInfo, error) {
+	return m.Stat(name)
+}
+
+// Mkdir creates the directory.
+func (m *MemFS) Mkdir(name string, perm os.FileMode) error {
+	p := memPath(name)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.nodes[p]; ok {
+		return memErr("mkdir", name, os.ErrExist)
+	}
+	if err := m.parentDirLocked("mkdir", name, p); err != nil {
+		return err
+	}
+	m.nodes[p] = &memNode{mode: os.ModeDir | perm.Perm(), modTime: time.Now()}
+	return nil
+}
+
+// MkdirAll creates the directory with the missing parents.
+func (m *MemFS) MkdirAll(name string, perm os.FileMode) error {
+	p := memPath(name)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var missing []string
+	for q := p; ; q = path.Dir(q) {
+		n, ok := m.nodes[q]
+		if ok {
+			if !n.mode.IsDir() {
+				return memErr("mkdir", name, errNotDir)
+			}
+			break
+		}
+		missing = append(missing, q)
+	}
+	now := time.Now()
+	for _, q := range missing {
+		m.nodes[q] = &memNode{mode: os.ModeDir | perm.Perm(), modTime: now}
+	}
+	return nil
+}
+
+func (m *MemFS) hasChildLocked(p string) bool {
+	prefix := strings.TrimSuffix(p, "/") + "/"
+	for q := range m.nodes {
+		if strings.HasPrefix(q, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Remove removes the file or the empty directory.
+func (m *MemFS) Remove(name string) error {
+	p := memPath(name)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n, ok := m.nodes[p]
+	if !ok {
+		return memErr("remove", name, os.ErrNotExist)
+	}
+	if n.mode.IsDir() && m.hasChildLocked(p) {
+		return memErr("remove", name, errNotEmpty)
+	}
+	if p != "/" {
+		delete(m.nodes, p)
+	}
+	return nil
+}
+
+// RemoveAll removes the path and its children, and returns nil if it doesn't exist.
+func (m *MemFS) RemoveAll(name string) error {
+	p := memPath(name)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	prefix := strings.TrimSuffix(p, "/") + "/"
+	for q := range m.nodes {
+		if q == p || strings.HasPrefix(q, prefix) {
+			delete(m.nodes, q)
+		}
+	}
+	if p == "/" {
+		m.nodes[p] = &memNode{mode: os.ModeDir | 0755, modTime: time.Now()}
+	}
+	return nil
+}
+
+// Rename moves oldpath to newpath, replacing the existing file or empty directory.
+func (m *MemFS) Rename(oldpath, newpath string) error {
+	op, np := memPath(oldpath), memPath(newpath)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n, ok := m.nodes[op]
+	if !ok {
+		return memErr("rename", oldpath, os.ErrNotExist)
+	}
+	if op == np {
+		return nil
+	}
+	if err := m.parentDirLocked("rename", newpath, np); err != nil {
+		return err
+	}
+	if strings.HasPrefix(np, op+"/") {
+		return memErr("rename", newpath, os.ErrInvalid)
+	}
+	if dst, ok := m.nodes[np]; ok {
+		if dst.mode.IsDir() != n.mode.IsDir() {
+			if dst.mode.IsDir() {
+				return memErr("rename", newpath, errIsDir)
+			}
+			return memErr("rename", newpath, errNotDir)
+		}
+		if dst.mode.IsDir() && m.hasChildLocked(np) {
+			return memErr("rename", newpath, errNotEmpty)
+		}
+	}
+	prefix := op + "/"
+	for q, c := range m.nodes {
+		if strings.HasPrefix(q, prefix) {
+			delete(m.nodes, q)
+			m.nodes[np+"/"+q[len(prefix):]] = c
+		}
+	}
+	delete(m.nodes, op)
+	m.nodes[np] = n
+	return nil
+}
+
+// Chmod changes the permission bits of the file.
+func (m *MemFS) Chmod(name string, mode os.FileMode) error {
+	p := memPath(name)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n, ok := m.nodes[p]
+	if !ok {
+		return memErr("chmod", name, os.ErrNotExist)
+	}
+	n.mode = n.mode&^os.ModePerm | mode.Perm()
+	return nil
+}
+
+// Chtimes changes the modification time of the file.
+func (m *MemFS) Chtimes(name string, atime, mtime time.Time) error {
+	p := memPath(name)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n, ok := m.nodes[p]
+	if !ok {
+		return memErr("chtimes", name, os.ErrNotExist)
+	}
+	n.modTime = mtime
+	return nil
+}
+
+// ReadDir returns the entries of the directory sorted by name.
+func (m *MemFS) ReadDir(dirname string) ([]os.FileInfo, error) {
+	p := memPath(dirname)
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.readDirLocked("readdir", dirname, p)
+}
+
+func (m *MemFS) readDirLocked(op, name, p string) ([]os.FileInfo, error) {
+	n, ok := m.nodes[p]
+	if !ok {
+		return nil, memErr(op, name, os.ErrNotExist)
+	}
+	if !n.mode.IsDir() {
+		return nil, memErr(op, name, errNotDir)
+	}
+	prefix := strings.TrimSuffix(p, "/") + "/"
+	var infos []os.FileInfo
+	for q, c := range m.nodes {
+		if strings.HasPrefix(q, prefix) && !strings.Contains(q[len(prefix):], "/") {
+			infos = append(infos, c.info(q[len(prefix):]))
+		}
+	}
+	sortInfos(infos)
+	return infos, nil
+}
+
+// TempFile creates a new file in dir, whose name is pattern with the last "*"
+// replaced by a unique string, or appended if there is no "*".
+func (m *MemFS) TempFile(dir, pattern string) (File, error) {
+	if dir == "" {
+		dir = os.TempDir()
+		if err := m.MkdirAll(dir, 0700); err != nil {
+			return nil, err
+		}
+	}
+	prefix, suffix := pattern, ""
+	if i := strings.LastIndex(pattern, "*"); i >= 0 {
+		prefix, suffix = pattern[:i], pattern[i+1:]
+	}
+	for {
+		m.mu.Lock()
+		m.seq++
+		seq := m.seq
+		m.mu.Unlock()
+		name := filepath.Join(dir, prefix+strconv.FormatUint(seq, 10)+suffix)
+		f, err := m.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
+		if os.IsExist(err) {
+			continue
+		}
+		return f, err
+	}
+}
+
+func (n *memNode) info(name string) os.FileInfo {
+	return &memFileInfo{name: name, size: int64(len(n.data)), mode: n.mode, modTime: n.modTime}
+}
+
+type memFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (fi *memFileInfo) Name() string       { return fi.name }
+func (fi *memFileInfo) Size() int64        { return fi.size }
+func (fi *memFileInfo) Mode() os.FileMode  { return fi.mode }
+func (fi *memFileInfo) ModTime() time.Time { return fi.modTime }
+func (fi *memFileInfo) IsDir() bool        { return fi.mode.IsDir() }
+func (fi *memFileInfo) Sys() interface{}   { return nil }
+
+type memFile struct {
+	fs     *MemFS
+	node   *memNode
+	name   string
+	path   string
+	flag   int
+	offset int64
+	dirPos int
+	closed bool
+}
+
+func (f *memFile) check(op string, write bool) error {
+	if f.closed {
+		return memErr(op, f.name, os.ErrClosed)
+	}
+	if write && f.flag&(os.O_WRONLY|os.O_RDWR) == 0 || !write && f.flag&os.O_WRONLY != 0 {
+		return memErr(op, f.name, errBadFileMode)
+	}
+	if f.node.mode.IsDir() && op != "readdir" && op != "close" && op != "stat" {
+		return memErr(op, f.name, errIsDir)
+	}
+	return nil
+}
+
+func (f *memFile) Name() string {
+	return f.name
+}
+
+func (f *memFile) Read(p []byte) (int, error) {
+	n, err := f.ReadAt(p, f.offset)
+	f.offset += int64(n)
+	return n, err
+}
+
+func (f *memFile) ReadAt(p []byte, off int64) (int, error) {
+	if err := f.check("read", false); err != nil {
+		return 0, err
+	}
+	if off < 0 {
+		return 0, memErr("read", f.name, os.ErrInvalid)
+	}
+	f.fs.mu.RLock()
+	defer f.fs.mu.RUnlock()
+	if off >= int64(len(f.node.data)) {
+		return 0, io.EOF
+	}
+	n := copy(p, f.node.data[off:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (f *memFile) Write(p []byte) (int, error) {
+	if err := f.check("write", true); err != nil {
+		return 0, err
+	}
+	f.fs.mu.Lock()
+	defer f.fs.mu.Unlock()
+	if f.flag&os.O_APPEND != 0 {
+		f.offset = int64(len(f.node.data))
+	}
+	end := f.offset + int64(len(p))
+	if end > int64(len(f.node.data)) {
+		data := make([]byte, end)
+		copy(data, f.node.data)
+		f.node.data = data
+	}
+	copy(f.node.data[f.offset:], p)
+	f.offset = end
+	f.node.modTime = time.Now()
+	return len(p), nil
+}
+
+func (f *memFile) Seek(offset int64, whence int) (int64, error) {
+	if f.closed {
+		return 0, memErr("seek", f.name, os.ErrClosed)
+	}
+	f.fs.mu.RLock()
+	size := int64(len(f.node.data))
+	f.fs.mu.RUnlock()
+	switch whence {
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		offset += size
+	}
+	if offset < 0 {
+		return 0, memErr("seek", f.name, os.ErrInvalid)
+	}
+	f.offset = offset
+	return offset, nil
+}
+
+func (f *memFile) Close() error {
+	if f.closed {
+		return memErr("close", f.name, os.ErrClosed)
+	}
+	f.closed = true
+	return nil
+}
+
+func (f *memFile) Stat() (os.FileInfo, error) {
+	if f.closed {
+		return nil, memErr("stat", f.name, os.ErrClosed)
+	}
+	f.fs.mu.RLock()
+	defer f.fs.mu.RUnlock()
+	return f.node.info(path.Base(f.path)), nil
+}
+
+func (f *memFile) Sync() error {
+	if f.closed {
+		return memErr("sync", f.name, os.ErrClosed)
+	}
+	return nil
+}
+
+func (f *memFile) Truncate(size int64) error {
+	if err := f.check("truncate", true); err != nil {
+		return err
+	}
+	if size < 0 {
+		return memErr("truncate", f.name, os.ErrInvalid)
+	}
+	f.fs.mu.Lock()
+	defer f.fs.mu.Unlock()
+	data := make([]byte, size)
+	copy(data, f.node.data)
+	f.node.data = data
+	f.node.modTime = time.Now()
+	return nil
+}
+
+func (f *memFile) Readdir(n int) ([]os.FileInfo, error) {
+	if f.closed {
+		return nil, memErr("readdir", f.name, os.ErrClosed)
+	}
+	f.fs.mu.RLock()
+	infos, err := f.fs.readDirLocked("readdir", f.name, f.path)
+	f.fs.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	// the entries may be removed since the last call
+	if f.dirPos > len(infos) {
+		f.dirPos = len(infos)
+	}
+	infos = infos[f.dirPos:]
+	if n > 0 {
+		if len(infos) == 0 {
+			return nil, io.EOF
+		}
+		if n < len(infos) {
+			infos = infos[:n]
+		}
+	}
+	f.dirPos += len(infos)
+	return infos, nil
+}
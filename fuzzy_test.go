@@ -0,0 +1,50 @@
+package goutil
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+	cases := []struct {
+		a, b string
+		d    int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		{"中文", "中国", 1},
+	}
+	for _, c := range cases {
+		if d := Levenshtein(c.a, c.b); d != c.d {
+			t.Errorf("Levenshtein(%q, %q) = %d, expect %d", c.a, c.b, d, c.d)
+		}
+	}
+}
+
+func TestJaroWinkler(t *testing.T) {
+	cases := []struct {
+		a, b string
+		s    float64
+	}{
+		{"MARTHA", "MARHTA", 0.961},
+		{"DWAYNE", "DUANE", 0.840},
+		{"DIXON", "DICKSONX", 0.813},
+		{"abc", "abc", 1},
+		{"abc", "xyz", 0},
+	}
+	for _, c := range cases {
+		if s := JaroWinkler(c.a, c.b); math.Abs(s-c.s) > 0.001 {
+			t.Errorf("JaroWinkler(%q, %q) = %.3f, expect %.3f", c.a, c.b, s, c.s)
+		}
+	}
+}
+
+func TestSimilarityRank(t *testing.T) {
+	got := SimilarityRank("comit", []string{"clone", "config", "commit", "init"})
+	if !reflect.DeepEqual(got, []string{"commit", "config", "clone", "init"}) {
+		t.Fatalf("unexpected rank: %v", got)
+	}
+}
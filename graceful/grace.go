@@ -17,6 +17,7 @@ package graceful
 
 import (
 	"context"
+	"sync"
 	"time"
 )
 
@@ -27,6 +28,8 @@ var (
 	shutdownTimeout time.Duration
 	preCloseFunc    func() error
 	postCloseFunc   func() error
+	postCloseHooks  []func() error
+	hooksLock       sync.Mutex
 )
 
 // SetShutdown sets the function which is called after the process shutdown,
@@ -35,7 +38,7 @@ var (
 // If timeout<0, indefinite period.
 // 'preCloseFunc' is executed before closing process, but not guaranteed to be completed.
 // 'postCloseFunc' is executed after process are closed, but not guaranteed to be completed.
-func SetShutdown(timeout time.Duration, preCloseFunc, postCloseFunc func() error) {
+func SetShutdown(timeout time.Duration, preClose, postClose func() error) {
 	if timeout < 0 {
 		shutdownTimeout = 1<<63 - 1
 	} else if timeout < MinShutdownTimeout {
@@ -43,14 +46,40 @@ func SetShutdown(timeout time.Duration, preCloseFunc, postCloseFunc func() error
 	} else {
 		shutdownTimeout = timeout
 	}
-	preCloseFunc = preCloseFunc
-	postCloseFunc = postCloseFunc
+	preCloseFunc = preClose
+	postCloseFunc = postClose
+}
+
+// AddPostCloseHook adds the function which is executed after process are closed,
+// following the 'postCloseFunc' of SetShutdown.
+// The hooks are executed in the order they are added, even if 'postCloseFunc' fails,
+// and an error doesn't stop the others.
+func AddPostCloseHook(fn func() error) {
+	hooksLock.Lock()
+	postCloseHooks = append(postCloseHooks, fn)
+	hooksLock.Unlock()
+}
+
+var (
+	shuttingDown     = make(chan struct{})
+	shuttingDownOnce sync.Once
+)
+
+// ShuttingDown returns a channel that's closed when the process starts shutting down or rebooting,
+// so that the long-running loops can stop early.
+func ShuttingDown() <-chan struct{} {
+	return shuttingDown
+}
+
+func markShuttingDown() {
+	shuttingDownOnce.Do(func() { close(shuttingDown) })
 }
 
 // Shutdown closes all the frame process gracefully.
 // Parameter timeout is used to reset time-out period for the process shutdown.
 func Shutdown(timeout ...time.Duration) {
 	log.Infof("shutting down process...")
+	markShuttingDown()
 
 	contextExec(timeout, "shutdown", func(ctxTimeout context.Context) <-chan struct{} {
 		endCh := make(chan struct{})
@@ -82,7 +111,8 @@ func contextExec(timeout []time.Duration, action string, deferCallback func(ctxT
 	if len(timeout) > 0 {
 		SetShutdown(timeout[0], preCloseFunc, postCloseFunc)
 	}
-	ctxTimeout, _ := context.WithTimeout(context.Background(), shutdownTimeout)
+	ctxTimeout, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
 	select {
 	case <-ctxTimeout.Done():
 		if err := ctxTimeout.Err(); err != nil {
@@ -93,12 +123,22 @@ func contextExec(timeout []time.Duration, action string, deferCallback func(ctxT
 }
 
 func shutdown(ctxTimeout context.Context, action string) bool {
+	graceful := true
 	if postCloseFunc != nil {
 		if err := postCloseFunc(); err != nil {
 			log.Errorf("[%s-postClose] %s", action, err.Error())
-			return false
+			graceful = false
 		}
 	}
 
-	return true
+	hooksLock.Lock()
+	hooks := postCloseHooks
+	hooksLock.Unlock()
+	for _, fn := range hooks {
+		if err := fn(); err != nil {
+			log.Errorf("[%s-postCloseHook] %s", action, err.Error())
+			graceful = false
+		}
+	}
+	return graceful
 }
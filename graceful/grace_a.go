@@ -1,4 +1,6 @@
-// +build windows
+//go:build windows || plan9 || js || wasip1
+// +build windows plan9 js wasip1
+
 //
 // Copyright 2016 HenryLee. All Rights Reserved.
 //
@@ -19,12 +21,13 @@ package graceful
 import (
 	"os"
 	"os/signal"
+	"runtime"
 	"time"
 )
 
 func graceSignal() {
 	// subscribe to SIGINT signals
-	ch := make(chan os.Signal)
+	ch := make(chan os.Signal, 1)
 	signal.Notify(ch, os.Interrupt, os.Kill)
 	defer func() {
 		os.Exit(0)
@@ -34,12 +37,14 @@ func graceSignal() {
 	Shutdown()
 }
 
+func reloadSignal() {}
+
 // Reboot all the frame process gracefully.
-// Notes: Windows system are not supported!
+// Notes: Windows, Plan 9, js and wasip1 systems are not supported!
 func Reboot(timeout ...time.Duration) {
-	log.Infof("windows system doesn't support reboot! call Shutdown() is recommended.")
+	log.Infof("%s system doesn't support reboot! call Shutdown() is recommended.", runtime.GOOS)
 }
 
 // SetExtractProcFiles sets extract proc files only for reboot.
-// Notes: Windows system are not supported!
+// Notes: Windows, Plan 9, js and wasip1 systems are not supported!
 func SetExtractProcFiles([]*os.File) {}
@@ -1,4 +1,6 @@
-// +build !windows
+//go:build !windows && !plan9 && !js && !wasip1
+// +build !windows,!plan9,!js,!wasip1
+
 //
 // Copyright 2016 HenryLee. All Rights Reserved.
 //
@@ -27,7 +29,7 @@ import (
 
 func graceSignal() {
 	// subscribe to SIGINT signals
-	ch := make(chan os.Signal)
+	ch := make(chan os.Signal, 1)
 	signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM, syscall.SIGUSR2)
 	defer func() {
 		os.Exit(0)
@@ -42,10 +44,21 @@ func graceSignal() {
 	}
 }
 
+func reloadSignal() {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+	go func() {
+		for range ch {
+			Reload()
+		}
+	}()
+}
+
 // Reboot all the frame process gracefully.
 // Notes: Windows system are not supported!
 func Reboot(timeout ...time.Duration) {
 	log.Infof("rebooting process...")
+	markShuttingDown()
 
 	var (
 		ppid     = os.Getppid()
@@ -0,0 +1,53 @@
+package graceful
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSetShutdown(t *testing.T) {
+	defer SetShutdown(0, nil, nil)
+	pre := func() error { return nil }
+	post := func() error { return nil }
+	SetShutdown(time.Second, pre, post)
+	if preCloseFunc == nil || postCloseFunc == nil {
+		t.Fatal("the close funcs should be set")
+	}
+	if shutdownTimeout != MinShutdownTimeout {
+		t.Fatalf("got timeout %v", shutdownTimeout)
+	}
+	SetShutdown(-1, nil, nil)
+	if preCloseFunc != nil || postCloseFunc != nil || shutdownTimeout != 1<<63-1 {
+		t.Fatal("the close funcs should be reset")
+	}
+}
+
+func TestShutdownHooks(t *testing.T) {
+	defer func() {
+		SetShutdown(0, nil, nil)
+		hooksLock.Lock()
+		postCloseHooks = nil
+		hooksLock.Unlock()
+	}()
+	var calls []string
+	SetShutdown(0, nil, func() error {
+		calls = append(calls, "post")
+		return errors.New("post failed")
+	})
+	AddPostCloseHook(func() error {
+		calls = append(calls, "hook1")
+		return errors.New("hook1 failed")
+	})
+	AddPostCloseHook(func() error {
+		calls = append(calls, "hook2")
+		return nil
+	})
+	if shutdown(context.Background(), "test") {
+		t.Fatal("expect not graceful")
+	}
+	if len(calls) != 3 || calls[0] != "post" || calls[1] != "hook1" || calls[2] != "hook2" {
+		t.Fatalf("got calls %v", calls)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2016 HenryLee. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graceful
+
+import "sync"
+
+var (
+	reloadHooks     []*reloadHook
+	reloadHooksLock sync.Mutex
+	reloadOnce      sync.Once
+)
+
+type reloadHook struct {
+	fn func() error
+}
+
+// AddReloadHook adds the function which is executed by Reload,
+// and Reload is triggered by SIGHUP since the first hook is added.
+// The returned function removes the hook.
+// Notes: Windows system doesn't support SIGHUP, call Reload directly!
+func AddReloadHook(fn func() error) (remove func()) {
+	h := &reloadHook{fn: fn}
+	reloadHooksLock.Lock()
+	reloadHooks = append(reloadHooks, h)
+	reloadHooksLock.Unlock()
+	reloadOnce.Do(reloadSignal)
+	return func() {
+		reloadHooksLock.Lock()
+		defer reloadHooksLock.Unlock()
+		for i, x := range reloadHooks {
+			if x == h {
+				// copies on removal, since Reload may be iterating the old slice
+				hooks := make([]*reloadHook, 0, len(reloadHooks)-1)
+				reloadHooks = append(append(hooks, reloadHooks[:i]...), reloadHooks[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Reload executes the reload hooks in the order they are added,
+// e.g. reloading the configuration without restarting the process.
+func Reload() {
+	log.Infof("reloading process...")
+	reloadHooksLock.Lock()
+	hooks := reloadHooks
+	reloadHooksLock.Unlock()
+	for _, h := range hooks {
+		if err := h.fn(); err != nil {
+			log.Errorf("[reload] %s", err.Error())
+		}
+	}
+}
@@ -0,0 +1,267 @@
+package goutil
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"sync"
+)
+
+// HashAlgorithm is the non-cryptographic hash algorithm.
+type HashAlgorithm int
+
+const (
+	// HashXX is the 64-bit xxHash (XXH64) with zero seed, the default algorithm.
+	HashXX HashAlgorithm = iota
+	// HashFNV1a is the 64-bit FNV-1a.
+	HashFNV1a
+	// HashCRC32C is the CRC-32 with the Castagnoli polynomial.
+	HashCRC32C
+)
+
+var (
+	crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+	fnvPool     = sync.Pool{New: func() interface{} { return fnv.New64a() }}
+	hashBufPool = sync.Pool{New: func() interface{} { return new([]byte) }}
+)
+
+// HashBytes returns the hash of b, using HashXX by default.
+func HashBytes(b []byte, algo ...HashAlgorithm) uint64 {
+	a := HashXX
+	if len(algo) > 0 {
+		a = algo[0]
+	}
+	switch a {
+	case HashFNV1a:
+		h := fnvPool.Get().(hash.Hash64)
+		h.Reset()
+		h.Write(b)
+		sum := h.Sum64()
+		fnvPool.Put(h)
+		return sum
+	case HashCRC32C:
+		return uint64(crc32.Checksum(b, crc32cTable))
+	}
+	return XXHash64(b)
+}
+
+// HashString returns the hash of s without copying, using HashXX by default.
+func HashString(s string, algo ...HashAlgorithm) uint64 {
+	return HashBytes(StringToBytes(s), algo...)
+}
+
+// Hash64 returns the xxHash of v, which is stable across processes and machines,
+// so it can be used to shard keys.
+// The strings, bytes, bools and numbers are hashed by their values (the integers of
+// different types but the same value have the same hash), and the other values are
+// hashed by their JSON encodings.
+func Hash64(v interface{}) uint64 {
+	bp := hashBufPool.Get().(*[]byte)
+	b := (*bp)[:0]
+	switch x := v.(type) {
+	case string:
+		return XXHash64(StringToBytes(x))
+	case []byte:
+		return XXHash64(x)
+	case nil:
+		b = append(b, 0)
+	case bool:
+		if x {
+			b = append(b, 1)
+		} else {
+			b = append(b, 0)
+		}
+	case int:
+		b = appendHashUint(b, uint64(x))
+	case int8:
+		b = appendHashUint(b, uint64(x))
+	case int16:
+		b = appendHashUint(b, uint64(x))
+	case int32:
+		b = appendHashUint(b, uint64(x))
+	case int64:
+		b = appendHashUint(b, uint64(x))
+	case uint:
+		b = appendHashUint(b, uint64(x))
+	case uint8:
+		b = appendHashUint(b, uint64(x))
+	case uint16:
+		b = appendHashUint(b, uint64(x))
+	case uint32:
+		b = appendHashUint(b, uint64(x))
+	case uint64:
+		b = appendHashUint(b, x)
+	case float32:
+		b = appendHashUint(b, math.Float64bits(float64(x)))
+	case float64:
+		b = appendHashUint(b, math.Float64bits(x))
+	default:
+		j, err := json.Marshal(v)
+		if err != nil {
+			j = []byte(fmt.Sprintf("%v", v))
+		}
+		b = append(b, j...)
+	}
+	sum := XXHash64(b)
+	*bp = b
+	hashBufPool.Put(bp)
+	return sum
+}
+
+func appendHashUint(b []byte, u uint64) []byte {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], u)
+	return append(b, buf[:]...)
+}
+
+// the xxHash primes are variables to allow the overflowing arithmetic
+var (
+	xxPrime1 uint64 = 11400714785074694791
+	xxPrime2 uint64 = 14029467366897019727
+	xxPrime3 uint64 = 1609587929392839161
+	xxPrime4 uint64 = 9650029242287828579
+	xxPrime5 uint64 = 2870177450012600261
+)
+
+// XXHash64 returns the 64-bit xxHash (XXH64) of b with zero seed.
+func XXHash64(b []byte) uint64 {
+	n := len(b)
+	var h uint64
+	if n >= 32 {
+		v1 := xxPrime1 + xxPrime2
+		v2 := xxPrime2
+		v3 := uint64(0)
+		v4 := -xxPrime1
+		for len(b) >= 32 {
+			v1 = xxRound(v1, binary.LittleEndian.Uint64(b[0:8]))
+			v2 = xxRound(v2, binary.LittleEndian.Uint64(b[8:16]))
+			v3 = xxRound(v3, binary.LittleEndian.Uint64(b[16:24]))
+			v4 = xxRound(v4, binary.LittleEndian.Uint64(b[24:32]))
+			b = b[32:]
+		}
+		h = bits.RotateLeft64(v1, 1) + bits.RotateLeft64(v2, 7) + bits.RotateLeft64(v3, 12) + bits.RotateLeft64(v4, 18)
+		h = xxMergeRound(h, v1)
+		h = xxMergeRound(h, v2)
+		h = xxMergeRound(h, v3)
+		h = xxMergeRound(h, v4)
+	} else {
+		h = xxPrime5
+	}
+	h += uint64(n)
+	return xxFinalize(h, b)
+}
+
+// NewXXHash64 returns the streaming 64-bit xxHash (XXH64) with zero seed,
+// and its Sum64 equals XXHash64 of all the written data.
+func NewXXHash64() hash.Hash64 {
+	d := new(xxDigest)
+	d.Reset()
+	return d
+}
+
+type xxDigest struct {
+	v1, v2, v3, v4 uint64
+	total          uint64
+	buf            [32]byte
+	n              int
+}
+
+func (d *xxDigest) Reset() {
+	d.v1 = xxPrime1 + xxPrime2
+	d.v2 = xxPrime2
+	d.v3 = 0
+	d.v4 = -xxPrime1
+	d.total = 0
+	d.n = 0
+}
+
+func (d *xxDigest) Size() int { return 8 }
+
+func (d *xxDigest) BlockSize() int { return 32 }
+
+func (d *xxDigest) Write(b []byte) (int, error) {
+	n := len(b)
+	d.total += uint64(n)
+	if d.n+len(b) < 32 {
+		d.n += copy(d.buf[d.n:], b)
+		return n, nil
+	}
+	if d.n > 0 {
+		c := copy(d.buf[d.n:], b)
+		d.block(d.buf[:])
+		b = b[c:]
+		d.n = 0
+	}
+	for ; len(b) >= 32; b = b[32:] {
+		d.block(b)
+	}
+	d.n = copy(d.buf[:], b)
+	return n, nil
+}
+
+func (d *xxDigest) block(b []byte) {
+	d.v1 = xxRound(d.v1, binary.LittleEndian.Uint64(b[0:8]))
+	d.v2 = xxRound(d.v2, binary.LittleEndian.Uint64(b[8:16]))
+	d.v3 = xxRound(d.v3, binary.LittleEndian.Uint64(b[16:24]))
+	d.v4 = xxRound(d.v4, binary.LittleEndian.Uint64(b[24:32]))
+}
+
+func (d *xxDigest) Sum64() uint64 {
+	var h uint64
+	if d.total >= 32 {
+		h = bits.RotateLeft64(d.v1, 1) + bits.RotateLeft64(d.v2, 7) + bits.RotateLeft64(d.v3, 12) + bits.RotateLeft64(d.v4, 18)
+		h = xxMergeRound(h, d.v1)
+		h = xxMergeRound(h, d.v2)
+		h = xxMergeRound(h, d.v3)
+		h = xxMergeRound(h, d.v4)
+	} else {
+		h = xxPrime5
+	}
+	h += d.total
+	return xxFinalize(h, d.buf[:d.n])
+}
+
+func (d *xxDigest) Sum(b []byte) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], d.Sum64())
+	return append(b, buf[:]...)
+}
+
+// xxFinalize mixes the remaining less than 32 bytes and avalanches.
+func xxFinalize(h uint64, b []byte) uint64 {
+	for ; len(b) >= 8; b = b[8:] {
+		h ^= xxRound(0, binary.LittleEndian.Uint64(b[:8]))
+		h = bits.RotateLeft64(h, 27)*xxPrime1 + xxPrime4
+	}
+	if len(b) >= 4 {
+		h ^= uint64(binary.LittleEndian.Uint32(b[:4])) * xxPrime1
+		h = bits.RotateLeft64(h, 23)*xxPrime2 + xxPrime3
+		b = b[4:]
+	}
+	for _, c := range b {
+		h ^= uint64(c) * xxPrime5
+		h = bits.RotateLeft64(h, 11) * xxPrime1
+	}
+	h ^= h >> 33
+	h *= xxPrime2
+	h ^= h >> 29
+	h *= xxPrime3
+	h ^= h >> 32
+	return h
+}
+
+func xxRound(acc, input uint64) uint64 {
+	acc += input * xxPrime2
+	acc = bits.RotateLeft64(acc, 31)
+	return acc * xxPrime1
+}
+
+func xxMergeRound(acc, val uint64) uint64 {
+	acc ^= xxRound(0, val)
+	return acc*xxPrime1 + xxPrime4
+}
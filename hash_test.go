@@ -0,0 +1,51 @@
+package goutil
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestXXHash64(t *testing.T) {
+	for s, expect := range map[string]uint64{
+		"":     0xef46db3751d8e999,
+		"a":    0xd24ec4f1a98c6e5b,
+		"as":   0x1c330fb2d66be179,
+		"asd":  0x631c37ce72a97393,
+		"asdf": 0x415872f599cea71e,
+		"Call me Ishmael. Some years ago--never mind how long precisely-": 0x02a2e85470d6fd96,
+	} {
+		if got := XXHash64([]byte(s)); got != expect {
+			t.Errorf("XXHash64(%q) = %#x, expect %#x", s, got, expect)
+		}
+	}
+}
+
+func TestHashBytes(t *testing.T) {
+	s := "hello world"
+	if got := HashString(s, HashFNV1a); got != 0x779a65e7023cd2e7 {
+		t.Errorf("FNV1a = %#x", got)
+	}
+	if got := HashString(s, HashCRC32C); got != 0xc99465aa {
+		t.Errorf("CRC32C = %#x", got)
+	}
+	if HashString(s) != XXHash64([]byte(s)) || HashBytes([]byte(s)) != HashString(s) {
+		t.Error("default algorithm should be xxHash")
+	}
+}
+
+func TestHash64(t *testing.T) {
+	if Hash64("key") != HashString("key") || Hash64([]byte("key")) != HashString("key") {
+		t.Error("string hash mismatch")
+	}
+	if Hash64(int8(7)) != Hash64(uint64(7)) || Hash64(7) == Hash64(8) {
+		t.Error("integer hash mismatch")
+	}
+	m1 := map[string]int{"a": 1, "b": 2}
+	m2 := map[string]int{"b": 2, "a": 1}
+	if Hash64(m1) != Hash64(m2) {
+		t.Error("map hash is not stable")
+	}
+	if Hash64(struct{ A string }{strings.Repeat("x", 3)}) != Hash64(struct{ A string }{"xxx"}) {
+		t.Error("struct hash is not stable")
+	}
+}
@@ -0,0 +1,78 @@
+package goutil
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+)
+
+// Sign returns the URL-safe base64 (without padding) HMAC-SHA256 signature of data.
+func Sign(key, data []byte) string {
+	return Base64URLEncode(hmacSHA256(key, data))
+}
+
+// Verify reports whether sig is the signature of data made by any of the keys,
+// in constant time, so that the keys can be rotated:
+// sign with the new key and verify with both the new and old keys for a while.
+func Verify(data []byte, sig string, keys ...[]byte) bool {
+	mac, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+	ok := false
+	for _, key := range keys {
+		// check all keys to not leak which one matches
+		if hmac.Equal(mac, hmacSHA256(key, data)) {
+			ok = true
+		}
+	}
+	return ok
+}
+
+func hmacSHA256(key, data []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// Signer signs with the current key and verifies with the current and previous keys.
+type Signer struct {
+	keys [][]byte
+}
+
+// NewSigner creates a *Signer for key rotation,
+// current is used to sign, and previous are accepted only when verifying.
+func NewSigner(current []byte, previous ...[]byte) *Signer {
+	return &Signer{keys: append([][]byte{current}, previous...)}
+}
+
+// Sign returns the signature of data made by the current key.
+func (s *Signer) Sign(data []byte) string {
+	return Sign(s.keys[0], data)
+}
+
+// Verify reports whether sig is the signature of data made by any of the keys.
+func (s *Signer) Verify(data []byte, sig string) bool {
+	return Verify(data, sig, s.keys...)
+}
+
+// SignValue returns the value with its signature appended as "value.signature",
+// e.g. for cookies.
+func (s *Signer) SignValue(value string) string {
+	return value + "." + s.Sign(StringToBytes(value))
+}
+
+// VerifyValue verifies the signed value returned by SignValue,
+// and returns the original value if it is valid.
+func (s *Signer) VerifyValue(signed string) (value string, ok bool) {
+	i := strings.LastIndexByte(signed, '.')
+	if i < 0 {
+		return "", false
+	}
+	value = signed[:i]
+	if !s.Verify(StringToBytes(value), signed[i+1:]) {
+		return "", false
+	}
+	return value, true
+}
@@ -0,0 +1,37 @@
+package goutil
+
+import "testing"
+
+func TestSign(t *testing.T) {
+	data := []byte("The quick brown fox jumps over the lazy dog")
+	sig := Sign([]byte("key"), data)
+	if sig != "97yD9DBThCSxMpjmqm-xQ-9NWaFJRhdZl0edvC0aPNg" {
+		t.Fatalf("got %q", sig)
+	}
+	if !Verify(data, sig, []byte("new"), []byte("key")) {
+		t.Fatal("expect valid with rotated keys")
+	}
+	if Verify(data, sig, []byte("other")) || Verify(data, "!", []byte("key")) || Verify([]byte("x"), sig, []byte("key")) {
+		t.Fatal("expect invalid")
+	}
+}
+
+func TestSigner(t *testing.T) {
+	old := NewSigner([]byte("old"))
+	s := NewSigner([]byte("new"), []byte("old"))
+	signed := s.SignValue("user=1.2")
+	if v, ok := s.VerifyValue(signed); !ok || v != "user=1.2" {
+		t.Fatal(v, ok)
+	}
+	if _, ok := old.VerifyValue(signed); ok {
+		t.Fatal("old signer should not accept the new key")
+	}
+	if v, ok := s.VerifyValue(old.SignValue("legacy")); !ok || v != "legacy" {
+		t.Fatal("rotated signer should accept the old key")
+	}
+	for _, bad := range []string{"", "novalue", signed + "x", "user=2.2" + signed[len("user=1.2"):]} {
+		if _, ok := s.VerifyValue(bad); ok {
+			t.Fatalf("expect invalid: %q", bad)
+		}
+	}
+}
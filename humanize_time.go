@@ -0,0 +1,151 @@
+package goutil
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+// TimeUnit is the unit of the relative time.
+type TimeUnit int
+
+// The units of the relative time.
+const (
+	UnitSecond TimeUnit = iota
+	UnitMinute
+	UnitHour
+	UnitDay
+	UnitMonth
+	UnitYear
+)
+
+// TimeLocale is the locale hook of TimeAgo.
+type TimeLocale struct {
+	// JustNow is used for the time within 10 seconds.
+	JustNow string
+	// Ago formats the past time, e.g. "3 minutes ago".
+	Ago func(n int64, unit TimeUnit) string
+	// In formats the future time, e.g. "in 2 days".
+	In func(n int64, unit TimeUnit) string
+}
+
+var enTimeUnits = [...]string{"second", "minute", "hour", "day", "month", "year"}
+
+func enTimeUnit(n int64, unit TimeUnit) string {
+	s := strconv.FormatInt(n, 10) + " " + enTimeUnits[unit]
+	if n != 1 {
+		s += "s"
+	}
+	return s
+}
+
+var zhTimeUnits = [...]string{"秒", "分钟", "小时", "天", "个月", "年"}
+
+var (
+	timeLocaleMu sync.RWMutex
+	timeLocales  = map[string]*TimeLocale{
+		"en": {
+			JustNow: "just now",
+			Ago:     func(n int64, unit TimeUnit) string { return enTimeUnit(n, unit) + " ago" },
+			In:      func(n int64, unit TimeUnit) string { return "in " + enTimeUnit(n, unit) },
+		},
+		"zh": {
+			JustNow: "刚刚",
+			Ago:     func(n int64, unit TimeUnit) string { return strconv.FormatInt(n, 10) + zhTimeUnits[unit] + "前" },
+			In:      func(n int64, unit TimeUnit) string { return strconv.FormatInt(n, 10) + zhTimeUnits[unit] + "后" },
+		},
+	}
+)
+
+// RegisterTimeLocale registers or replaces the locale of TimeAgo, "en" and "zh" are built in.
+func RegisterTimeLocale(name string, locale *TimeLocale) {
+	timeLocaleMu.Lock()
+	timeLocales[name] = locale
+	timeLocaleMu.Unlock()
+}
+
+// TimeAgo formats the time relative to now, e.g. "3 minutes ago" and "in 2 days".
+// The locale is "en" by default, or if it is not registered.
+func TimeAgo(t time.Time, locale ...string) string {
+	return TimeAgoFrom(t, time.Now(), locale...)
+}
+
+// TimeAgoFrom formats the time relative to now, like TimeAgo.
+func TimeAgoFrom(t, now time.Time, locale ...string) string {
+	timeLocaleMu.RLock()
+	var l *TimeLocale
+	if len(locale) > 0 {
+		l = timeLocales[locale[0]]
+	}
+	if l == nil {
+		l = timeLocales["en"]
+	}
+	timeLocaleMu.RUnlock()
+
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+	if d < 10*time.Second {
+		return l.JustNow
+	}
+	var n int64
+	var unit TimeUnit
+	const day = 24 * time.Hour
+	switch {
+	case d < time.Minute:
+		n, unit = int64(d/time.Second), UnitSecond
+	case d < time.Hour:
+		n, unit = int64(d/time.Minute), UnitMinute
+	case d < day:
+		n, unit = int64(d/time.Hour), UnitHour
+	case d < 30*day:
+		n, unit = int64(d/day), UnitDay
+	case d < 365*day:
+		n, unit = int64(d/(30*day)), UnitMonth
+	default:
+		n, unit = int64(d/(365*day)), UnitYear
+	}
+	if future {
+		return l.In(n, unit)
+	}
+	return l.Ago(n, unit)
+}
+
+// HumanDuration formats the duration compactly in its two most significant units,
+// e.g. "2d03h", "1h05m", "3m07s", "12s" and "350ms".
+func HumanDuration(d time.Duration) string {
+	var b []byte
+	// works in uint64, so that -math.MinInt64 doesn't overflow
+	u := uint64(d)
+	if d < 0 {
+		b = append(b, '-')
+		u = -u
+	}
+	if u < uint64(time.Second) {
+		return string(strconv.AppendUint(b, u/uint64(time.Millisecond), 10)) + "ms"
+	}
+	const day = 24 * time.Hour
+	units := [...]struct {
+		size uint64
+		name byte
+	}{{uint64(day), 'd'}, {uint64(time.Hour), 'h'}, {uint64(time.Minute), 'm'}, {uint64(time.Second), 's'}}
+	for i, unit := range units {
+		if u < unit.size && i < len(units)-1 {
+			continue
+		}
+		b = strconv.AppendUint(b, u/unit.size, 10)
+		b = append(b, unit.name)
+		if i < len(units)-1 {
+			next := units[i+1]
+			if n := u % unit.size / next.size; n < 10 {
+				b = append(b, '0', byte('0'+n), next.name)
+			} else {
+				b = append(strconv.AppendUint(b, n, 10), next.name)
+			}
+		}
+		break
+	}
+	return string(b)
+}
@@ -0,0 +1,61 @@
+package goutil
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestTimeAgo(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		d      time.Duration
+		en, zh string
+	}{
+		{-3 * time.Second, "just now", "刚刚"},
+		{-30 * time.Second, "30 seconds ago", "30秒前"},
+		{-time.Minute, "1 minute ago", "1分钟前"},
+		{-3*time.Minute - 20*time.Second, "3 minutes ago", "3分钟前"},
+		{-5 * time.Hour, "5 hours ago", "5小时前"},
+		{48 * time.Hour, "in 2 days", "2天后"},
+		{-65 * 24 * time.Hour, "2 months ago", "2个月前"},
+		{800 * 24 * time.Hour, "in 2 years", "2年后"},
+	}
+	for _, c := range cases {
+		if got := TimeAgoFrom(now.Add(c.d), now); got != c.en {
+			t.Errorf("%v: got %q, want %q", c.d, got, c.en)
+		}
+		if got := TimeAgoFrom(now.Add(c.d), now, "zh"); got != c.zh {
+			t.Errorf("%v: got %q, want %q", c.d, got, c.zh)
+		}
+	}
+	if got := TimeAgo(time.Now().Add(-2*time.Hour), "unknown"); got != "2 hours ago" {
+		t.Errorf("got %q", got)
+	}
+	RegisterTimeLocale("test", &TimeLocale{
+		JustNow: "now",
+		Ago:     func(n int64, unit TimeUnit) string { return "-" },
+		In:      func(n int64, unit TimeUnit) string { return "+" },
+	})
+	if got := TimeAgoFrom(now.Add(time.Hour), now, "test"); got != "+" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestHumanDuration(t *testing.T) {
+	cases := map[time.Duration]string{
+		350 * time.Millisecond:                    "350ms",
+		12 * time.Second:                          "12s",
+		3*time.Minute + 7*time.Second:             "3m07s",
+		time.Hour + 5*time.Minute + 9*time.Second: "1h05m",
+		51*time.Hour + 30*time.Minute:             "2d03h",
+		-90 * time.Second:                         "-1m30s",
+		math.MinInt64:                             "-106751d23h",
+		math.MaxInt64:                             "106751d23h",
+	}
+	for d, want := range cases {
+		if got := HumanDuration(d); got != want {
+			t.Errorf("%v: got %q, want %q", d, got, want)
+		}
+	}
+}
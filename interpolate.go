@@ -0,0 +1,70 @@
+package goutil
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MissingKeyPolicy decides what Interpolate does with a placeholder whose key is missing.
+type MissingKeyPolicy int
+
+const (
+	// MissingKeyKeep keeps the placeholder as is.
+	MissingKeyKeep MissingKeyPolicy = iota
+	// MissingKeyEmpty replaces the placeholder with an empty string.
+	MissingKeyEmpty
+	// MissingKeyError returns an error.
+	MissingKeyError
+)
+
+// Interpolate replaces the ${key} placeholders in s with the values of vars,
+// formatted by fmt.Sprint, such as Interpolate("Hello ${name}", map[string]interface{}{"name": "Go"}).
+// "$$" is an escaped "$", so "$${name}" outputs "${name}".
+// The missing keys are handled according to policy, MissingKeyKeep by default.
+func Interpolate(s string, vars map[string]interface{}, policy ...MissingKeyPolicy) (string, error) {
+	p := MissingKeyKeep
+	if len(policy) > 0 {
+		p = policy[0]
+	}
+	if strings.IndexByte(s, '$') < 0 {
+		return s, nil
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	for {
+		i := strings.IndexByte(s, '$')
+		if i < 0 || i == len(s)-1 {
+			b.WriteString(s)
+			return b.String(), nil
+		}
+		b.WriteString(s[:i])
+		switch s[i+1] {
+		case '$':
+			b.WriteByte('$')
+			s = s[i+2:]
+			continue
+		case '{':
+		default:
+			b.WriteByte('$')
+			s = s[i+1:]
+			continue
+		}
+		end := strings.IndexByte(s[i:], '}')
+		if end < 0 {
+			return "", fmt.Errorf("goutil: unclosed placeholder in %q", s[i:])
+		}
+		key := s[i+2 : i+end]
+		if v, ok := vars[key]; ok {
+			fmt.Fprint(&b, v)
+		} else {
+			switch p {
+			case MissingKeyEmpty:
+			case MissingKeyError:
+				return "", fmt.Errorf("goutil: missing key %q", key)
+			default:
+				b.WriteString(s[i : i+end+1])
+			}
+		}
+		s = s[i+end+1:]
+	}
+}
@@ -0,0 +1,30 @@
+package goutil
+
+import (
+	"testing"
+)
+
+func TestInterpolate(t *testing.T) {
+	vars := map[string]interface{}{"name": "Go", "n": 3}
+	cases := []struct {
+		s      string
+		policy MissingKeyPolicy
+		out    string
+		err    bool
+	}{
+		{"Hello ${name}", MissingKeyKeep, "Hello Go", false},
+		{"${name}${n}$", MissingKeyKeep, "Go3$", false},
+		{"cost $5, $${name}", MissingKeyKeep, "cost $5, ${name}", false},
+		{"Hi ${who}", MissingKeyKeep, "Hi ${who}", false},
+		{"Hi ${who}", MissingKeyEmpty, "Hi ", false},
+		{"Hi ${who}", MissingKeyError, "", true},
+		{"Hi ${name", MissingKeyKeep, "", true},
+		{"plain", MissingKeyKeep, "plain", false},
+	}
+	for _, c := range cases {
+		out, err := Interpolate(c.s, vars, c.policy)
+		if (err != nil) != c.err || out != c.out {
+			t.Errorf("Interpolate(%q) = %q, %v, expect %q", c.s, out, err, c.out)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package goutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ErrJSONPathNotFound is returned when the json path does not exist.
+var ErrJSONPathNotFound = errors.New("goutil: json path not found")
+
+// GetJSON returns the raw JSON value at the path without unmarshaling the whole data.
+// The path consists of dotted keys and array indices, e.g. "items[2].id",
+// and the empty path means the whole data.
+func GetJSON(data []byte, path string) ([]byte, error) {
+	segs, err := parseJSONPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(data) {
+		return nil, errors.New("goutil: invalid json")
+	}
+	start, end, matched, _, err := lookupJSON(data, segs)
+	if err != nil {
+		return nil, err
+	}
+	if matched < len(segs) {
+		return nil, ErrJSONPathNotFound
+	}
+	return data[start:end], nil
+}
+
+// SetJSON sets the value at the path and returns the new JSON data,
+// leaving the rest of data byte-for-byte unchanged.
+// The missing object keys are created, and an array can be appended by
+// using its length as the index; otherwise ErrJSONPathNotFound is returned.
+func SetJSON(data []byte, path string, value interface{}) ([]byte, error) {
+	segs, err := parseJSONPath(path)
+	if err != nil {
+		return nil, err
+	}
+	vb, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(segs) == 0 {
+		return vb, nil
+	}
+	if !json.Valid(data) {
+		return nil, errors.New("goutil: invalid json")
+	}
+	start, end, matched, n, err := lookupJSON(data, segs)
+	if err != nil {
+		return nil, err
+	}
+	if matched == len(segs) {
+		return spliceBytes(data, start, end, vb), nil
+	}
+	// build the missing nested objects
+	for i := len(segs) - 1; i > matched; i-- {
+		if segs[i].isIndex {
+			return nil, ErrJSONPathNotFound
+		}
+		kb, _ := json.Marshal(segs[i].key)
+		vb = append(append(append([]byte{'{'}, kb...), ':'), append(vb, '}')...)
+	}
+	seg := segs[matched]
+	var member []byte
+	switch data[start] {
+	case '{':
+		if seg.isIndex {
+			return nil, ErrJSONPathNotFound
+		}
+		kb, _ := json.Marshal(seg.key)
+		member = append(append(kb, ':'), vb...)
+	case '[':
+		if !seg.isIndex || seg.index != n {
+			return nil, ErrJSONPathNotFound
+		}
+		member = vb
+	default:
+		return nil, ErrJSONPathNotFound
+	}
+	closing := end - 1
+	if len(bytes.TrimSpace(data[start+1:closing])) > 0 {
+		member = append([]byte{','}, member...)
+	}
+	return spliceBytes(data, closing, closing, member), nil
+}
+
+func spliceBytes(data []byte, start, end int, insert []byte) []byte {
+	b := make([]byte, 0, len(data)-(end-start)+len(insert))
+	b = append(b, data[:start]...)
+	b = append(b, insert...)
+	return append(b, data[end:]...)
+}
+
+type jsonSeg struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+func parseJSONPath(path string) ([]jsonSeg, error) {
+	var segs []jsonSeg
+	for i := 0; i < len(path); {
+		switch path[i] {
+		case '.':
+			i++
+		case '[':
+			j := i + 1
+			for j < len(path) && path[j] != ']' {
+				j++
+			}
+			if j == len(path) {
+				return nil, fmt.Errorf("goutil: invalid json path %q", path)
+			}
+			idx, err := strconv.Atoi(path[i+1 : j])
+			if err != nil || idx < 0 {
+				return nil, fmt.Errorf("goutil: invalid json path index %q", path[i+1:j])
+			}
+			segs = append(segs, jsonSeg{index: idx, isIndex: true})
+			i = j + 1
+		default:
+			j := i
+			for j < len(path) && path[j] != '.' && path[j] != '[' {
+				j++
+			}
+			segs = append(segs, jsonSeg{key: path[i:j]})
+			i = j
+		}
+	}
+	return segs, nil
+}
+
+// lookupJSON resolves the segments as far as possible, and returns the span of
+// the deepest resolved value, the number of resolved segments, and
+// the elements count if it stopped at an array.
+func lookupJSON(data []byte, segs []jsonSeg) (start, end, matched, n int, err error) {
+	start = skipJSONSpace(data, 0)
+	if end, err = jsonValueEnd(data, start); err != nil {
+		return
+	}
+	for ; matched < len(segs); matched++ {
+		var cs, ce int
+		var found bool
+		seg := segs[matched]
+		switch {
+		case data[start] == '{' && !seg.isIndex:
+			cs, ce, found, err = findJSONMember(data, start, seg.key)
+		case data[start] == '[' && seg.isIndex:
+			cs, ce, n, found, err = findJSONIndex(data, start, seg.index)
+		}
+		if err != nil || !found {
+			return
+		}
+		start, end = cs, ce
+	}
+	return
+}
+
+func findJSONMember(data []byte, i int, key string) (start, end int, found bool, err error) {
+	i = skipJSONSpace(data, i+1)
+	if data[i] == '}' {
+		return
+	}
+	for {
+		keyEnd, err := jsonValueEnd(data, i)
+		if err != nil {
+			return 0, 0, false, err
+		}
+		var k string
+		if err = json.Unmarshal(data[i:keyEnd], &k); err != nil {
+			return 0, 0, false, err
+		}
+		i = skipJSONSpace(data, skipJSONSpace(data, keyEnd)+1) // skip ':'
+		vEnd, err := jsonValueEnd(data, i)
+		if err != nil {
+			return 0, 0, false, err
+		}
+		if k == key {
+			return i, vEnd, true, nil
+		}
+		i = skipJSONSpace(data, vEnd)
+		if data[i] != ',' {
+			return 0, 0, false, nil
+		}
+		i = skipJSONSpace(data, i+1)
+	}
+}
+
+func findJSONIndex(data []byte, i int, idx int) (start, end, n int, found bool, err error) {
+	i = skipJSONSpace(data, i+1)
+	if data[i] == ']' {
+		return
+	}
+	for ; ; n++ {
+		vEnd, err := jsonValueEnd(data, i)
+		if err != nil {
+			return 0, 0, n, false, err
+		}
+		if n == idx {
+			return i, vEnd, n, true, nil
+		}
+		i = skipJSONSpace(data, vEnd)
+		if data[i] != ',' {
+			return 0, 0, n + 1, false, nil
+		}
+		i = skipJSONSpace(data, i+1)
+	}
+}
+
+func skipJSONSpace(data []byte, i int) int {
+	for i < len(data) {
+		switch data[i] {
+		case ' ', '\t', '\n', '\r':
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// jsonValueEnd returns the end offset of the (valid) JSON value starting at i.
+func jsonValueEnd(data []byte, i int) (int, error) {
+	if i >= len(data) {
+		return 0, errors.New("goutil: unexpected end of json")
+	}
+	switch data[i] {
+	case '"':
+		for j := i + 1; j < len(data); j++ {
+			switch data[j] {
+			case '\\':
+				j++
+			case '"':
+				return j + 1, nil
+			}
+		}
+		return 0, errors.New("goutil: unexpected end of json string")
+	case '{', '[':
+		depth := 0
+		for j := i; j < len(data); j++ {
+			switch data[j] {
+			case '"':
+				e, err := jsonValueEnd(data, j)
+				if err != nil {
+					return 0, err
+				}
+				j = e - 1
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+				if depth == 0 {
+					return j + 1, nil
+				}
+			}
+		}
+		return 0, errors.New("goutil: unexpected end of json")
+	}
+	j := i
+	for j < len(data) {
+		switch data[j] {
+		case ',', '}', ']', ' ', '\t', '\n', '\r':
+			return j, nil
+		}
+		j++
+	}
+	return j, nil
+}
@@ -0,0 +1,58 @@
+package goutil
+
+import "testing"
+
+var jsonPathData = []byte(`{
+	"name": "order",
+	"items": [{"id": 1}, {"id": 2, "tags": ["a", "b\"]"]}, {"id": 3}],
+	"meta": {"a.b": null, "empty": {}}
+}`)
+
+func TestGetJSON(t *testing.T) {
+	for path, expect := range map[string]string{
+		"name":          `"order"`,
+		"items[1].id":   `2`,
+		"items[1].tags": `["a", "b\"]"]`,
+		"items[2]":      `{"id": 3}`,
+		"meta.empty":    `{}`,
+	} {
+		got, err := GetJSON(jsonPathData, path)
+		if err != nil || string(got) != expect {
+			t.Errorf("GetJSON(%q) = %s, %v; expect %s", path, got, err, expect)
+		}
+	}
+	for _, path := range []string{"nope", "items[3]", "name.x", "items.id", "meta[0]"} {
+		if _, err := GetJSON(jsonPathData, path); err != ErrJSONPathNotFound {
+			t.Errorf("GetJSON(%q) err = %v", path, err)
+		}
+	}
+	if _, err := GetJSON([]byte(`{"a":`), "a"); err == nil {
+		t.Error("expect invalid json error")
+	}
+}
+
+func TestSetJSON(t *testing.T) {
+	data := []byte(`{"a": {"b": 1}, "list": [1, 2], "e": {}}`)
+	for _, c := range []struct {
+		path   string
+		value  interface{}
+		expect string
+	}{
+		{"a.b", "x", `{"a": {"b": "x"}, "list": [1, 2], "e": {}}`},
+		{"a.c", true, `{"a": {"b": 1,"c":true}, "list": [1, 2], "e": {}}`},
+		{"e.x.y", 1, `{"a": {"b": 1}, "list": [1, 2], "e": {"x":{"y":1}}}`},
+		{"list[1]", nil, `{"a": {"b": 1}, "list": [1, null], "e": {}}`},
+		{"list[2]", 3, `{"a": {"b": 1}, "list": [1, 2,3], "e": {}}`},
+		{"", []int{}, `[]`},
+	} {
+		got, err := SetJSON(data, c.path, c.value)
+		if err != nil || string(got) != c.expect {
+			t.Errorf("SetJSON(%q) = %s, %v; expect %s", c.path, got, err, c.expect)
+		}
+	}
+	for _, path := range []string{"list[5]", "a.b.c", "x[0]"} {
+		if _, err := SetJSON(data, path, 1); err != ErrJSONPathNotFound {
+			t.Errorf("SetJSON(%q) err = %v", path, err)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package goutil
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrLeaseReleased is the error of a LeaseHandle after Release is called.
+var ErrLeaseReleased = errors.New("goutil: lease released")
+
+// Lease manages a renewable resource lease, such as a file lock,
+// a distributed lock or a token.
+// It is safe for multiple goroutines to acquire handles concurrently.
+type Lease struct {
+	interval time.Duration
+	acquire  func(context.Context) error
+	renew    func(context.Context) error
+	revoke   func() error
+}
+
+// NewLease creates a new *Lease.
+// 'acquire' is called by Acquire to take the resource, it can be nil.
+// 'renew' is called every interval by a background goroutine to keep the resource.
+// 'revoke' is called once the handle is released or the renewal fails, it can be nil.
+// If interval<=0, will use 1s.
+func NewLease(interval time.Duration, acquire, renew func(context.Context) error, revoke func() error) *Lease {
+	if interval <= 0 {
+		interval = time.Second
+	}
+	return &Lease{
+		interval: interval,
+		acquire:  acquire,
+		renew:    renew,
+		revoke:   revoke,
+	}
+}
+
+// Acquire takes the resource and returns a handle which is auto-renewed
+// until it is released or the renewal fails.
+func (l *Lease) Acquire(ctx context.Context) (*LeaseHandle, error) {
+	if l.acquire != nil {
+		if err := l.acquire(ctx); err != nil {
+			return nil, err
+		}
+	}
+	hctx, cancel := context.WithCancel(context.Background())
+	h := &LeaseHandle{
+		lease:   l,
+		ctx:     hctx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	go h.keepalive()
+	return h, nil
+}
+
+// LeaseHandle is a held lease returned by (*Lease).Acquire.
+type LeaseHandle struct {
+	lease   *Lease
+	ctx     context.Context
+	cancel  context.CancelFunc
+	done    chan struct{}
+	stopped chan struct{} // closed when keepalive exits
+	once    sync.Once
+	err     error
+	revErr  error
+}
+
+// Context returns a context which is cancelled when the lease is lost,
+// so that the work protected by the lease can be stopped.
+func (h *LeaseHandle) Context() context.Context {
+	return h.ctx
+}
+
+// Done returns a channel that's closed when the lease is revoked.
+func (h *LeaseHandle) Done() <-chan struct{} {
+	return h.done
+}
+
+// Err returns nil while the lease is held.
+// Returns ErrLeaseReleased after Release, or the renewal error that revoked the lease.
+func (h *LeaseHandle) Err() error {
+	select {
+	case <-h.done:
+		return h.err
+	default:
+		return nil
+	}
+}
+
+// Release stops renewing and revokes the lease.
+// It waits for the renewal in flight, if any, to return before revoking.
+// Returns the error of the revoke function, it is safe to call it multiple times.
+func (h *LeaseHandle) Release() error {
+	h.revokeOnce(ErrLeaseReleased, false)
+	return h.revErr
+}
+
+func (h *LeaseHandle) keepalive() {
+	defer close(h.stopped)
+	ticker := time.NewTicker(h.lease.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-h.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if h.lease.renew == nil {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(h.ctx, h.lease.interval)
+		err := h.lease.renew(ctx)
+		cancel()
+		if err != nil {
+			if h.ctx.Err() == nil {
+				h.revokeOnce(err, true)
+			}
+			return
+		}
+	}
+}
+
+// revokeOnce revokes the lease with the reason once.
+// Unless it is called by keepalive itself, it stops keepalive first,
+// so that a late renewal cannot extend the resource after it is revoked.
+func (h *LeaseHandle) revokeOnce(reason error, inKeepalive bool) {
+	if !inKeepalive {
+		h.cancel()
+		<-h.stopped
+	}
+	h.once.Do(func() {
+		h.cancel()
+		if h.lease.revoke != nil {
+			h.revErr = h.lease.revoke()
+		}
+		h.err = reason
+		close(h.done)
+	})
+}
@@ -0,0 +1,82 @@
+package goutil
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLease(t *testing.T) {
+	var renews, revokes int32
+	l := NewLease(5*time.Millisecond, nil, func(context.Context) error {
+		atomic.AddInt32(&renews, 1)
+		return nil
+	}, func() error {
+		atomic.AddInt32(&revokes, 1)
+		return nil
+	})
+	h, err := l.Acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(30 * time.Millisecond)
+	if h.Err() != nil {
+		t.Fatal(h.Err())
+	}
+	h.Release()
+	h.Release()
+	<-h.Done()
+	if h.Err() != ErrLeaseReleased {
+		t.Fatalf("expect ErrLeaseReleased, got %v", h.Err())
+	}
+	if atomic.LoadInt32(&renews) == 0 || atomic.LoadInt32(&revokes) != 1 {
+		t.Fatalf("renews: %d, revokes: %d", renews, revokes)
+	}
+}
+
+func TestLeaseRenewFailure(t *testing.T) {
+	errLost := errors.New("lost")
+	l := NewLease(5*time.Millisecond, nil, func(context.Context) error {
+		return errLost
+	}, nil)
+	h, err := l.Acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-h.Done():
+	case <-time.After(time.Second):
+		t.Fatal("lease should be revoked")
+	}
+	if h.Err() != errLost || h.Context().Err() == nil {
+		t.Fatalf("unexpected state: %v", h.Err())
+	}
+}
+
+func TestLeaseReleaseWaitsRenew(t *testing.T) {
+	var renewing, renewed int32
+	l := NewLease(5*time.Millisecond, nil, func(context.Context) error {
+		// ignores the context
+		if atomic.CompareAndSwapInt32(&renewing, 0, 1) {
+			time.Sleep(50 * time.Millisecond)
+			atomic.StoreInt32(&renewed, 1)
+		}
+		return nil
+	}, func() error {
+		if atomic.LoadInt32(&renewing) == 1 && atomic.LoadInt32(&renewed) == 0 {
+			t.Error("revoked while the renewal is in flight")
+		}
+		return nil
+	})
+	h, err := l.Acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	h.Release()
+	if h.Err() != ErrLeaseReleased {
+		t.Fatalf("expect ErrLeaseReleased, got %v", h.Err())
+	}
+}
@@ -0,0 +1,172 @@
+package limiter
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBackoffRatio is the default multiplicative decrease ratio of Adaptive.
+	DefaultBackoffRatio = 0.9
+)
+
+// Adaptive is an AIMD (additive increase / multiplicative decrease) concurrency limiter.
+// The allowed in-flight count grows by about 1 for every limit successful calls,
+// and is multiplied by the backoff ratio on an error or latency spike,
+// at most once per window (failures of calls started before the last decrease are ignored),
+// so services are protected without hand-tuned static limits.
+// It is safe for concurrent use by multiple goroutines.
+type Adaptive struct {
+	mu               sync.Mutex
+	limit            float64
+	min, max         float64
+	inFlight         int
+	epoch            uint64 // incremented by every decrease
+	backoffRatio     float64
+	latencyThreshold time.Duration
+	changed          chan struct{}
+}
+
+// NewAdaptive creates a new *Adaptive.
+// If min<=0, will use 1.
+// If max<min, max is unlimited.
+// The initial limit is clamped into [min,max].
+func NewAdaptive(initial, min, max int) *Adaptive {
+	if min <= 0 {
+		min = 1
+	}
+	a := &Adaptive{
+		min:          float64(min),
+		max:          float64(max),
+		backoffRatio: DefaultBackoffRatio,
+	}
+	if max < min {
+		a.max = math.MaxInt32
+	}
+	a.limit = a.clamp(float64(initial))
+	return a
+}
+
+// SetBackoffRatio sets the multiplicative decrease ratio.
+// If ratio<=0 or ratio>=1, will use DefaultBackoffRatio.
+func (a *Adaptive) SetBackoffRatio(ratio float64) {
+	if ratio <= 0 || ratio >= 1 {
+		ratio = DefaultBackoffRatio
+	}
+	a.mu.Lock()
+	a.backoffRatio = ratio
+	a.mu.Unlock()
+}
+
+// SetLatencyThreshold sets the latency above which a successful call is
+// treated as a latency spike and shrinks the limit.
+// If d<=0, latency is ignored.
+func (a *Adaptive) SetLatencyThreshold(d time.Duration) {
+	a.mu.Lock()
+	a.latencyThreshold = d
+	a.mu.Unlock()
+}
+
+// Acquire waits for an in-flight slot, blocking until one is available or ctx is done.
+// On success, the returned done function must be called once with the result of the call.
+func (a *Adaptive) Acquire(ctx context.Context) (done func(err error), err error) {
+	a.mu.Lock()
+	for !a.tryAcquireLocked() {
+		ch := a.changedLocked()
+		a.mu.Unlock()
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		a.mu.Lock()
+	}
+	epoch := a.epoch
+	a.mu.Unlock()
+	return a.doneFunc(epoch), nil
+}
+
+// TryAcquire acquires an in-flight slot without blocking.
+// On success, the returned done function must be called once with the result of the call.
+func (a *Adaptive) TryAcquire() (done func(err error), ok bool) {
+	a.mu.Lock()
+	ok = a.tryAcquireLocked()
+	epoch := a.epoch
+	a.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+	return a.doneFunc(epoch), true
+}
+
+// Limit returns the current allowed in-flight count.
+func (a *Adaptive) Limit() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return int(a.limit)
+}
+
+// InFlight returns the current in-flight count.
+func (a *Adaptive) InFlight() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.inFlight
+}
+
+func (a *Adaptive) doneFunc(epoch uint64) func(error) {
+	start := time.Now()
+	var once sync.Once
+	return func(err error) {
+		once.Do(func() { a.release(epoch, time.Since(start), err) })
+	}
+}
+
+func (a *Adaptive) release(epoch uint64, latency time.Duration, err error) {
+	a.mu.Lock()
+	a.inFlight--
+	if err != nil || (a.latencyThreshold > 0 && latency > a.latencyThreshold) {
+		// the calls started before the last decrease have been counted by it
+		if epoch == a.epoch {
+			a.limit = a.clamp(a.limit * a.backoffRatio)
+			a.epoch++
+		}
+	} else {
+		a.limit = a.clamp(a.limit + 1/a.limit)
+	}
+	a.broadcastLocked()
+	a.mu.Unlock()
+}
+
+func (a *Adaptive) tryAcquireLocked() bool {
+	if a.inFlight >= int(a.limit) {
+		return false
+	}
+	a.inFlight++
+	return true
+}
+
+func (a *Adaptive) clamp(limit float64) float64 {
+	if limit < a.min {
+		return a.min
+	}
+	if limit > a.max {
+		return a.max
+	}
+	return limit
+}
+
+func (a *Adaptive) changedLocked() chan struct{} {
+	if a.changed == nil {
+		a.changed = make(chan struct{})
+	}
+	return a.changed
+}
+
+func (a *Adaptive) broadcastLocked() {
+	if a.changed != nil {
+		close(a.changed)
+		a.changed = nil
+	}
+}
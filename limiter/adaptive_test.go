@@ -0,0 +1,87 @@
+package limiter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAdaptive(t *testing.T) {
+	a := NewAdaptive(4, 1, 10)
+	for i := 0; i < 100; i++ {
+		done, err := a.Acquire(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		done(nil)
+	}
+	if a.Limit() != 10 {
+		t.Fatalf("expect limit growing to 10, got %d", a.Limit())
+	}
+	for i := 0; i < 10; i++ {
+		done, _ := a.Acquire(context.Background())
+		done(errors.New("overload"))
+	}
+	if a.Limit() >= 5 {
+		t.Fatalf("expect limit shrinking, got %d", a.Limit())
+	}
+	if a.InFlight() != 0 {
+		t.Fatalf("expect no in-flight, got %d", a.InFlight())
+	}
+}
+
+func TestAdaptiveWait(t *testing.T) {
+	a := NewAdaptive(1, 1, 1)
+	done, ok := a.TryAcquire()
+	if !ok {
+		t.Fatal("expect a slot")
+	}
+	if _, ok := a.TryAcquire(); ok {
+		t.Fatal("expect no slot")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := a.Acquire(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("expect DeadlineExceeded, got %v", err)
+	}
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		done(nil)
+	}()
+	done2, err := a.Acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	done2(nil)
+}
+
+func TestAdaptiveConcurrentFailures(t *testing.T) {
+	a := NewAdaptive(10, 1, 10)
+	dones := make([]func(error), 10)
+	for i := range dones {
+		done, ok := a.TryAcquire()
+		if !ok {
+			t.Fatal("expect a slot")
+		}
+		dones[i] = done
+	}
+	var wg sync.WaitGroup
+	for _, done := range dones {
+		wg.Add(1)
+		go func(done func(error)) {
+			defer wg.Done()
+			done(errors.New("overload"))
+		}(done)
+	}
+	wg.Wait()
+	if a.Limit() != 9 {
+		t.Fatalf("expect limit decreased once to 9, got %d", a.Limit())
+	}
+	done, _ := a.TryAcquire()
+	done(errors.New("overload"))
+	if a.Limit() != 8 {
+		t.Fatalf("expect limit decreased again to 8, got %d", a.Limit())
+	}
+}
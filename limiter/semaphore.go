@@ -0,0 +1,69 @@
+// limiter package limits the concurrency of calls to protect downstreams.
+package limiter
+
+import (
+	"context"
+)
+
+// Semaphore is a counting semaphore which limits the number of goroutines
+// that can hold it at the same time.
+// It is safe for concurrent use by multiple goroutines.
+type Semaphore struct {
+	ch chan struct{}
+}
+
+// NewSemaphore creates a new *Semaphore with n permits.
+// If n<=0, will use 1.
+func NewSemaphore(n int) *Semaphore {
+	if n <= 0 {
+		n = 1
+	}
+	return &Semaphore{ch: make(chan struct{}, n)}
+}
+
+// Acquire acquires a permit, blocking until one is available or ctx is done.
+// On failure, returns ctx.Err() and leaves the semaphore unchanged.
+func (s *Semaphore) Acquire(ctx context.Context) error {
+	select {
+	case s.ch <- struct{}{}:
+		return nil
+	default:
+	}
+	select {
+	case s.ch <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// TryAcquire acquires a permit without blocking.
+// On failure, returns false and leaves the semaphore unchanged.
+func (s *Semaphore) TryAcquire() bool {
+	select {
+	case s.ch <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// Release releases a permit acquired by Acquire or TryAcquire.
+// It panics if called more times than the acquisitions.
+func (s *Semaphore) Release() {
+	select {
+	case <-s.ch:
+	default:
+		panic("limiter: Semaphore released more than held")
+	}
+}
+
+// Len returns the number of permits currently held.
+func (s *Semaphore) Len() int {
+	return len(s.ch)
+}
+
+// Cap returns the total number of permits.
+func (s *Semaphore) Cap() int {
+	return cap(s.ch)
+}
@@ -0,0 +1,29 @@
+package limiter
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSemaphore(t *testing.T) {
+	s := NewSemaphore(2)
+	if !s.TryAcquire() || !s.TryAcquire() {
+		t.Fatal("expect two permits")
+	}
+	if s.TryAcquire() {
+		t.Fatal("expect no more permits")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := s.Acquire(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("expect DeadlineExceeded, got %v", err)
+	}
+	s.Release()
+	if err := s.Acquire(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if s.Len() != 2 || s.Cap() != 2 {
+		t.Fatalf("Len: %d, Cap: %d", s.Len(), s.Cap())
+	}
+}
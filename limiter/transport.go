@@ -0,0 +1,195 @@
+package limiter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrQueueTimeout is returned by Transport when a request waits for a
+// per-host permit longer than the queue timeout.
+var ErrQueueTimeout = errors.New("limiter: timeout waiting for a per-host connection permit")
+
+// Transport is an http.RoundTripper which limits the number of concurrent
+// requests to each host, protecting downstreams from connection storms.
+//
+// A permit is held from sending the request until the response body is closed,
+// so callers must always close the response body.
+// The hosts are keyed by host:port, using the default port of the scheme if missing.
+type Transport struct {
+	base         http.RoundTripper
+	maxPerHost   int
+	queueTimeout time.Duration
+	mu           sync.Mutex
+	sems         map[string]*hostSemaphore
+}
+
+// hostSemaphore is the semaphore of a host,
+// which is evicted once no request holds or waits for it.
+type hostSemaphore struct {
+	*Semaphore
+	refs int
+}
+
+var _ http.RoundTripper = new(Transport)
+
+// NewTransport creates a new *Transport.
+// If base is nil, will use http.DefaultTransport.
+// If maxPerHost<=0, will use 1.
+// If queueTimeout<=0, requests wait for a permit until their context is done.
+func NewTransport(base http.RoundTripper, maxPerHost int, queueTimeout time.Duration) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if maxPerHost <= 0 {
+		maxPerHost = 1
+	}
+	return &Transport{
+		base:         base,
+		maxPerHost:   maxPerHost,
+		queueTimeout: queueTimeout,
+		sems:         make(map[string]*hostSemaphore),
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := hostKey(req.URL.Scheme, req.URL.Host)
+	sem := t.semaphore(key)
+	if err := t.acquire(req.Context(), sem.Semaphore); err != nil {
+		t.unref(key, sem)
+		// RoundTrip must always close the body, even on errors
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	release := func() {
+		sem.Release()
+		t.unref(key, sem)
+	}
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	if resp.Body == nil {
+		release()
+		return resp, nil
+	}
+	body := &releaseBody{ReadCloser: resp.Body, release: release}
+	if w, ok := resp.Body.(io.Writer); ok {
+		// keep the body writable, e.g. 101 Switching Protocols
+		resp.Body = &releaseReadWriteBody{releaseBody: body, Writer: w}
+	} else {
+		resp.Body = body
+	}
+	return resp, nil
+}
+
+// CloseIdleConnections closes the idle connections of the base RoundTripper,
+// if it supports.
+func (t *Transport) CloseIdleConnections() {
+	type closeIdler interface {
+		CloseIdleConnections()
+	}
+	if c, ok := t.base.(closeIdler); ok {
+		c.CloseIdleConnections()
+	}
+}
+
+// InFlight returns the number of in-flight requests to the host.
+// The host is in the form of host:port.
+func (t *Transport) InFlight(host string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	sem, ok := t.sems[hostKey("", host)]
+	if !ok {
+		return 0
+	}
+	return sem.Len()
+}
+
+// semaphore returns the referenced semaphore of the host key,
+// the caller must call unref when done.
+func (t *Transport) semaphore(key string) *hostSemaphore {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	sem, ok := t.sems[key]
+	if !ok {
+		sem = &hostSemaphore{Semaphore: NewSemaphore(t.maxPerHost)}
+		t.sems[key] = sem
+	}
+	sem.refs++
+	return sem
+}
+
+func (t *Transport) unref(key string, sem *hostSemaphore) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	sem.refs--
+	if sem.refs == 0 {
+		delete(t.sems, key)
+	}
+}
+
+// hostKey returns the host:port of the URL host,
+// using the default port of the scheme if missing.
+func hostKey(scheme, host string) string {
+	host = strings.ToLower(host)
+	hostname, port, err := net.SplitHostPort(host)
+	if err != nil {
+		hostname = strings.Trim(host, "[]")
+	} else if port != "" {
+		return host
+	}
+	switch strings.ToLower(scheme) {
+	case "http", "ws":
+		port = "80"
+	case "https", "wss":
+		port = "443"
+	default:
+		return host
+	}
+	return net.JoinHostPort(hostname, port)
+}
+
+func (t *Transport) acquire(ctx context.Context, sem *Semaphore) error {
+	if sem.TryAcquire() {
+		return nil
+	}
+	if t.queueTimeout <= 0 {
+		return sem.Acquire(ctx)
+	}
+	ctxTimeout, cancel := context.WithTimeout(ctx, t.queueTimeout)
+	defer cancel()
+	err := sem.Acquire(ctxTimeout)
+	if err == context.DeadlineExceeded && ctx.Err() == nil {
+		return ErrQueueTimeout
+	}
+	return err
+}
+
+// releaseBody releases the permit once the response body is closed.
+type releaseBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (b *releaseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// releaseReadWriteBody is the releaseBody of a writable response body.
+type releaseReadWriteBody struct {
+	*releaseBody
+	io.Writer
+}
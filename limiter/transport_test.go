@@ -0,0 +1,164 @@
+package limiter
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTransport(t *testing.T) {
+	var cur, max int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&cur, 1)
+		for {
+			m := atomic.LoadInt32(&max)
+			if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&cur, -1)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: NewTransport(nil, 2, 0)}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(srv.URL)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+	if max > 2 {
+		t.Fatalf("expect at most 2 concurrent requests, got %d", max)
+	}
+}
+
+func TestTransportQueueTimeout(t *testing.T) {
+	block := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-block
+	}))
+	defer srv.Close()
+	defer close(block)
+
+	tr := NewTransport(nil, 1, 20*time.Millisecond)
+	client := &http.Client{Transport: tr}
+	go client.Get(srv.URL)
+	for tr.InFlight(srv.Listener.Addr().String()) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	_, err := client.Get(srv.URL)
+	if err == nil {
+		t.Fatal("expect queue timeout error")
+	}
+	t.Log(err)
+
+	body := &closeBody{Reader: strings.NewReader("x")}
+	req, _ := http.NewRequest("POST", srv.URL, body)
+	if _, err = tr.RoundTrip(req); err != ErrQueueTimeout {
+		t.Fatalf("expect ErrQueueTimeout, got %v", err)
+	}
+	if !body.closed {
+		t.Fatal("request body should be closed")
+	}
+}
+
+type closeBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *closeBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestHostKey(t *testing.T) {
+	cases := []struct {
+		scheme, host, key string
+	}{
+		{"http", "Example.com", "example.com:80"},
+		{"http", "example.com:80", "example.com:80"},
+		{"https", "example.com", "example.com:443"},
+		{"https", "example.com:", "example.com:443"},
+		{"wss", "example.com:8443", "example.com:8443"},
+		{"http", "[::1]", "[::1]:80"},
+		{"", "example.com", "example.com"},
+	}
+	for _, c := range cases {
+		if key := hostKey(c.scheme, c.host); key != c.key {
+			t.Errorf("hostKey(%q, %q) = %q, expect %q", c.scheme, c.host, key, c.key)
+		}
+	}
+}
+
+type fakeRoundTripper struct {
+	body      io.ReadCloser
+	idleClose bool
+}
+
+func (f *fakeRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusSwitchingProtocols, Body: f.body, Request: req}, nil
+}
+
+func (f *fakeRoundTripper) CloseIdleConnections() {
+	f.idleClose = true
+}
+
+type readWriteBody struct {
+	closeBody
+	written []byte
+}
+
+func (b *readWriteBody) Write(p []byte) (int, error) {
+	b.written = append(b.written, p...)
+	return len(p), nil
+}
+
+func TestTransportHostSemaphore(t *testing.T) {
+	body := &readWriteBody{closeBody: closeBody{Reader: strings.NewReader("")}}
+	base := &fakeRoundTripper{body: body}
+	tr := NewTransport(base, 1, 0)
+	req, _ := http.NewRequest("GET", "http://Example.com/", nil)
+	resp, err := tr.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := tr.InFlight("example.com:80"); n != 1 {
+		t.Fatalf("expect 1 in-flight request, got %d", n)
+	}
+	w, ok := resp.Body.(io.Writer)
+	if !ok {
+		t.Fatal("response body should keep io.Writer")
+	}
+	w.Write([]byte("x"))
+	if string(body.written) != "x" {
+		t.Fatalf("expect written %q, got %q", "x", body.written)
+	}
+	resp.Body.Close()
+	if !body.closed {
+		t.Fatal("response body should be closed")
+	}
+	if n := len(tr.sems); n != 0 {
+		t.Fatalf("expect the idle semaphore evicted, got %d", n)
+	}
+	tr.CloseIdleConnections()
+	if !base.idleClose {
+		t.Fatal("CloseIdleConnections should pass through")
+	}
+}
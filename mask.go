@@ -0,0 +1,100 @@
+package goutil
+
+import (
+	"strings"
+)
+
+// MaskChar is the rune used to mask the sensitive characters.
+const MaskChar = '*'
+
+// MaskMiddle masks s except for the first keepPrefix and the last keepSuffix runes,
+// such as MaskMiddle("abcdef", 1, 2) returns "a***ef".
+// If s is too short to hide anything, all the runes are masked.
+func MaskMiddle(s string, keepPrefix, keepSuffix int) string {
+	rs := []rune(s)
+	if keepPrefix < 0 {
+		keepPrefix = 0
+	}
+	if keepSuffix < 0 {
+		keepSuffix = 0
+	}
+	if keepPrefix+keepSuffix >= len(rs) {
+		keepPrefix, keepSuffix = 0, 0
+	}
+	for i := keepPrefix; i < len(rs)-keepSuffix; i++ {
+		rs[i] = MaskChar
+	}
+	return string(rs)
+}
+
+// MaskEmail masks the local part of the email address,
+// such as MaskEmail("john.doe@example.com") returns "j******e@example.com".
+func MaskEmail(email string) string {
+	i := strings.LastIndexByte(email, '@')
+	if i < 0 {
+		return MaskMiddle(email, 1, 1)
+	}
+	local := email[:i]
+	if len([]rune(local)) <= 2 {
+		local = MaskMiddle(local, 1, 0)
+	} else {
+		local = MaskMiddle(local, 1, 1)
+	}
+	return local + email[i:]
+}
+
+// MaskPhone masks the digits of the phone number except for the first 3 and the last 4,
+// the formatting characters are kept,
+// such as MaskPhone("13812345678") returns "138****5678".
+// For the numbers shorter than 10 digits, only the last half (at most 4) digits are kept.
+func MaskPhone(phone string) string {
+	n := countDigits(phone)
+	pre, suf := 3, 4
+	if n < 10 {
+		pre, suf = 0, n/2
+		if suf > 4 {
+			suf = 4
+		}
+	}
+	if phone != "" && phone[0] == '+' {
+		// Don't count the country code into the prefix.
+		pre = 0
+	}
+	return maskDigits(phone, pre, suf)
+}
+
+// MaskCard masks the digits of the card number except for the last 4,
+// the formatting characters are kept,
+// such as MaskCard("4111 1111 1111 1111") returns "**** **** **** 1111".
+func MaskCard(card string) string {
+	return maskDigits(card, 0, 4)
+}
+
+func countDigits(s string) int {
+	var n int
+	for i := 0; i < len(s); i++ {
+		if s[i] >= '0' && s[i] <= '9' {
+			n++
+		}
+	}
+	return n
+}
+
+func maskDigits(s string, keepPrefix, keepSuffix int) string {
+	n := countDigits(s)
+	if keepPrefix+keepSuffix >= n {
+		keepPrefix, keepSuffix = 0, 0
+	}
+	b := []byte(s)
+	var i int
+	for j, c := range b {
+		if c < '0' || c > '9' {
+			continue
+		}
+		if i >= keepPrefix && i < n-keepSuffix {
+			b[j] = MaskChar
+		}
+		i++
+	}
+	return string(b)
+}
@@ -0,0 +1,29 @@
+package goutil
+
+import (
+	"testing"
+)
+
+func TestMask(t *testing.T) {
+	cases := []struct {
+		got, expect string
+	}{
+		{MaskMiddle("abcdef", 1, 2), "a***ef"},
+		{MaskMiddle("中文名字", 1, 1), "中**字"},
+		{MaskMiddle("ab", 1, 1), "**"},
+		{MaskEmail("john.doe@example.com"), "j******e@example.com"},
+		{MaskEmail("jo@example.com"), "j*@example.com"},
+		{MaskEmail("notanemail"), "n********l"},
+		{MaskPhone("13812345678"), "138****5678"},
+		{MaskPhone("+1 (555) 123-4567"), "+* (***) ***-4567"},
+		{MaskPhone("123456"), "***456"},
+		{MaskCard("4111 1111 1111 1111"), "**** **** **** 1111"},
+		{MaskCard("4111-1111-1111-1111"), "****-****-****-1111"},
+		{MaskCard("123"), "***"},
+	}
+	for _, c := range cases {
+		if c.got != c.expect {
+			t.Errorf("got %q, expect %q", c.got, c.expect)
+		}
+	}
+}
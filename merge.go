@@ -0,0 +1,88 @@
+package goutil
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// MergeMode controls how MergeJSON and MergeMapsDeep merge the arrays.
+type MergeMode int
+
+const (
+	// MergeReplaceArrays replaces the base arrays with the patch arrays, as RFC 7396 does.
+	MergeReplaceArrays MergeMode = iota
+	// MergeAppendArrays appends the patch arrays to the base arrays.
+	MergeAppendArrays
+)
+
+// MergeJSON applies the JSON merge patch (RFC 7396) to the base JSON document:
+// the objects are merged recursively, null deletes the key,
+// and the other values replace the base values.
+// With MergeAppendArrays, the arrays are appended instead of replaced.
+func MergeJSON(base, patch []byte, mode ...MergeMode) ([]byte, error) {
+	var b, p interface{}
+	if len(bytes.TrimSpace(base)) > 0 {
+		if err := unmarshalUseNumber(base, &b); err != nil {
+			return nil, err
+		}
+	}
+	if err := unmarshalUseNumber(patch, &p); err != nil {
+		return nil, err
+	}
+	return json.Marshal(mergeValue(b, p, pickMergeMode(mode)))
+}
+
+// MergeMapsDeep merges the layers in order into a new map, the later layers take precedence.
+// It follows the same rules as MergeJSON, and does not modify the layers,
+// e.g. MergeMapsDeep(MergeReplaceArrays, defaults, env, file).
+func MergeMapsDeep(mode MergeMode, layers ...map[string]interface{}) map[string]interface{} {
+	r := make(map[string]interface{})
+	for _, layer := range layers {
+		if layer != nil {
+			r = mergeObject(r, layer, mode)
+		}
+	}
+	return r
+}
+
+func pickMergeMode(mode []MergeMode) MergeMode {
+	if len(mode) > 0 {
+		return mode[0]
+	}
+	return MergeReplaceArrays
+}
+
+func unmarshalUseNumber(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+func mergeValue(base, patch interface{}, mode MergeMode) interface{} {
+	switch p := patch.(type) {
+	case map[string]interface{}:
+		b, _ := base.(map[string]interface{})
+		return mergeObject(b, p, mode)
+	case []interface{}:
+		if b, ok := base.([]interface{}); ok && mode == MergeAppendArrays {
+			r := make([]interface{}, 0, len(b)+len(p))
+			return append(append(r, b...), p...)
+		}
+	}
+	return patch
+}
+
+func mergeObject(base, patch map[string]interface{}, mode MergeMode) map[string]interface{} {
+	r := make(map[string]interface{}, len(base)+len(patch))
+	for k, v := range base {
+		r[k] = v
+	}
+	for k, v := range patch {
+		if v == nil {
+			delete(r, k)
+			continue
+		}
+		r[k] = mergeValue(r[k], v, mode)
+	}
+	return r
+}
@@ -0,0 +1,49 @@
+package goutil
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeJSON(t *testing.T) {
+	for _, c := range []struct {
+		base, patch, expect string
+		mode                MergeMode
+	}{
+		// RFC 7396 appendix A
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`, MergeReplaceArrays},
+		{`{"a":"b"}`, `{"a":null}`, `{}`, MergeReplaceArrays},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`, MergeReplaceArrays},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`, MergeReplaceArrays},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`, MergeReplaceArrays},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`, MergeReplaceArrays},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`, MergeReplaceArrays},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`, MergeReplaceArrays},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`, MergeReplaceArrays},
+		{``, `{"n":12345678901234567890}`, `{"n":12345678901234567890}`, MergeReplaceArrays},
+		// additive arrays
+		{`{"a":[1],"b":{"c":[2]}}`, `{"a":[3],"b":{"c":[4]}}`, `{"a":[1,3],"b":{"c":[2,4]}}`, MergeAppendArrays},
+	} {
+		got, err := MergeJSON([]byte(c.base), []byte(c.patch), c.mode)
+		if err != nil || string(got) != c.expect {
+			t.Errorf("MergeJSON(%s, %s) = %s, %v; expect %s", c.base, c.patch, got, err, c.expect)
+		}
+	}
+	if _, err := MergeJSON([]byte(`{}`), []byte(`{`)); err == nil {
+		t.Error("expect invalid patch error")
+	}
+}
+
+func TestMergeMapsDeep(t *testing.T) {
+	defaults := map[string]interface{}{"db": map[string]interface{}{"host": "localhost", "port": 5432}, "debug": false}
+	env := map[string]interface{}{"db": map[string]interface{}{"host": "db.local"}}
+	file := map[string]interface{}{"debug": true, "db": map[string]interface{}{"port": nil}}
+	got := MergeMapsDeep(MergeReplaceArrays, defaults, nil, env, file)
+	expect := map[string]interface{}{"db": map[string]interface{}{"host": "db.local"}, "debug": true}
+	if !reflect.DeepEqual(got, expect) {
+		t.Fatalf("got %v", got)
+	}
+	if defaults["db"].(map[string]interface{})["host"] != "localhost" {
+		t.Fatal("layer is modified")
+	}
+}
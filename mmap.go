@@ -0,0 +1,100 @@
+package goutil
+
+import (
+	"errors"
+	"os"
+	"sync"
+)
+
+// ErrMmapClosed is returned when using a closed MmapFile.
+var ErrMmapClosed = errors.New("goutil: mmap file closed")
+
+// MmapFile is a memory-mapped file.
+type MmapFile struct {
+	f        *os.File
+	data     []byte
+	writable bool
+	mu       sync.Mutex
+	closed   bool
+}
+
+// Mmap maps the whole file into memory for the fast random access without read syscalls.
+// If writable is true, the changes of the bytes are written back to the file,
+// otherwise modifying the bytes panics (on the platforms with mmap).
+// The file size can not be changed by the map.
+func Mmap(path string, writable ...bool) (*MmapFile, error) {
+	m := &MmapFile{writable: len(writable) > 0 && writable[0]}
+	flag := os.O_RDONLY
+	if m.writable {
+		flag = os.O_RDWR
+	}
+	f, err := os.OpenFile(path, flag, 0)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	size := info.Size()
+	if int64(int(size)) != size {
+		f.Close()
+		return nil, errors.New("goutil: file too large to mmap")
+	}
+	if size > 0 {
+		if m.data, err = mmapFile(f, int(size), m.writable); err != nil {
+			f.Close()
+			return nil, &os.PathError{Op: "mmap", Path: path, Err: err}
+		}
+	}
+	m.f = f
+	return m, nil
+}
+
+// Bytes returns the mapped bytes, which are invalid after Close.
+func (m *MmapFile) Bytes() []byte {
+	return m.data
+}
+
+// Len returns the length of the mapped bytes.
+func (m *MmapFile) Len() int {
+	return len(m.data)
+}
+
+// Flush synchronously writes the changes of the writable map back to the file.
+func (m *MmapFile) Flush() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return ErrMmapClosed
+	}
+	if !m.writable || len(m.data) == 0 {
+		return nil
+	}
+	return msyncFile(m.f, m.data)
+}
+
+// Close unmaps the file and closes it, the writable changes are flushed first.
+func (m *MmapFile) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return ErrMmapClosed
+	}
+	m.closed = true
+	var err error
+	if len(m.data) > 0 {
+		if m.writable {
+			err = msyncFile(m.f, m.data)
+		}
+		if e := munmapFile(m.f, m.data); err == nil {
+			err = e
+		}
+		m.data = nil
+	}
+	if e := m.f.Close(); err == nil {
+		err = e
+	}
+	return err
+}
@@ -0,0 +1,27 @@
+//go:build !linux && !darwin && !freebsd && !openbsd && !dragonfly && !windows
+// +build !linux,!darwin,!freebsd,!openbsd,!dragonfly,!windows
+
+package goutil
+
+import (
+	"io"
+	"os"
+)
+
+// mmapFile reads the file into memory where mmap is unavailable.
+func mmapFile(f *os.File, size int, _ bool) ([]byte, error) {
+	b := make([]byte, size)
+	_, err := io.ReadFull(f, b)
+	return b, err
+}
+
+func munmapFile(_ *os.File, _ []byte) error {
+	return nil
+}
+
+func msyncFile(f *os.File, b []byte) error {
+	if _, err := f.WriteAt(b, 0); err != nil {
+		return err
+	}
+	return f.Sync()
+}
@@ -0,0 +1,60 @@
+package goutil
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestMmap(t *testing.T) {
+	f, err := ioutil.TempFile("", "goutil_mmap")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("hello world")
+	f.Close()
+
+	m, err := Mmap(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(m.Bytes()) != "hello world" || m.Len() != 11 {
+		t.Fatalf("got %q", m.Bytes())
+	}
+	if err = m.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err = m.Close(); err != ErrMmapClosed {
+		t.Fatalf("expect ErrMmapClosed, got %v", err)
+	}
+
+	m, err = Mmap(f.Name(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	copy(m.Bytes(), "HELLO")
+	if err = m.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	b, _ := ioutil.ReadFile(f.Name())
+	if string(b) != "HELLO world" {
+		t.Fatalf("got %q", b)
+	}
+	copy(m.Bytes()[6:], "WORLD")
+	if err = m.Close(); err != nil {
+		t.Fatal(err)
+	}
+	b, _ = ioutil.ReadFile(f.Name())
+	if string(b) != "HELLO WORLD" {
+		t.Fatalf("got %q", b)
+	}
+
+	empty, _ := ioutil.TempFile("", "goutil_mmap")
+	empty.Close()
+	defer os.Remove(empty.Name())
+	if m, err = Mmap(empty.Name()); err != nil || m.Len() != 0 {
+		t.Fatal(err)
+	}
+	m.Close()
+}
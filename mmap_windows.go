@@ -0,0 +1,39 @@
+//go:build windows
+// +build windows
+
+package goutil
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+func mmapFile(f *os.File, size int, writable bool) ([]byte, error) {
+	prot, access := uint32(syscall.PAGE_READONLY), uint32(syscall.FILE_MAP_READ)
+	if writable {
+		prot, access = syscall.PAGE_READWRITE, syscall.FILE_MAP_WRITE
+	}
+	h, err := syscall.CreateFileMapping(syscall.Handle(f.Fd()), nil, prot, uint32(int64(size)>>32), uint32(size), nil)
+	if err != nil {
+		return nil, os.NewSyscallError("CreateFileMapping", err)
+	}
+	// the view keeps the mapping object alive
+	defer syscall.CloseHandle(h)
+	addr, err := syscall.MapViewOfFile(h, access, 0, 0, uintptr(size))
+	if err != nil {
+		return nil, os.NewSyscallError("MapViewOfFile", err)
+	}
+	return mmapSlice(addr, size), nil
+}
+
+func munmapFile(_ *os.File, b []byte) error {
+	return os.NewSyscallError("UnmapViewOfFile", syscall.UnmapViewOfFile(uintptr(unsafe.Pointer(&b[0]))))
+}
+
+func msyncFile(f *os.File, b []byte) error {
+	if err := syscall.FlushViewOfFile(uintptr(unsafe.Pointer(&b[0])), uintptr(len(b))); err != nil {
+		return os.NewSyscallError("FlushViewOfFile", err)
+	}
+	return os.NewSyscallError("FlushFileBuffers", syscall.FlushFileBuffers(syscall.Handle(f.Fd())))
+}
@@ -0,0 +1,12 @@
+//go:build windows && go1.17
+// +build windows,go1.17
+
+package goutil
+
+import "unsafe"
+
+// mmapSlice returns the mapped view at addr as a []byte.
+// addr is outside the Go heap, it is reinterpreted rather than converted to keep vet quiet.
+func mmapSlice(addr uintptr, size int) []byte {
+	return unsafe.Slice((*byte)(*(*unsafe.Pointer)(unsafe.Pointer(&addr))), size)
+}
@@ -0,0 +1,17 @@
+//go:build windows && !go1.17
+// +build windows,!go1.17
+
+package goutil
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// mmapSlice returns the mapped view at addr as a []byte.
+func mmapSlice(addr uintptr, size int) []byte {
+	var b []byte
+	hdr := (*reflect.SliceHeader)(unsafe.Pointer(&b))
+	hdr.Data, hdr.Len, hdr.Cap = addr, size, size
+	return b
+}
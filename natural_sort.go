@@ -0,0 +1,82 @@
+package goutil
+
+import (
+	"sort"
+)
+
+// NaturalCompare compares a and b in natural order, where the digit runs are
+// compared by their numeric values, so "file2" < "file10".
+// Returns -1 if a<b, 0 if a==b, +1 if a>b.
+func NaturalCompare(a, b string) int {
+	i, j := 0, 0
+	tie := 0 // result decided by the leading zeros, if all else is equal
+	for i < len(a) && j < len(b) {
+		ca, cb := a[i], b[j]
+		if isDigit(ca) && isDigit(cb) {
+			// Skip the leading zeros.
+			za, zb := i, j
+			for i < len(a) && a[i] == '0' {
+				i++
+			}
+			for j < len(b) && b[j] == '0' {
+				j++
+			}
+			za, zb = i-za, j-zb
+			// Compare the numbers by length, then by digits.
+			sa, sb := i, j
+			for i < len(a) && isDigit(a[i]) {
+				i++
+			}
+			for j < len(b) && isDigit(b[j]) {
+				j++
+			}
+			if la, lb := i-sa, j-sb; la != lb {
+				return compareInt(la, lb)
+			}
+			for k := 0; k < i-sa; k++ {
+				if a[sa+k] != b[sb+k] {
+					return compareInt(int(a[sa+k]), int(b[sb+k]))
+				}
+			}
+			if tie == 0 && za != zb {
+				tie = compareInt(za, zb)
+			}
+			continue
+		}
+		if ca != cb {
+			return compareInt(int(ca), int(cb))
+		}
+		i++
+		j++
+	}
+	if r := compareInt(len(a)-i, len(b)-j); r != 0 {
+		return r
+	}
+	return tie
+}
+
+// NaturalSort sorts the strings in natural order in place, so "file2" sorts before "file10".
+func NaturalSort(a []string) {
+	sort.Sort(NaturalStrings(a))
+}
+
+// NaturalStrings attaches the methods of sort.Interface to []string, sorting in natural order.
+type NaturalStrings []string
+
+func (p NaturalStrings) Len() int           { return len(p) }
+func (p NaturalStrings) Less(i, j int) bool { return NaturalCompare(p[i], p[j]) < 0 }
+func (p NaturalStrings) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,37 @@
+package goutil
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNaturalCompare(t *testing.T) {
+	cases := []struct {
+		a, b string
+		r    int
+	}{
+		{"file2", "file10", -1},
+		{"file10", "file2", 1},
+		{"file02", "file2", 1},
+		{"file2", "file2", 0},
+		{"a", "b", -1},
+		{"x1y2", "x1y10", -1},
+		{"v1.10.0", "v1.9.3", 1},
+		{"abc", "abc1", -1},
+		{"", "0", -1},
+	}
+	for _, c := range cases {
+		if r := NaturalCompare(c.a, c.b); r != c.r {
+			t.Errorf("NaturalCompare(%q, %q) = %d, expect %d", c.a, c.b, r, c.r)
+		}
+	}
+}
+
+func TestNaturalSort(t *testing.T) {
+	a := []string{"file10.txt", "file2.txt", "file1.txt", "File3.txt", "file02.txt"}
+	NaturalSort(a)
+	expect := []string{"File3.txt", "file1.txt", "file2.txt", "file02.txt", "file10.txt"}
+	if !reflect.DeepEqual(a, expect) {
+		t.Fatalf("got %v, expect %v", a, expect)
+	}
+}
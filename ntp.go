@@ -0,0 +1,204 @@
+package goutil
+
+import (
+	"encoding/binary"
+	"errors"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/henrylee2cn/goutil/coarsetime"
+)
+
+// ErrNTPResponse is returned when the NTP server responds an invalid packet.
+var ErrNTPResponse = errors.New("goutil: invalid NTP response")
+
+// NTPResult is the result of an SNTP query.
+type NTPResult struct {
+	// Offset is the offset to add to the local clock to match the server.
+	Offset time.Duration
+	// RTT is the round-trip delay of the query, excluding the processing time of the server.
+	RTT time.Duration
+	// Time is the corrected local time when the response arrived.
+	Time time.Time
+	// Stratum is the stratum of the server.
+	Stratum int
+}
+
+// the seconds from 1900-01-01, the NTP era 0, to the Unix epoch
+const ntpEpochOffset = 2208988800
+
+func toNTPTime(t time.Time) uint64 {
+	sec := uint64(t.Unix() + ntpEpochOffset)
+	frac := uint64(t.Nanosecond()) << 32 / 1e9
+	return sec<<32 | frac
+}
+
+func fromNTPTime(v uint64) time.Time {
+	sec := int64(v >> 32)
+	if sec < 1<<31 {
+		// the era 1 since 2036, as RFC 4330 suggests
+		sec += 1 << 32
+	}
+	sec -= ntpEpochOffset
+	nsec := int64((v & 0xffffffff) * 1e9 >> 32)
+	return time.Unix(sec, nsec)
+}
+
+// QueryNTPOffset queries the offset of the local clock and the round-trip delay
+// by the SNTP (RFC 4330). The server is "host" or "host:port", with the port 123 by default.
+// The timeout is 5s by default.
+func QueryNTPOffset(server string, timeout ...time.Duration) (NTPResult, error) {
+	var r NTPResult
+	to := 5 * time.Second
+	if len(timeout) > 0 && timeout[0] > 0 {
+		to = timeout[0]
+	}
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "123")
+	}
+	conn, err := net.DialTimeout("udp", server, to)
+	if err != nil {
+		return r, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(to))
+
+	req := make([]byte, 48)
+	req[0] = 0x23 // LI 0, version 4, mode 3 (client)
+	t1 := time.Now()
+	binary.BigEndian.PutUint64(req[40:], toNTPTime(t1))
+	if _, err = conn.Write(req); err != nil {
+		return r, err
+	}
+	resp := make([]byte, 48)
+	for {
+		n, err := conn.Read(resp)
+		if err != nil {
+			return r, err
+		}
+		t4 := time.Now()
+		// skips the stray packets not answering the request
+		if n < 48 || binary.BigEndian.Uint64(resp[24:]) != binary.BigEndian.Uint64(req[40:]) {
+			continue
+		}
+		if mode := resp[0] & 7; mode != 4 && mode != 5 || resp[1] == 0 || resp[1] > 15 || resp[0]>>6 == 3 {
+			// the unsynchronized or kiss-of-death response
+			return r, ErrNTPResponse
+		}
+		t2 := fromNTPTime(binary.BigEndian.Uint64(resp[32:]))
+		t3 := fromNTPTime(binary.BigEndian.Uint64(resp[40:]))
+		// the monotonic clock makes t4-t1 immune to the local clock changes
+		r.RTT = t4.Sub(t1) - t3.Sub(t2)
+		if r.RTT < 0 {
+			r.RTT = 0
+		}
+		r.Offset = (t2.Sub(t1) + t3.Sub(t4)) / 2
+		r.Time = t4.Add(r.Offset)
+		r.Stratum = int(resp[1])
+		return r, nil
+	}
+}
+
+// NTPCheckerOptions is the options of NewNTPChecker.
+type NTPCheckerOptions struct {
+	// Servers are queried in order until one responds, "pool.ntp.org" by default.
+	Servers []string
+	// Interval is the check interval, 10 minutes by default.
+	Interval time.Duration
+	// Threshold is the max tolerable absolute offset, 100ms by default.
+	Threshold time.Duration
+	// OnDrift is called when the offset exceeds the Threshold, logging a warning by default.
+	OnDrift func(NTPResult)
+	// Clock is corrected by the offset of every check if not nil, e.g. coarsetime.DefaultClock().
+	Clock *coarsetime.Clock
+	// Timeout is the timeout of every query, 5s by default.
+	Timeout time.Duration
+}
+
+// NTPChecker periodically checks the drift of the local clock,
+// which matters for the time-based ids such as the Snowflake ones.
+type NTPChecker struct {
+	opts     NTPCheckerOptions
+	mu       sync.Mutex
+	last     NTPResult
+	lastErr  error
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewNTPChecker creates and starts a checker, which checks at once and then every interval.
+// opts may be nil.
+func NewNTPChecker(opts *NTPCheckerOptions) *NTPChecker {
+	c := &NTPChecker{stop: make(chan struct{})}
+	if opts != nil {
+		c.opts = *opts
+	}
+	if len(c.opts.Servers) == 0 {
+		c.opts.Servers = []string{"pool.ntp.org"}
+	}
+	if c.opts.Interval <= 0 {
+		c.opts.Interval = 10 * time.Minute
+	}
+	if c.opts.Threshold <= 0 {
+		c.opts.Threshold = 100 * time.Millisecond
+	}
+	if c.opts.OnDrift == nil {
+		threshold := c.opts.Threshold
+		c.opts.OnDrift = func(r NTPResult) {
+			log.Printf("goutil: local clock drifts %v from NTP (threshold %v)", r.Offset, threshold)
+		}
+	}
+	go c.run()
+	return c
+}
+
+func (c *NTPChecker) run() {
+	ticker := time.NewTicker(c.opts.Interval)
+	defer ticker.Stop()
+	for {
+		c.Check()
+		select {
+		case <-ticker.C:
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+// Check queries the servers once, and returns the result.
+func (c *NTPChecker) Check() (NTPResult, error) {
+	var r NTPResult
+	var err error
+	for _, server := range c.opts.Servers {
+		if r, err = QueryNTPOffset(server, c.opts.Timeout); err == nil {
+			break
+		}
+	}
+	c.mu.Lock()
+	c.last, c.lastErr = r, err
+	c.mu.Unlock()
+	if err != nil {
+		return r, err
+	}
+	if c.opts.Clock != nil {
+		c.opts.Clock.SetOffset(r.Offset)
+	}
+	if r.Offset > c.opts.Threshold || r.Offset < -c.opts.Threshold {
+		c.opts.OnDrift(r)
+	}
+	return r, nil
+}
+
+// Last returns the result of the last check.
+func (c *NTPChecker) Last() (NTPResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.last, c.lastErr
+}
+
+// Stop stops the periodic checks.
+func (c *NTPChecker) Stop() {
+	c.stopOnce.Do(func() { close(c.stop) })
+}
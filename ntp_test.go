@@ -0,0 +1,87 @@
+package goutil
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/henrylee2cn/goutil/coarsetime"
+)
+
+// serveNTP serves the SNTP responses whose clock is ahead by the offset.
+func serveNTP(t *testing.T, offset time.Duration, stratum byte) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	go func() {
+		buf := make([]byte, 48)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if n < 48 {
+				continue
+			}
+			resp := make([]byte, 48)
+			resp[0] = 0x24 // version 4, mode 4 (server)
+			resp[1] = stratum
+			copy(resp[24:32], buf[40:48])
+			now := toNTPTime(time.Now().Add(offset))
+			binary.BigEndian.PutUint64(resp[32:], now)
+			binary.BigEndian.PutUint64(resp[40:], now)
+			conn.WriteTo(resp, addr)
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+func TestNTPTime(t *testing.T) {
+	for _, now := range []time.Time{time.Unix(1600000000, 123456789), time.Date(2040, 1, 1, 0, 0, 0, 5000, time.UTC)} {
+		if got := fromNTPTime(toNTPTime(now)); got.Sub(now) > time.Microsecond || now.Sub(got) > time.Microsecond {
+			t.Fatalf("got %v, want %v", got, now)
+		}
+	}
+}
+
+func TestQueryNTPOffset(t *testing.T) {
+	addr := serveNTP(t, 2*time.Second, 2)
+	r, err := QueryNTPOffset(addr, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Offset < 1900*time.Millisecond || r.Offset > 2100*time.Millisecond || r.Stratum != 2 || r.RTT < 0 {
+		t.Fatalf("got %+v", r)
+	}
+	if _, err = QueryNTPOffset(serveNTP(t, 0, 0), time.Second); err != ErrNTPResponse {
+		t.Fatalf("expect the kiss-of-death error, got %v", err)
+	}
+}
+
+func TestNTPChecker(t *testing.T) {
+	clock := coarsetime.NewClock(time.Millisecond)
+	defer clock.Stop()
+	drift := make(chan NTPResult, 1)
+	c := NewNTPChecker(&NTPCheckerOptions{
+		Servers:  []string{serveNTP(t, -time.Second, 1)},
+		Interval: time.Hour,
+		Timeout:  time.Second,
+		Clock:    clock,
+		OnDrift:  func(r NTPResult) { drift <- r },
+	})
+	defer c.Stop()
+	select {
+	case r := <-drift:
+		if r.Offset > -900*time.Millisecond {
+			t.Fatalf("got %+v", r)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout")
+	}
+	if r, err := c.Last(); err != nil || r.Offset != clock.Offset() {
+		t.Fatalf("got %+v, %v, clock offset %v", r, err, clock.Offset())
+	}
+}
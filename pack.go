@@ -0,0 +1,343 @@
+package goutil
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Pack serializes the struct (or pointer to struct) into a fixed binary layout
+// driven by the `bin` tags, the byte order is big-endian by default.
+// The tag options:
+//
+//	be / le   the byte order of the field (including its nested fields)
+//	size=N    the width in bytes, 1 to 8 for integers (e.g. 3-byte integers),
+//	          and required for string and []byte, which are padded with zeros
+//	pad=N     N zero bytes before the field
+//	-         skip the field
+//
+// Supported field types: bool, integers, floats, string, []byte, arrays, nested structs,
+// and the blank '_' fields are written as zeros of the width of their type and tag, e.g. `_ [3]byte`.
+// int, uint and uintptr are 8 bytes on every platform unless the size is set.
+// The unexported fields other than '_' must be skipped by the '-' tag.
+func Pack(v interface{}, order ...binary.ByteOrder) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("goutil: Pack requires a struct, got %T", v)
+	}
+	return packValue(nil, rv, packOption{little: isLittleEndian(order)})
+}
+
+// Unpack parses the fixed binary layout into the struct which ptr points to,
+// following the same `bin` tag rules as Pack, and returns the number of bytes consumed.
+func Unpack(data []byte, ptr interface{}, order ...binary.ByteOrder) (int, error) {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return 0, errors.New("goutil: Unpack requires a non-nil pointer to struct")
+	}
+	return unpackValue(data, rv.Elem(), packOption{little: isLittleEndian(order)})
+}
+
+type packOption struct {
+	little bool
+	size   int
+	pad    int
+}
+
+func isLittleEndian(order []binary.ByteOrder) bool {
+	return len(order) > 0 && order[0] != nil && order[0].Uint16([]byte{1, 0}) == 1
+}
+
+func parsePackTag(sf reflect.StructField, parent packOption) (opt packOption, skip bool, err error) {
+	opt.little = parent.little
+	tag := sf.Tag.Get("bin")
+	if tag == "-" {
+		return opt, true, nil
+	}
+	for _, p := range strings.Split(tag, ",") {
+		switch {
+		case p == "":
+		case p == "be":
+			opt.little = false
+		case p == "le":
+			opt.little = true
+		case strings.HasPrefix(p, "size="):
+			opt.size, err = strconv.Atoi(p[len("size="):])
+		case strings.HasPrefix(p, "pad="):
+			opt.pad, err = strconv.Atoi(p[len("pad="):])
+		default:
+			err = errors.New("unknown option " + p)
+		}
+		if err == nil && (opt.size < 0 || opt.pad < 0) {
+			err = errors.New("negative size or pad")
+		}
+		if err != nil {
+			return opt, false, fmt.Errorf("goutil: bin tag of field %s: %v", sf.Name, err)
+		}
+	}
+	return opt, false, nil
+}
+
+func intWidth(v reflect.Value, opt packOption) (int, error) {
+	w := int(v.Type().Size())
+	switch v.Kind() {
+	case reflect.Int, reflect.Uint, reflect.Uintptr:
+		// keeps the layout the same on the 32-bit and 64-bit platforms
+		w = 8
+	}
+	if opt.size > 0 {
+		if opt.size > 8 {
+			return 0, fmt.Errorf("goutil: integer size %d is larger than 8", opt.size)
+		}
+		w = opt.size
+	}
+	return w, nil
+}
+
+// blankSize returns the width of the blank field, the same as a field of its type and options.
+func blankSize(sf reflect.StructField, opt packOption) (int, error) {
+	b, err := packValue(nil, reflect.New(sf.Type).Elem(), opt)
+	if err != nil {
+		return 0, fmt.Errorf("%w (field _)", err)
+	}
+	return len(b), nil
+}
+
+// unexportedPackError rejects the unexported field instead of skipping it,
+// which would shift the offsets of the fields after it.
+func unexportedPackError(sf reflect.StructField) error {
+	return fmt.Errorf("goutil: unexported field %s must be tagged with bin:\"-\"", sf.Name)
+}
+
+func putUintN(b []byte, u uint64, w int, little bool) []byte {
+	for i := 0; i < w; i++ {
+		shift := uint(8 * (w - 1 - i))
+		if little {
+			shift = uint(8 * i)
+		}
+		b = append(b, byte(u>>shift))
+	}
+	return b
+}
+
+func uintN(b []byte, w int, little bool) uint64 {
+	var u uint64
+	for i := 0; i < w; i++ {
+		shift := uint(8 * (w - 1 - i))
+		if little {
+			shift = uint(8 * i)
+		}
+		u |= uint64(b[i]) << shift
+	}
+	return u
+}
+
+func packValue(b []byte, v reflect.Value, opt packOption) ([]byte, error) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return append(b, 1), nil
+		}
+		return append(b, 0), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		w, err := intWidth(v, opt)
+		if err != nil {
+			return nil, err
+		}
+		n := v.Int()
+		if w < 8 && (n < -1<<(8*uint(w)-1) || n >= 1<<(8*uint(w)-1)) {
+			return nil, fmt.Errorf("goutil: %d overflows %d bytes", n, w)
+		}
+		return putUintN(b, uint64(n), w, opt.little), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		w, err := intWidth(v, opt)
+		if err != nil {
+			return nil, err
+		}
+		n := v.Uint()
+		if w < 8 && n >= 1<<(8*uint(w)) {
+			return nil, fmt.Errorf("goutil: %d overflows %d bytes", n, w)
+		}
+		return putUintN(b, n, w, opt.little), nil
+	case reflect.Float32:
+		return putUintN(b, uint64(math.Float32bits(float32(v.Float()))), 4, opt.little), nil
+	case reflect.Float64:
+		return putUintN(b, math.Float64bits(v.Float()), 8, opt.little), nil
+	case reflect.String, reflect.Slice:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8 {
+			break
+		}
+		if opt.size == 0 {
+			return nil, errors.New("goutil: size option is required for " + v.Type().String())
+		}
+		if v.Len() > opt.size {
+			return nil, fmt.Errorf("goutil: length %d exceeds size %d", v.Len(), opt.size)
+		}
+		if v.Kind() == reflect.String {
+			b = append(b, v.String()...)
+		} else {
+			b = append(b, v.Bytes()...)
+		}
+		return append(b, make([]byte, opt.size-v.Len())...), nil
+	case reflect.Array:
+		elemOpt := packOption{little: opt.little}
+		var err error
+		for i := 0; i < v.Len(); i++ {
+			if b, err = packValue(b, v.Index(i), elemOpt); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			fopt, skip, err := parsePackTag(sf, opt)
+			if err != nil {
+				return nil, err
+			}
+			if skip {
+				continue
+			}
+			b = append(b, make([]byte, fopt.pad)...)
+			if sf.Name == "_" {
+				n, err := blankSize(sf, fopt)
+				if err != nil {
+					return nil, err
+				}
+				b = append(b, make([]byte, n)...)
+				continue
+			}
+			if sf.PkgPath != "" {
+				return nil, unexportedPackError(sf)
+			}
+			if b, err = packValue(b, v.Field(i), fopt); err != nil {
+				return nil, fmt.Errorf("%w (field %s)", err, sf.Name)
+			}
+		}
+		return b, nil
+	}
+	return nil, errors.New("goutil: unsupported binary type " + v.Type().String())
+}
+
+func unpackValue(data []byte, v reflect.Value, opt packOption) (int, error) {
+	need := func(n int) error {
+		if len(data) < n {
+			return ErrShortBytes
+		}
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		if err := need(1); err != nil {
+			return 0, err
+		}
+		v.SetBool(data[0] != 0)
+		return 1, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		w, err := intWidth(v, opt)
+		if err != nil {
+			return 0, err
+		}
+		if err = need(w); err != nil {
+			return 0, err
+		}
+		shift := uint(64 - 8*w)
+		v.SetInt(int64(uintN(data, w, opt.little)<<shift) >> shift)
+		return w, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		w, err := intWidth(v, opt)
+		if err != nil {
+			return 0, err
+		}
+		if err = need(w); err != nil {
+			return 0, err
+		}
+		v.SetUint(uintN(data, w, opt.little))
+		return w, nil
+	case reflect.Float32:
+		if err := need(4); err != nil {
+			return 0, err
+		}
+		v.SetFloat(float64(math.Float32frombits(uint32(uintN(data, 4, opt.little)))))
+		return 4, nil
+	case reflect.Float64:
+		if err := need(8); err != nil {
+			return 0, err
+		}
+		v.SetFloat(math.Float64frombits(uintN(data, 8, opt.little)))
+		return 8, nil
+	case reflect.String, reflect.Slice:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8 {
+			break
+		}
+		if opt.size == 0 {
+			return 0, errors.New("goutil: size option is required for " + v.Type().String())
+		}
+		if err := need(opt.size); err != nil {
+			return 0, err
+		}
+		if v.Kind() == reflect.String {
+			v.SetString(strings.TrimRight(string(data[:opt.size]), "\x00"))
+		} else {
+			v.SetBytes(append([]byte(nil), data[:opt.size]...))
+		}
+		return opt.size, nil
+	case reflect.Array:
+		elemOpt := packOption{little: opt.little}
+		off := 0
+		for i := 0; i < v.Len(); i++ {
+			n, err := unpackValue(data[off:], v.Index(i), elemOpt)
+			if err != nil {
+				return 0, err
+			}
+			off += n
+		}
+		return off, nil
+	case reflect.Struct:
+		t := v.Type()
+		off := 0
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			fopt, skip, err := parsePackTag(sf, opt)
+			if err != nil {
+				return 0, err
+			}
+			if skip {
+				continue
+			}
+			off += fopt.pad
+			if sf.Name == "_" {
+				n, err := blankSize(sf, fopt)
+				if err != nil {
+					return 0, err
+				}
+				off += n
+				continue
+			}
+			if sf.PkgPath != "" {
+				return 0, unexportedPackError(sf)
+			}
+			if off > len(data) {
+				return 0, ErrShortBytes
+			}
+			n, err := unpackValue(data[off:], v.Field(i), fopt)
+			if err != nil {
+				return 0, fmt.Errorf("%w (field %s)", err, sf.Name)
+			}
+			off += n
+		}
+		if off > len(data) {
+			return 0, ErrShortBytes
+		}
+		return off, nil
+	}
+	return 0, errors.New("goutil: unsupported binary type " + v.Type().String())
+}
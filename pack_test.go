@@ -0,0 +1,121 @@
+package goutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+type packFlags struct {
+	Ack bool
+	Seq uint16 `bin:"le"`
+}
+
+type packHeader struct {
+	Magic   [2]byte
+	Version uint8
+	_       [1]byte
+	Length  uint32
+	Offset  int32  `bin:"size=3"`
+	Name    string `bin:"size=6"`
+	Flags   packFlags
+	Ratio   float32 `bin:"pad=2"`
+	Ignored string  `bin:"-"`
+	Payload []byte  `bin:"size=2"`
+}
+
+func TestPack(t *testing.T) {
+	h := packHeader{
+		Magic:   [2]byte{'G', 'U'},
+		Version: 1,
+		Length:  0x01020304,
+		Offset:  -2,
+		Name:    "goutil",
+		Flags:   packFlags{Ack: true, Seq: 0x0102},
+		Ratio:   1,
+		Ignored: "x",
+		Payload: []byte{7},
+	}
+	b, err := Pack(&h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := []byte{
+		'G', 'U', 1, 0,
+		1, 2, 3, 4,
+		0xff, 0xff, 0xfe,
+		'g', 'o', 'u', 't', 'i', 'l',
+		1, 0x02, 0x01,
+		0, 0, 0x3f, 0x80, 0, 0,
+		7, 0,
+	}
+	if !bytes.Equal(b, expect) {
+		t.Fatalf("got    %x\nexpect %x", b, expect)
+	}
+	var u packHeader
+	n, err := Unpack(append(b, 0xee), &u)
+	if err != nil || n != len(expect) {
+		t.Fatal(n, err)
+	}
+	h.Ignored, h.Payload = "", []byte{7, 0}
+	if u.Name != h.Name || u.Offset != h.Offset || u.Flags != h.Flags || u.Ratio != h.Ratio ||
+		u.Length != h.Length || !bytes.Equal(u.Payload, h.Payload) || u.Ignored != "" {
+		t.Fatalf("got %+v", u)
+	}
+
+	le, err := Pack(packFlags{Seq: 1}, binary.LittleEndian)
+	if err != nil || !bytes.Equal(le, []byte{0, 1, 0}) {
+		t.Fatal(le, err)
+	}
+	if _, err = Unpack(b[:10], &u); !errors.Is(err, ErrShortBytes) {
+		t.Fatalf("expect short bytes error, got %v", err)
+	}
+	var plat struct {
+		N int
+		U uint `bin:"size=2"`
+	}
+	plat.N, plat.U = -2, 3
+	if b, err := Pack(plat); err != nil || !bytes.Equal(b, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe, 0, 3}) {
+		t.Fatal(b, err)
+	}
+	h.Name = "too long name"
+	if _, err = Pack(h); err == nil {
+		t.Fatal("expect size error")
+	}
+	h.Name, h.Offset = "", 1<<23
+	if _, err = Pack(h); err == nil {
+		t.Fatal("expect overflow error")
+	}
+}
+
+func TestPackFieldRules(t *testing.T) {
+	var blank struct {
+		A uint8
+		_ int
+		_ uint32 `bin:"size=2"`
+		B uint8
+	}
+	blank.A, blank.B = 1, 2
+	b, err := Pack(&blank)
+	if err != nil || !bytes.Equal(b, []byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2}) {
+		t.Fatal(b, err)
+	}
+	blank.A, blank.B = 0, 0
+	if n, err := Unpack(b, &blank); err != nil || n != len(b) || blank.A != 1 || blank.B != 2 {
+		t.Fatal(n, err, blank)
+	}
+
+	var hidden struct {
+		A    uint8
+		b    uint8
+		c    uint8 `bin:"-"`
+		Last uint8
+	}
+	if _, err = Pack(hidden); err == nil {
+		t.Fatal("expect unexported field error")
+	}
+	if _, err = Unpack([]byte{1, 2, 3}, &hidden); err == nil {
+		t.Fatal("expect unexported field error")
+	}
+}
@@ -0,0 +1,97 @@
+package goutil
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnknownTimeFormat is returned when ParseTimeAny matches none of the layouts.
+var ErrUnknownTimeFormat = errors.New("goutil: unknown time format")
+
+// LayoutUnix is the pseudo layout of ParseTimeAny matching the Unix timestamps,
+// in seconds (may be fractional), milliseconds, microseconds or nanoseconds by the magnitude.
+const LayoutUnix = "unix"
+
+// DefaultTimeLayouts is the layouts tried by ParseTimeAny in order, when no layouts are given.
+var DefaultTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"2006/01/02 15:04:05",
+	"2006/01/02",
+	"20060102150405",
+	"20060102",
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC850,
+	time.ANSIC,
+	time.UnixDate,
+	time.RubyDate,
+	"02 Jan 2006 15:04:05",
+	"Jan 2, 2006",
+	"2 Jan 2006",
+	LayoutUnix,
+}
+
+// ParseTimeAny parses s by trying the layouts in order (DefaultTimeLayouts by default)
+// and returns the first match, for ingesting the heterogeneous upstream timestamps.
+// The time without a zone is in loc, time.Local if loc is nil.
+// The layout LayoutUnix matches the Unix timestamps.
+func ParseTimeAny(s string, loc *time.Location, layouts ...string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if loc == nil {
+		loc = time.Local
+	}
+	if len(layouts) == 0 {
+		layouts = DefaultTimeLayouts
+	}
+	for _, layout := range layouts {
+		if layout == LayoutUnix {
+			if t, ok := parseUnixTime(s); ok {
+				return t.In(loc), nil
+			}
+			continue
+		}
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, ErrUnknownTimeFormat
+}
+
+func parseUnixTime(s string) (time.Time, bool) {
+	if s == "" {
+		return time.Time{}, false
+	}
+	if strings.IndexByte(s, '.') >= 0 {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return time.Time{}, false
+		}
+		sec := int64(f)
+		return time.Unix(sec, int64((f-float64(sec))*1e9)), true
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	abs := n
+	if abs < 0 {
+		abs = -abs
+	}
+	switch {
+	case abs < 1e11:
+		return time.Unix(n, 0), true
+	case abs < 1e14:
+		return time.Unix(0, n*int64(time.Millisecond)), true
+	case abs < 1e17:
+		return time.Unix(0, n*int64(time.Microsecond)), true
+	default:
+		return time.Unix(0, n), true
+	}
+}
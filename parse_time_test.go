@@ -0,0 +1,43 @@
+package goutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTimeAny(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*3600)
+	want := time.Date(2020, 8, 20, 15, 4, 5, 0, loc)
+	for _, s := range []string{
+		"2020-08-20T15:04:05+08:00",
+		"2020-08-20T07:04:05Z",
+		"2020-08-20T15:04:05",
+		"2020-08-20 15:04:05",
+		" 2020/08/20 15:04:05 ",
+		"20200820150405",
+		"Thu, 20 Aug 2020 15:04:05 +0800",
+		"1597907045",
+		"1597907045000",
+		"1597907045.0",
+	} {
+		got, err := ParseTimeAny(s, loc)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("%q: got %v, %v", s, got, err)
+		}
+	}
+	if got, err := ParseTimeAny("20200820", loc); err != nil || !got.Equal(time.Date(2020, 8, 20, 0, 0, 0, 0, loc)) {
+		t.Errorf("got %v, %v", got, err)
+	}
+	if got, err := ParseTimeAny("1597907045123", nil); err != nil || got.UnixNano() != 1597907045123*int64(time.Millisecond) {
+		t.Errorf("got %v, %v", got, err)
+	}
+	if _, err := ParseTimeAny("20200820", loc, LayoutUnix, "2006-01-02"); err != nil {
+		t.Error(err)
+	}
+	if _, err := ParseTimeAny("2020-08-20", loc, LayoutUnix); err != ErrUnknownTimeFormat {
+		t.Errorf("got %v", err)
+	}
+	if _, err := ParseTimeAny("yesterday", loc); err != ErrUnknownTimeFormat {
+		t.Errorf("got %v", err)
+	}
+}
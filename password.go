@@ -0,0 +1,91 @@
+package goutil
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidPasswordHash is returned when the password hash is malformed.
+var ErrInvalidPasswordHash = errors.New("goutil: invalid password hash")
+
+const (
+	passwordSaltLen = 16
+	passwordKeyLen  = 32
+)
+
+// HashPassword hashes the password with Argon2id and a random salt,
+// using DefaultArgon2Params by default.
+// The returned string is in the PHC format with the parameters embedded, such as:
+//
+//	$argon2id$v=19$m=65536,t=3,p=4$<salt>$<hash>
+func HashPassword(password string, params ...Argon2Params) (string, error) {
+	p := DefaultArgon2Params
+	if len(params) > 0 {
+		p = params[0]
+	}
+	if err := p.Validate(); err != nil {
+		return "", err
+	}
+	salt := make([]byte, passwordSaltLen)
+	cryptoFill(salt)
+	key := Argon2IDKey(StringToBytes(password), salt, p.Time, p.Memory, p.Threads, passwordKeyLen)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2Version, p.Memory, p.Time, p.Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// CheckPassword reports whether the password matches the hash returned by HashPassword,
+// comparing in constant time.
+// If it matches but the hash was made with other parameters than the current ones
+// (DefaultArgon2Params by default), newHash is the rehashed password with the current
+// parameters, which should replace the stored hash; otherwise newHash is empty.
+// The hash with the parameters beyond MaxArgon2Params is reported as ErrInvalidPasswordHash
+// before deriving the key, so that a forged hash can't make the check cost much more than
+// DefaultArgon2Params; raise MaxArgon2Params first if the stored hashes use stronger parameters.
+func CheckPassword(password, hash string, params ...Argon2Params) (ok bool, newHash string, err error) {
+	p, salt, key, err := parsePasswordHash(hash)
+	if err != nil {
+		return false, "", err
+	}
+	other := Argon2IDKey(StringToBytes(password), salt, p.Time, p.Memory, p.Threads, uint32(len(key)))
+	if subtle.ConstantTimeCompare(key, other) != 1 {
+		return false, "", nil
+	}
+	current := DefaultArgon2Params
+	if len(params) > 0 {
+		current = params[0]
+	}
+	if p != current || len(salt) != passwordSaltLen || len(key) != passwordKeyLen {
+		newHash, err = HashPassword(password, current)
+		if err != nil {
+			return true, "", err
+		}
+	}
+	return true, newHash, nil
+}
+
+func parsePasswordHash(hash string) (p Argon2Params, salt, key []byte, err error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return p, nil, nil, ErrInvalidPasswordHash
+	}
+	var version int
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2Version {
+		return p, nil, nil, ErrInvalidPasswordHash
+	}
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Time, &p.Threads); err != nil || p.Validate() != nil {
+		return p, nil, nil, ErrInvalidPasswordHash
+	}
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return p, nil, nil, ErrInvalidPasswordHash
+	}
+	if key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(key) == 0 {
+		return p, nil, nil, ErrInvalidPasswordHash
+	}
+	return p, salt, key, nil
+}
@@ -0,0 +1,39 @@
+package goutil
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPassword(t *testing.T) {
+	weak := Argon2Params{Time: 1, Memory: 64, Threads: 1}
+	strong := Argon2Params{Time: 2, Memory: 128, Threads: 2}
+	hash, err := HashPassword("s3cret", weak)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(hash, "$argon2id$v=19$m=64,t=1,p=1$") {
+		t.Fatalf("got %q", hash)
+	}
+	ok, newHash, err := CheckPassword("s3cret", hash, weak)
+	if !ok || newHash != "" || err != nil {
+		t.Fatal(ok, newHash, err)
+	}
+	if ok, _, _ = CheckPassword("wrong", hash, weak); ok {
+		t.Fatal("expect mismatch")
+	}
+	ok, newHash, err = CheckPassword("s3cret", hash, strong)
+	if !ok || err != nil || !strings.HasPrefix(newHash, "$argon2id$v=19$m=128,t=2,p=2$") {
+		t.Fatal(ok, newHash, err)
+	}
+	if ok, _, _ = CheckPassword("s3cret", newHash, strong); !ok {
+		t.Fatal("upgraded hash should match")
+	}
+	for _, bad := range []string{"", "$argon2i$v=19$m=64,t=1,p=1$c2FsdA$aGFzaA", "$argon2id$v=16$m=64,t=1,p=1$c2FsdA$aGFzaA", "$argon2id$v=19$m=64,t=0,p=1$c2FsdA$aGFzaA", "$argon2id$v=19$m=64,t=1,p=1$!$aGFzaA",
+		"$argon2id$v=19$m=4294967295,t=1,p=1$c2FsdA$aGFzaA", "$argon2id$v=19$m=64,t=1000,p=1$c2FsdA$aGFzaA", "$argon2id$v=19$m=64,t=1,p=256$c2FsdA$aGFzaA",
+		"$argon2id$v=19$m=1048576,t=10,p=255$c2FsdA$aGFzaA", "$argon2id$v=19$m=65537,t=1,p=1$c2FsdA$aGFzaA", "$argon2id$v=19$m=64,t=5,p=1$c2FsdA$aGFzaA"} {
+		if _, _, err = CheckPassword("s3cret", bad); err != ErrInvalidPasswordHash {
+			t.Errorf("CheckPassword(%q) err = %v", bad, err)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package goutil
+
+import (
+	"errors"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+)
+
+// ErrPathTraversal is returned by SecureJoin if the path escapes from the base directory.
+var ErrPathTraversal = errors.New("goutil: path escapes from the base directory")
+
+// ExpandHome expands the leading "~" or "~user" of the path to the home directory.
+func ExpandHome(path string) (string, error) {
+	if !strings.HasPrefix(path, "~") {
+		return path, nil
+	}
+	name, rest := path[1:], ""
+	if i := strings.IndexAny(name, `/\`); i >= 0 {
+		name, rest = name[:i], name[i:]
+	}
+	var home string
+	if name == "" {
+		var err error
+		if home, err = os.UserHomeDir(); err != nil {
+			return "", err
+		}
+	} else {
+		u, err := user.Lookup(name)
+		if err != nil {
+			return "", err
+		}
+		home = u.HomeDir
+	}
+	return home + rest, nil
+}
+
+// AbsPath returns the cleaned absolute path with the home directory expanded.
+func AbsPath(path string) (string, error) {
+	path, err := ExpandHome(path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Abs(path)
+}
+
+// SecureJoin joins the untrusted userPath to base, and returns ErrPathTraversal if
+// the result escapes from base by "..", a volume name or the existing symbolic links.
+// The absolute userPath is treated as relative to base.
+func SecureJoin(base, userPath string) (string, error) {
+	userPath = filepath.FromSlash(userPath)
+	if filepath.VolumeName(userPath) != "" {
+		return "", ErrPathTraversal
+	}
+	base = filepath.Clean(base)
+	joined := filepath.Join(base, userPath)
+	if !withinDir(base, joined) {
+		return "", ErrPathTraversal
+	}
+	realBase, err := filepath.EvalSymlinks(base)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return joined, nil
+		}
+		return "", err
+	}
+	// resolves the longest existing prefix
+	existing := joined
+	for existing != base {
+		if _, err = os.Lstat(existing); err == nil {
+			break
+		}
+		existing = filepath.Dir(existing)
+	}
+	real, err := filepath.EvalSymlinks(existing)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// a dangling symlink
+			return "", ErrPathTraversal
+		}
+		return "", err
+	}
+	if !withinDir(realBase, real) {
+		return "", ErrPathTraversal
+	}
+	return joined, nil
+}
+
+func withinDir(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,70 @@
+package goutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestExpandHome(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skip(err)
+	}
+	for in, expect := range map[string]string{
+		"~":     home,
+		"~/a/b": home + "/a/b",
+		"a/~":   "a/~",
+		"/x":    "/x",
+	} {
+		got, err := ExpandHome(in)
+		if err != nil || got != expect {
+			t.Fatalf("%q: got %q, %v", in, got, err)
+		}
+	}
+	abs, err := AbsPath("~/a/../b")
+	if err != nil || abs != filepath.Join(home, "b") {
+		t.Fatalf("got %q, %v", abs, err)
+	}
+}
+
+func TestSecureJoin(t *testing.T) {
+	base, err := ioutil.TempDir("", "securejoin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(base)
+	for in, expect := range map[string]string{
+		"a/b":         filepath.Join(base, "a", "b"),
+		"/etc/passwd": filepath.Join(base, "etc", "passwd"),
+		"a/../b":      filepath.Join(base, "b"),
+		"":            base,
+	} {
+		got, err := SecureJoin(base, in)
+		if err != nil || got != expect {
+			t.Fatalf("%q: got %q, %v", in, got, err)
+		}
+	}
+	for _, in := range []string{"..", "../x", "a/../../x"} {
+		if _, err := SecureJoin(base, in); err != ErrPathTraversal {
+			t.Fatalf("%q: got %v", in, err)
+		}
+	}
+	if runtime.GOOS == "windows" {
+		return
+	}
+	if err = os.Symlink("/", filepath.Join(base, "root")); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Symlink(".", filepath.Join(base, "self")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = SecureJoin(base, "root/etc/passwd"); err != ErrPathTraversal {
+		t.Fatalf("got %v", err)
+	}
+	if got, err := SecureJoin(base, "self/x"); err != nil || got != filepath.Join(base, "self", "x") {
+		t.Fatalf("got %q, %v", got, err)
+	}
+}
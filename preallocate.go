@@ -0,0 +1,75 @@
+package goutil
+
+import (
+	"errors"
+	"os"
+)
+
+// ErrSparseUnsupported is returned by FileHoles on the platforms without SEEK_HOLE support.
+var ErrSparseUnsupported = errors.New("goutil: sparse file detection is not supported on this platform")
+
+// Preallocate reserves the disk space of the file up to size bytes without writing the zero pages,
+// and extends the file size if it is smaller, by fallocate on Linux, F_PREALLOCATE on macOS
+// and SetEndOfFile on Windows. On the other platforms, the file is only truncated to the size.
+func Preallocate(f *os.File, size int64) error {
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if fi.Size() >= size {
+		return nil
+	}
+	return preallocate(f, fi.Size(), size)
+}
+
+// IsSparse reports whether the file has fewer blocks allocated than its size.
+func IsSparse(filename string) (bool, error) {
+	fi, err := os.Stat(filename)
+	if err != nil {
+		return false, err
+	}
+	return isSparse(fi), nil
+}
+
+// FileHole is a hole of a sparse file, in [Offset, Offset+Length).
+type FileHole struct {
+	Offset int64
+	Length int64
+}
+
+// FileHoles returns the holes of the file by SEEK_HOLE and SEEK_DATA, or ErrSparseUnsupported.
+// The implicit hole at the end of file is not included.
+// It changes the file offset.
+func FileHoles(f *os.File) ([]FileHole, error) {
+	if seekHole < 0 {
+		return nil, ErrSparseUnsupported
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	var holes []FileHole
+	var off int64
+	for off < fi.Size() {
+		start, err := f.Seek(off, seekHole)
+		if err != nil {
+			if err = seekErr(err); err != nil {
+				return nil, err
+			}
+			break
+		}
+		if start >= fi.Size() {
+			break
+		}
+		end, err := f.Seek(start, seekData)
+		if err != nil {
+			if err = seekErr(err); err != nil {
+				return nil, err
+			}
+			end = fi.Size()
+		}
+		holes = append(holes, FileHole{Offset: start, Length: end - start})
+		off = end
+	}
+	return holes, nil
+}
@@ -0,0 +1,43 @@
+//go:build darwin
+// +build darwin
+
+package goutil
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+const (
+	seekHole = 3
+	seekData = 4
+
+	fPreallocate    = 42
+	fAllocateAll    = 0x4
+	fPEOFPosMode    = 3
+	fAllocateContig = 0x2
+)
+
+// fstore is fstore_t of fcntl F_PREALLOCATE.
+type fstore struct {
+	flags      uint32
+	posmode    int32
+	offset     int64
+	length     int64
+	bytesalloc int64
+}
+
+func preallocate(f *os.File, cur, size int64) error {
+	st := fstore{flags: fAllocateContig | fAllocateAll, posmode: fPEOFPosMode, length: size - cur}
+	_, _, errno := syscall.Syscall(syscall.SYS_FCNTL, f.Fd(), fPreallocate, uintptr(unsafe.Pointer(&st)))
+	if errno != 0 {
+		// retries without the contiguous space
+		st.flags = fAllocateAll
+		_, _, errno = syscall.Syscall(syscall.SYS_FCNTL, f.Fd(), fPreallocate, uintptr(unsafe.Pointer(&st)))
+	}
+	if errno != 0 && errno != syscall.ENOTSUP {
+		return errno
+	}
+	return f.Truncate(size)
+}
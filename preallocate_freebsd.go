@@ -0,0 +1,15 @@
+//go:build freebsd
+// +build freebsd
+
+package goutil
+
+import "os"
+
+const (
+	seekData = 3
+	seekHole = 4
+)
+
+func preallocate(f *os.File, cur, size int64) error {
+	return f.Truncate(size)
+}
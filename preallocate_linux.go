@@ -0,0 +1,27 @@
+//go:build linux
+// +build linux
+
+package goutil
+
+import (
+	"os"
+	"syscall"
+)
+
+const (
+	seekData = 3
+	seekHole = 4
+)
+
+func preallocate(f *os.File, cur, size int64) error {
+	for {
+		err := syscall.Fallocate(int(f.Fd()), 0, cur, size-cur)
+		switch err {
+		case syscall.EINTR:
+			continue
+		case syscall.EOPNOTSUPP, syscall.ENOSYS:
+			return f.Truncate(size)
+		}
+		return err
+	}
+}
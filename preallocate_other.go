@@ -0,0 +1,15 @@
+//go:build !darwin && !freebsd && !linux
+// +build !darwin,!freebsd,!linux
+
+package goutil
+
+import "os"
+
+const (
+	seekData = -1
+	seekHole = -1
+)
+
+func preallocate(f *os.File, cur, size int64) error {
+	return f.Truncate(size)
+}
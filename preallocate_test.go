@@ -0,0 +1,68 @@
+package goutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPreallocate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "prealloc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	f, err := os.Create(filepath.Join(dir, "a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err = Preallocate(f, 1<<20); err != nil {
+		t.Fatal(err)
+	}
+	if fi, _ := f.Stat(); fi.Size() != 1<<20 {
+		t.Fatalf("got size %d", fi.Size())
+	}
+	// never shrinks
+	if err = Preallocate(f, 10); err != nil {
+		t.Fatal(err)
+	}
+	if fi, _ := f.Stat(); fi.Size() != 1<<20 {
+		t.Fatalf("got size %d", fi.Size())
+	}
+}
+
+func TestFileHoles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sparse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "s")
+	f, err := os.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err = f.WriteAt(make([]byte, 4096), 1<<20); err != nil {
+		t.Fatal(err)
+	}
+	sparse, err := IsSparse(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	holes, err := FileHoles(f)
+	if err == ErrSparseUnsupported {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sparse || len(holes) == 0 {
+		t.Skip("the file system does not support the sparse files")
+	}
+	if holes[0].Offset != 0 || holes[0].Length != 1<<20 {
+		t.Fatalf("got %+v", holes)
+	}
+}
@@ -0,0 +1,107 @@
+package goutil
+
+import (
+	"runtime"
+	"sync"
+	"time"
+)
+
+// ProcStat is a resource usage snapshot of the current process.
+type ProcStat struct {
+	Time time.Time
+	// RSS is the resident set size in bytes, the peak one on the BSDs and macOS.
+	RSS uint64
+	// CPUTime is the user and system CPU time since the process start.
+	CPUTime time.Duration
+	// CPUPercent is the CPU usage since the previous snapshot, which can exceed 100 on the multi-core machines.
+	CPUPercent   float64
+	NumGoroutine int
+	// NumFD is the number of the open file descriptors (handles on Windows), or -1 if unknown.
+	NumFD        int
+	HeapAlloc    uint64
+	HeapSys      uint64
+	NumGC        uint32
+	GCPauseTotal time.Duration
+	LastGC       time.Time
+}
+
+type cpuTracker struct {
+	mu   sync.Mutex
+	time time.Time
+	cpu  time.Duration
+}
+
+func (c *cpuTracker) percent(now time.Time, cpu time.Duration) float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var p float64
+	if elapsed := now.Sub(c.time); elapsed > 0 {
+		p = float64(cpu-c.cpu) / float64(elapsed) * 100
+	}
+	c.time, c.cpu = now, cpu
+	return p
+}
+
+var procStatsCPU = cpuTracker{time: time.Now()}
+
+// ProcStats returns a resource usage snapshot of the current process,
+// whose CPUPercent is computed since the previous call or the program start.
+func ProcStats() ProcStat {
+	return readProcStat(&procStatsCPU)
+}
+
+func readProcStat(c *cpuTracker) ProcStat {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	s := ProcStat{
+		Time:         time.Now(),
+		RSS:          selfRSS(),
+		CPUTime:      selfCPUTime(),
+		NumGoroutine: runtime.NumGoroutine(),
+		NumFD:        selfNumFD(),
+		HeapAlloc:    m.HeapAlloc,
+		HeapSys:      m.HeapSys,
+		NumGC:        m.NumGC,
+		GCPauseTotal: time.Duration(m.PauseTotalNs),
+	}
+	if m.LastGC > 0 {
+		s.LastGC = time.Unix(0, int64(m.LastGC))
+	}
+	s.CPUPercent = c.percent(s.Time, s.CPUTime)
+	return s
+}
+
+// ProcSampler emits the ProcStat snapshots on an interval.
+type ProcSampler struct {
+	stop chan struct{}
+	once sync.Once
+	wg   sync.WaitGroup
+}
+
+// NewProcSampler starts calling fn with a snapshot every interval,
+// whose CPUPercent is computed since the previous snapshot.
+func NewProcSampler(interval time.Duration, fn func(ProcStat)) *ProcSampler {
+	s := &ProcSampler{stop: make(chan struct{})}
+	c := &cpuTracker{time: time.Now(), cpu: selfCPUTime()}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.stop:
+				return
+			case <-ticker.C:
+				fn(readProcStat(c))
+			}
+		}
+	}()
+	return s
+}
+
+// Stop stops the sampler and waits for the running fn to return.
+func (s *ProcSampler) Stop() {
+	s.once.Do(func() { close(s.stop) })
+	s.wg.Wait()
+}
@@ -0,0 +1,18 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !windows
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!windows
+
+package goutil
+
+import "time"
+
+func selfCPUTime() time.Duration {
+	return 0
+}
+
+func selfRSS() uint64 {
+	return 0
+}
+
+func selfNumFD() int {
+	return -1
+}
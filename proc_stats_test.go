@@ -0,0 +1,44 @@
+package goutil
+
+import (
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestProcStats(t *testing.T) {
+	s := ProcStats()
+	if s.NumGoroutine < 1 || s.HeapSys == 0 || s.Time.IsZero() {
+		t.Fatalf("got %+v", s)
+	}
+	if runtime.GOOS == "linux" || runtime.GOOS == "windows" {
+		if s.RSS == 0 || s.NumFD < 1 {
+			t.Fatalf("got %+v", s)
+		}
+	}
+	if s.CPUPercent < 0 {
+		t.Fatalf("got %+v", s)
+	}
+}
+
+func TestProcSampler(t *testing.T) {
+	ch := make(chan ProcStat, 10)
+	s := NewProcSampler(10*time.Millisecond, func(st ProcStat) {
+		select {
+		case ch <- st:
+		default:
+		}
+	})
+	defer s.Stop()
+	for i := 0; i < 2; i++ {
+		select {
+		case st := <-ch:
+			if st.NumGoroutine < 1 {
+				t.Fatalf("got %+v", st)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("timeout")
+		}
+	}
+	s.Stop()
+}
@@ -0,0 +1,70 @@
+//go:build windows
+// +build windows
+
+package goutil
+
+import (
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+var (
+	procK32GetProcessMemoryInfo = modkernel32.NewProc("K32GetProcessMemoryInfo")
+	procGetProcessHandleCount   = modkernel32.NewProc("GetProcessHandleCount")
+)
+
+type processMemoryCounters struct {
+	cb                         uint32
+	PageFaultCount             uint32
+	PeakWorkingSetSize         uintptr
+	WorkingSetSize             uintptr
+	QuotaPeakPagedPoolUsage    uintptr
+	QuotaPagedPoolUsage        uintptr
+	QuotaPeakNonPagedPoolUsage uintptr
+	QuotaNonPagedPoolUsage     uintptr
+	PagefileUsage              uintptr
+	PeakPagefileUsage          uintptr
+}
+
+func selfCPUTime() time.Duration {
+	h, err := syscall.GetCurrentProcess()
+	if err != nil {
+		return 0
+	}
+	var creation, exit, kernel, user syscall.Filetime
+	if syscall.GetProcessTimes(h, &creation, &exit, &kernel, &user) != nil {
+		return 0
+	}
+	// Filetime is in 100-nanosecond intervals
+	ticks := int64(kernel.HighDateTime)<<32 | int64(kernel.LowDateTime)
+	ticks += int64(user.HighDateTime)<<32 | int64(user.LowDateTime)
+	return time.Duration(ticks * 100)
+}
+
+func selfRSS() uint64 {
+	h, err := syscall.GetCurrentProcess()
+	if err != nil {
+		return 0
+	}
+	var c processMemoryCounters
+	c.cb = uint32(unsafe.Sizeof(c))
+	r, _, _ := procK32GetProcessMemoryInfo.Call(uintptr(h), uintptr(unsafe.Pointer(&c)), uintptr(c.cb))
+	if r == 0 {
+		return 0
+	}
+	return uint64(c.WorkingSetSize)
+}
+
+func selfNumFD() int {
+	h, err := syscall.GetCurrentProcess()
+	if err != nil {
+		return -1
+	}
+	var n uint32
+	r, _, _ := procGetProcessHandleCount.Call(uintptr(h), uintptr(unsafe.Pointer(&n)))
+	if r == 0 {
+		return -1
+	}
+	return int(n)
+}
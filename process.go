@@ -0,0 +1,43 @@
+package goutil
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrProcessNotFound is returned when the process doesn't exist.
+	ErrProcessNotFound = errors.New("goutil: process not found")
+	// ErrProcessUnsupported is returned by the process inspection on the unsupported platforms.
+	ErrProcessUnsupported = errors.New("goutil: process inspection is not supported on this platform")
+)
+
+// ProcInfo is the information of a process.
+type ProcInfo struct {
+	Pid  int
+	PPid int
+	// Name is the executable name, which may be truncated by the OS (15 bytes on Linux).
+	Name string
+	// Cmdline is the command line arguments, only the executable on Windows.
+	Cmdline   []string
+	StartTime time.Time
+}
+
+// ProcessInfo returns the information of the process, or ErrProcessNotFound.
+func ProcessInfo(pid int) (*ProcInfo, error) {
+	return processInfo(pid)
+}
+
+// PidExists reports whether the process exists, including those of the other users.
+func PidExists(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	return pidExists(pid)
+}
+
+// FindProcessByName returns the pids of the processes whose Name or the base name
+// of the executable equals name.
+func FindProcessByName(name string) ([]int, error) {
+	return findProcessByName(name)
+}
@@ -0,0 +1,64 @@
+//go:build darwin || dragonfly || freebsd || netbsd || openbsd
+// +build darwin dragonfly freebsd netbsd openbsd
+
+package goutil
+
+import (
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+func pidExists(pid int) bool {
+	err := syscall.Kill(pid, 0)
+	return err == nil || err == syscall.EPERM
+}
+
+// processInfo queries the process by ps.
+func processInfo(pid int) (*ProcInfo, error) {
+	out, err := exec.Command("ps", "-o", "ppid=,lstart=,comm=", "-p", strconv.Itoa(pid)).Output()
+	if err != nil {
+		if !pidExists(pid) {
+			return nil, ErrProcessNotFound
+		}
+		return nil, err
+	}
+	// ppid, lstart like "Mon Jan  2 15:04:05 2006", comm
+	fields := strings.Fields(string(out))
+	if len(fields) < 7 {
+		return nil, ErrProcessNotFound
+	}
+	info := &ProcInfo{Pid: pid}
+	info.PPid, _ = strconv.Atoi(fields[0])
+	info.StartTime, _ = time.ParseInLocation("Mon Jan 2 15:04:05 2006", strings.Join(fields[1:6], " "), time.Local)
+	info.Name = filepath.Base(strings.Join(fields[6:], " "))
+	if out, err = exec.Command("ps", "-o", "args=", "-p", strconv.Itoa(pid)).Output(); err == nil {
+		info.Cmdline = strings.Fields(string(out))
+	}
+	return info, nil
+}
+
+func findProcessByName(name string) ([]int, error) {
+	out, err := exec.Command("ps", "-axo", "pid=,comm=").Output()
+	if err != nil {
+		return nil, err
+	}
+	var pids []int
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		pid, err := strconv.Atoi(fields[0])
+		if err != nil {
+			continue
+		}
+		if comm := strings.Join(fields[1:], " "); comm == name || filepath.Base(comm) == name {
+			pids = append(pids, pid)
+		}
+	}
+	return pids, nil
+}
@@ -0,0 +1,96 @@
+package goutil
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// clockTicks is the USER_HZ of /proc/<pid>/stat, which is 100 on almost all Linux systems.
+const clockTicks = 100
+
+func pidExists(pid int) bool {
+	err := syscall.Kill(pid, 0)
+	return err == nil || err == syscall.EPERM
+}
+
+func bootTime() (time.Time, error) {
+	data, err := ioutil.ReadFile("/proc/stat")
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.HasPrefix(line, "btime ") {
+			sec, err := strconv.ParseInt(strings.TrimSpace(line[6:]), 10, 64)
+			if err != nil {
+				return time.Time{}, err
+			}
+			return time.Unix(sec, 0), nil
+		}
+	}
+	return time.Time{}, os.ErrNotExist
+}
+
+func processInfo(pid int) (*ProcInfo, error) {
+	dir := "/proc/" + strconv.Itoa(pid)
+	stat, err := ioutil.ReadFile(dir + "/stat")
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrProcessNotFound
+		}
+		return nil, err
+	}
+	// pid (comm) state ppid ... starttime(22nd) ..., the comm may contain spaces and parentheses
+	open, end := bytes.IndexByte(stat, '('), bytes.LastIndexByte(stat, ')')
+	if open < 0 || end < open {
+		return nil, errors.New("goutil: invalid /proc stat")
+	}
+	fields := strings.Fields(string(stat[end+1:]))
+	if len(fields) < 20 {
+		return nil, errors.New("goutil: invalid /proc stat")
+	}
+	info := &ProcInfo{Pid: pid, Name: string(stat[open+1 : end])}
+	info.PPid, _ = strconv.Atoi(fields[1])
+	if ticks, err := strconv.ParseInt(fields[19], 10, 64); err == nil {
+		if bt, err := bootTime(); err == nil {
+			info.StartTime = bt.Add(time.Duration(ticks) * time.Second / clockTicks)
+		}
+	}
+	if cmdline, err := ioutil.ReadFile(dir + "/cmdline"); err == nil && len(cmdline) > 0 {
+		info.Cmdline = strings.Split(strings.TrimRight(string(cmdline), "\x00"), "\x00")
+	}
+	return info, nil
+}
+
+func findProcessByName(name string) ([]int, error) {
+	f, err := os.Open("/proc")
+	if err != nil {
+		return nil, err
+	}
+	names, err := f.Readdirnames(-1)
+	f.Close()
+	if err != nil {
+		return nil, err
+	}
+	var pids []int
+	for _, n := range names {
+		pid, err := strconv.Atoi(n)
+		if err != nil {
+			continue
+		}
+		info, err := processInfo(pid)
+		if err != nil {
+			continue
+		}
+		if info.Name == name || len(info.Cmdline) > 0 && filepath.Base(info.Cmdline[0]) == name {
+			pids = append(pids, pid)
+		}
+	}
+	return pids, nil
+}
@@ -0,0 +1,16 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !windows
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!windows
+
+package goutil
+
+func pidExists(pid int) bool {
+	return false
+}
+
+func processInfo(pid int) (*ProcInfo, error) {
+	return nil, ErrProcessUnsupported
+}
+
+func findProcessByName(name string) ([]int, error) {
+	return nil, ErrProcessUnsupported
+}
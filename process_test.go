@@ -0,0 +1,43 @@
+package goutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestProcessInfo(t *testing.T) {
+	pid := os.Getpid()
+	info, err := ProcessInfo(pid)
+	if err == ErrProcessUnsupported {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Pid != pid || info.PPid != os.Getppid() || len(info.Cmdline) == 0 {
+		t.Fatalf("got %+v", info)
+	}
+	if d := time.Since(info.StartTime); d < 0 || d > time.Hour {
+		t.Fatalf("start time %v", info.StartTime)
+	}
+	if !PidExists(pid) || PidExists(-1) {
+		t.Fatal("PidExists")
+	}
+	if _, err = ProcessInfo(1 << 30); err != ErrProcessNotFound {
+		t.Fatalf("expect not found, got %v", err)
+	}
+
+	pids, err := FindProcessByName(filepath.Base(info.Cmdline[0]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var found bool
+	for _, p := range pids {
+		found = found || p == pid
+	}
+	if !found {
+		t.Fatalf("self not found in %v", pids)
+	}
+}
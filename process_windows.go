@@ -0,0 +1,87 @@
+//go:build windows
+// +build windows
+
+package goutil
+
+import (
+	"strings"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+const (
+	processQueryLimitedInformation = 0x1000
+	stillActive                    = 259
+)
+
+func pidExists(pid int) bool {
+	h, err := syscall.OpenProcess(processQueryLimitedInformation, false, uint32(pid))
+	if err != nil {
+		return err == syscall.ERROR_ACCESS_DENIED
+	}
+	defer syscall.CloseHandle(h)
+	var code uint32
+	if err = syscall.GetExitCodeProcess(h, &code); err != nil {
+		return false
+	}
+	return code == stillActive
+}
+
+// walkProcesses calls fn with each process entry of the snapshot until it returns false.
+func walkProcesses(fn func(e *syscall.ProcessEntry32) bool) error {
+	snap, err := syscall.CreateToolhelp32Snapshot(syscall.TH32CS_SNAPPROCESS, 0)
+	if err != nil {
+		return err
+	}
+	defer syscall.CloseHandle(snap)
+	var e syscall.ProcessEntry32
+	e.Size = uint32(unsafe.Sizeof(e))
+	for err = syscall.Process32First(snap, &e); err == nil; err = syscall.Process32Next(snap, &e) {
+		if !fn(&e) {
+			return nil
+		}
+	}
+	if err == syscall.ERROR_NO_MORE_FILES {
+		return nil
+	}
+	return err
+}
+
+func processInfo(pid int) (*ProcInfo, error) {
+	var info *ProcInfo
+	err := walkProcesses(func(e *syscall.ProcessEntry32) bool {
+		if int(e.ProcessID) != pid {
+			return true
+		}
+		name := syscall.UTF16ToString(e.ExeFile[:])
+		info = &ProcInfo{Pid: pid, PPid: int(e.ParentProcessID), Name: name, Cmdline: []string{name}}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, ErrProcessNotFound
+	}
+	if h, err := syscall.OpenProcess(processQueryLimitedInformation, false, uint32(pid)); err == nil {
+		var creation, exit, kernel, user syscall.Filetime
+		if syscall.GetProcessTimes(h, &creation, &exit, &kernel, &user) == nil {
+			info.StartTime = time.Unix(0, creation.Nanoseconds())
+		}
+		syscall.CloseHandle(h)
+	}
+	return info, nil
+}
+
+func findProcessByName(name string) ([]int, error) {
+	var pids []int
+	err := walkProcesses(func(e *syscall.ProcessEntry32) bool {
+		exe := syscall.UTF16ToString(e.ExeFile[:])
+		if strings.EqualFold(exe, name) || strings.EqualFold(strings.TrimSuffix(exe, ".exe"), name) {
+			pids = append(pids, int(e.ProcessID))
+		}
+		return true
+	})
+	return pids, err
+}
@@ -0,0 +1,286 @@
+package goutil
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EncodeQuery encodes the struct (or pointer to struct) into url.Values.
+// The fields are named by the `query` tag, such as:
+//
+//	type Params struct {
+//		IDs   []int     `query:"id"`
+//		Since time.Time `query:"since,omitempty,layout=2006-01-02"`
+//		Limit *int      `query:"limit,omitempty"`
+//		Skip  string    `query:"-"`
+//	}
+//
+// The untagged exported fields use the field names, and the embedded structs are flattened.
+// Supported field types: string, bool, numbers, time.Time (RFC3339 by default,
+// 'layout=unix' means the unix seconds), time.Duration, encoding.TextMarshaler,
+// and the pointers and slices of them.
+func EncodeQuery(v interface{}) (url.Values, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, errors.New("goutil: EncodeQuery requires a struct, got " + reflect.TypeOf(v).String())
+	}
+	values := make(url.Values)
+	err := walkQueryFields(rv, func(f reflect.Value, opt queryOption) error {
+		if opt.omitempty && isEmptyValue(f) {
+			return nil
+		}
+		for f.Kind() == reflect.Ptr {
+			if f.IsNil() {
+				return nil
+			}
+			f = f.Elem()
+		}
+		if f.Kind() == reflect.Slice && f.Type().Elem().Kind() != reflect.Uint8 {
+			for i := 0; i < f.Len(); i++ {
+				s, err := formatScalar(f.Index(i), opt.layout)
+				if err != nil {
+					return fmt.Errorf("goutil: query field %q: %v", opt.name, err)
+				}
+				values.Add(opt.name, s)
+			}
+			return nil
+		}
+		s, err := formatScalar(f, opt.layout)
+		if err != nil {
+			return fmt.Errorf("goutil: query field %q: %v", opt.name, err)
+		}
+		values.Add(opt.name, s)
+		return nil
+	})
+	return values, err
+}
+
+// DecodeQuery decodes the url.Values into the struct which ptr points to.
+// It follows the same `query` tag rules as EncodeQuery,
+// the fields without the corresponding values are left unchanged.
+func DecodeQuery(values url.Values, ptr interface{}) error {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("goutil: DecodeQuery requires a non-nil pointer to struct")
+	}
+	return walkQueryFields(rv.Elem(), func(f reflect.Value, opt queryOption) error {
+		vs, ok := values[opt.name]
+		if !ok || len(vs) == 0 {
+			return nil
+		}
+		if err := setFromStrings(f, vs, opt.layout); err != nil {
+			return fmt.Errorf("goutil: query field %q: %v", opt.name, err)
+		}
+		return nil
+	})
+}
+
+type queryOption struct {
+	name      string
+	omitempty bool
+	layout    string
+}
+
+func walkQueryFields(rv reflect.Value, fn func(reflect.Value, queryOption) error) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		tag := sf.Tag.Get("query")
+		if tag == "-" {
+			continue
+		}
+		f := rv.Field(i)
+		if sf.Anonymous && tag == "" && sf.Type.Kind() == reflect.Struct {
+			if err := walkQueryFields(f, fn); err != nil {
+				return err
+			}
+			continue
+		}
+		if sf.PkgPath != "" {
+			continue
+		}
+		opt := parseQueryTag(sf.Name, tag)
+		if err := fn(f, opt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseQueryTag(fieldName, tag string) queryOption {
+	parts := strings.Split(tag, ",")
+	opt := queryOption{name: parts[0]}
+	if opt.name == "" {
+		opt.name = fieldName
+	}
+	for _, p := range parts[1:] {
+		switch {
+		case p == "omitempty":
+			opt.omitempty = true
+		case strings.HasPrefix(p, "layout="):
+			opt.layout = p[len("layout="):]
+		}
+	}
+	return opt
+}
+
+var (
+	timeType            = reflect.TypeOf(time.Time{})
+	durationType        = reflect.TypeOf(time.Duration(0))
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// formatScalar formats the non-pointer scalar value into string.
+func formatScalar(v reflect.Value, layout string) (string, error) {
+	switch v.Type() {
+	case timeType:
+		t := v.Interface().(time.Time)
+		switch layout {
+		case "":
+			return t.Format(time.RFC3339), nil
+		case "unix":
+			return strconv.FormatInt(t.Unix(), 10), nil
+		}
+		return t.Format(layout), nil
+	case durationType:
+		return v.Interface().(time.Duration).String(), nil
+	}
+	if v.Type().Implements(textMarshalerType) {
+		b, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(b), err
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 32), nil
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64), nil
+	}
+	return "", errors.New("unsupported type " + v.Type().String())
+}
+
+// setFromStrings sets the value (pointer, slice or scalar) from the strings.
+func setFromStrings(v reflect.Value, ss []string, layout string) error {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return setFromStrings(v.Elem(), ss, layout)
+	}
+	if v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8 {
+		s := reflect.MakeSlice(v.Type(), len(ss), len(ss))
+		for i, str := range ss {
+			if err := setFromStrings(s.Index(i), []string{str}, layout); err != nil {
+				return err
+			}
+		}
+		v.Set(s)
+		return nil
+	}
+	return setScalar(v, ss[len(ss)-1], layout)
+}
+
+// setScalar sets the non-pointer scalar value from string.
+func setScalar(v reflect.Value, s string, layout string) error {
+	switch v.Type() {
+	case timeType:
+		var t time.Time
+		var err error
+		switch layout {
+		case "":
+			t, err = time.Parse(time.RFC3339, s)
+		case "unix":
+			var sec int64
+			sec, err = strconv.ParseInt(s, 10, 64)
+			t = time.Unix(sec, 0)
+		default:
+			t, err = time.Parse(layout, s)
+		}
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	case durationType:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+	if v.CanAddr() && v.Addr().Type().Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return errors.New("unsupported type " + v.Type().String())
+	}
+	return nil
+}
+
+// isEmptyValue reports whether v is the zero value of its type, like encoding/json omitempty.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v.Interface().(time.Time).IsZero()
+		}
+	}
+	return false
+}
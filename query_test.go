@@ -0,0 +1,61 @@
+package goutil
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type queryPage struct {
+	Limit *int `query:"limit,omitempty"`
+	Token string
+}
+
+type queryParams struct {
+	queryPage
+	IDs     []int         `query:"id"`
+	Name    string        `query:"name,omitempty"`
+	Since   time.Time     `query:"since,layout=2006-01-02"`
+	At      time.Time     `query:"at,omitempty,layout=unix"`
+	Timeout time.Duration `query:"timeout"`
+	Ratio   float64       `query:"ratio"`
+	OK      *bool         `query:"ok"`
+	Skip    string        `query:"-"`
+	private int
+}
+
+func TestQuery(t *testing.T) {
+	limit, ok := 10, true
+	p := queryParams{
+		queryPage: queryPage{Limit: &limit, Token: "abc"},
+		IDs:       []int{1, 2, 3},
+		Since:     time.Date(2018, 1, 2, 0, 0, 0, 0, time.UTC),
+		Timeout:   1500 * time.Millisecond,
+		Ratio:     0.5,
+		OK:        &ok,
+		Skip:      "skip",
+	}
+	values, err := EncodeQuery(&p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "Token=abc&id=1&id=2&id=3&limit=10&ok=true&ratio=0.5&since=2018-01-02&timeout=1.5s"
+	if s := values.Encode(); s != expect {
+		t.Fatalf("got %q, expect %q", s, expect)
+	}
+	var q queryParams
+	if err = DecodeQuery(values, &q); err != nil {
+		t.Fatal(err)
+	}
+	p.Skip = ""
+	if !reflect.DeepEqual(p, q) {
+		t.Fatalf("got %+v, expect %+v", q, p)
+	}
+	if err = DecodeQuery(url.Values{"id": {"x"}}, &q); err == nil {
+		t.Fatal("expect error for invalid int")
+	}
+	if _, err = EncodeQuery(1); err == nil {
+		t.Fatal("expect error for non-struct")
+	}
+}
@@ -2,7 +2,8 @@ package goutil
 
 import (
 	"crypto/rand"
-	"encoding/base64"
+	"io"
+	"math/bits"
 	mrand "math/rand"
 )
 
@@ -10,30 +11,108 @@ import (
 // if the system's secure random number generator fails to function correctly.
 func RandomBytes(n int) []byte {
 	b := make([]byte, n)
-	_, err := rand.Read(b)
-	// Note that err == nil only if we read len(b) bytes.
-	if err != nil {
-		panic(err)
-	}
-
+	cryptoFill(b)
 	return b
 }
 
-var (
-	encodeURL    = []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_")
-	encodeURLLen = len(encodeURL)
+// Charset presets for RandomString and FastRandomString.
+const (
+	// URLSafeCharset is the URL-safe base64 alphabet.
+	URLSafeCharset = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+	// AlphanumericCharset contains the upper and lower letters and digits.
+	AlphanumericCharset = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+	// HexCharset contains the lower hexadecimal digits.
+	HexCharset = "0123456789abcdef"
+	// NumericCharset contains the decimal digits.
+	NumericCharset = "0123456789"
+	// ReadableCharset contains the letters and digits except the look-alike 0, 1, I, O and l.
+	ReadableCharset = "23456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
 )
 
-// RandomString returns a URL-safe, base64 encoded securely generated
-// random string. It will panic if the system's secure random number generator
-// fails to function correctly.
-// The length n must be an integer multiple of 4, otherwise the last character will be padded with `=`.
-func RandomString(n int) string {
-	d := base64.URLEncoding.DecodedLen(n)
-	buf := make([]byte, base64.URLEncoding.EncodedLen(d), n)
-	base64.URLEncoding.Encode(buf, RandomBytes(d))
-	for i := n - len(buf); i > 0; i-- {
-		buf = append(buf, encodeURL[mrand.Intn(encodeURLLen)])
-	}
-	return string(buf)
+// RandomString returns a securely generated random string of length n.
+// The characters are picked from charset without bias, URLSafeCharset by default.
+// The charset must be no more than 256 single-byte characters.
+// It will panic if the system's secure random number generator fails to function correctly.
+func RandomString(n int, charset ...string) string {
+	return maskedRandom(n, pickCharset(charset), cryptoFill)
+}
+
+// FastRandomString returns a random string of length n using math/rand.
+// The characters are picked from charset without bias, URLSafeCharset by default.
+// The charset must be no more than 256 single-byte characters.
+// NOTE: it's faster than RandomString, but it must not be used for tokens or secrets.
+func FastRandomString(n int, charset ...string) string {
+	return maskedRandom(n, pickCharset(charset), fastFill)
+}
+
+// NewShortID returns a securely generated NanoID-style short ID of the length,
+// for the user-facing codes such as invites and order references.
+// The characters are picked from alphabet without bias, ReadableCharset by default.
+// The alphabet must be no more than 256 distinct single-byte characters.
+// It will panic if the system's secure random number generator fails to function correctly.
+func NewShortID(length int, alphabet ...string) string {
+	if len(alphabet) == 0 || len(alphabet[0]) == 0 {
+		alphabet = []string{ReadableCharset}
+	}
+	var seen [256]bool
+	for i := 0; i < len(alphabet[0]); i++ {
+		if c := alphabet[0][i]; !seen[c] {
+			seen[c] = true
+		} else {
+			panic("goutil: the alphabet has duplicate characters")
+		}
+	}
+	return maskedRandom(length, pickCharset(alphabet), cryptoFill)
+}
+
+func pickCharset(charset []string) string {
+	if len(charset) == 0 || len(charset[0]) == 0 {
+		return URLSafeCharset
+	}
+	if len(charset[0]) > 256 {
+		panic("goutil: the charset has more than 256 characters")
+	}
+	return charset[0]
+}
+
+// maskedRandom picks every character by masking a random byte to the smallest
+// power of 2 covering the charset, and rejecting the out-of-range values.
+func maskedRandom(n int, charset string, fill func([]byte)) string {
+	if n <= 0 {
+		return ""
+	}
+	size := len(charset)
+	mask := byte(1<<uint(bits.Len(uint(size-1))) - 1)
+	out := make([]byte, n)
+	buf := make([]byte, n*(int(mask)+1)/size+8)
+	for i := 0; i < n; {
+		fill(buf)
+		for _, b := range buf {
+			if b &= mask; int(b) < size {
+				out[i] = charset[b]
+				if i++; i == n {
+					break
+				}
+			}
+		}
+	}
+	return BytesToString(out)
+}
+
+func cryptoFill(b []byte) {
+	// Note that err == nil only if we read len(b) bytes.
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		panic(err)
+	}
+}
+
+func fastFill(b []byte) {
+	for i := 0; i < len(b); {
+		v := mrand.Int63()
+		for j := 0; j < 7 && i < len(b); j++ {
+			b[i] = byte(v)
+			v >>= 8
+			i++
+		}
+	}
 }
@@ -0,0 +1,91 @@
+//go:build go1.18
+// +build go1.18
+
+package goutil
+
+import (
+	mrand "math/rand"
+)
+
+// Intner is the random source of the generic random helpers, and *math/rand.Rand implements it.
+// Inject a seeded one for the reproducible tests.
+type Intner interface {
+	// Intn returns a random number in [0, n).
+	Intn(n int) int
+}
+
+type globalIntner struct{}
+
+func (globalIntner) Intn(n int) int { return mrand.Intn(n) }
+
+func pickIntner(r []Intner) Intner {
+	if len(r) == 0 || r[0] == nil {
+		return globalIntner{}
+	}
+	return r[0]
+}
+
+// Shuffle shuffles the slice in place, using the global math/rand source by default.
+func Shuffle[T any](s []T, r ...Intner) {
+	rnd := pickIntner(r)
+	for i := len(s) - 1; i > 0; i-- {
+		j := rnd.Intn(i + 1)
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// RandomChoice returns a random element of the slice, and false if the slice is empty.
+func RandomChoice[T any](s []T, r ...Intner) (T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s[pickIntner(r).Intn(len(s))], true
+}
+
+// SampleN returns n random elements of the slice by the reservoir sampling,
+// in a new slice, or all the elements if the slice has no more than n.
+func SampleN[T any](s []T, n int, r ...Intner) []T {
+	res := NewReservoir[T](n, r...)
+	for _, v := range s {
+		res.Add(v)
+	}
+	return res.Samples()
+}
+
+// Reservoir keeps a uniform random sample of no more than n elements of a stream
+// of unknown length, by the reservoir sampling. It is not safe for concurrent use.
+type Reservoir[T any] struct {
+	n       int
+	seen    int
+	samples []T
+	rnd     Intner
+}
+
+// NewReservoir creates a reservoir of the n samples.
+func NewReservoir[T any](n int, r ...Intner) *Reservoir[T] {
+	if n < 0 {
+		n = 0
+	}
+	return &Reservoir[T]{n: n, rnd: pickIntner(r)}
+}
+
+// Add offers the next element of the stream.
+func (res *Reservoir[T]) Add(v T) {
+	res.seen++
+	if len(res.samples) < res.n {
+		res.samples = append(res.samples, v)
+	} else if j := res.rnd.Intn(res.seen); j < res.n {
+		res.samples[j] = v
+	}
+}
+
+// Seen returns the number of the elements offered.
+func (res *Reservoir[T]) Seen() int {
+	return res.seen
+}
+
+// Samples returns the sample elements.
+func (res *Reservoir[T]) Samples() []T {
+	return res.samples
+}
@@ -0,0 +1,68 @@
+//go:build go1.18
+// +build go1.18
+
+package goutil
+
+import (
+	mrand "math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestShuffle(t *testing.T) {
+	a := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	b := append([]int(nil), a...)
+	Shuffle(a, mrand.New(mrand.NewSource(1)))
+	Shuffle(b, mrand.New(mrand.NewSource(1)))
+	if !reflect.DeepEqual(a, b) {
+		t.Fatalf("the same seed got %v and %v", a, b)
+	}
+	if sort.Ints(a); !reflect.DeepEqual(a, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}) {
+		t.Fatalf("got %v", a)
+	}
+	Shuffle([]string{})
+}
+
+func TestRandomChoice(t *testing.T) {
+	if _, ok := RandomChoice([]int(nil)); ok {
+		t.Fatal("expect false for the empty slice")
+	}
+	if v, ok := RandomChoice([]string{"a", "b"}); !ok || v != "a" && v != "b" {
+		t.Fatalf("got %q, %v", v, ok)
+	}
+}
+
+func TestSampleN(t *testing.T) {
+	s := make([]int, 100)
+	for i := range s {
+		s[i] = i
+	}
+	if got := SampleN(s[:3], 5); len(got) != 3 {
+		t.Fatalf("got %v", got)
+	}
+	// every element should be picked in about n/len of the rounds
+	counts := make([]int, len(s))
+	r := mrand.New(mrand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		got := SampleN(s, 10, r)
+		if len(got) != 10 {
+			t.Fatalf("got %v", got)
+		}
+		for _, v := range got {
+			counts[v]++
+		}
+	}
+	for i, n := range counts {
+		if n < 800 || n > 1200 {
+			t.Fatalf("element %d is picked %d times", i, n)
+		}
+	}
+	res := NewReservoir[string](2)
+	for _, v := range []string{"a", "b", "c"} {
+		res.Add(v)
+	}
+	if res.Seen() != 3 || len(res.Samples()) != 2 {
+		t.Fatalf("got %d, %v", res.Seen(), res.Samples())
+	}
+}
@@ -3,6 +3,7 @@ package goutil
 import (
 	"crypto/rand"
 	"io"
+	"strings"
 	"sync"
 	"testing"
 )
@@ -65,3 +66,49 @@ func TestRandomString(t *testing.T) {
 		t.Log(id)
 	}
 }
+
+func TestRandomStringCharset(t *testing.T) {
+	for _, charset := range []string{HexCharset, NumericCharset, AlphanumericCharset, "ab"} {
+		for _, s := range []string{RandomString(100, charset), FastRandomString(100, charset)} {
+			if len(s) != 100 {
+				t.Fatalf("expect length 100, got %d", len(s))
+			}
+			for _, r := range s {
+				if !strings.ContainsRune(charset, r) {
+					t.Fatalf("%q is not in charset %q", r, charset)
+				}
+			}
+		}
+	}
+	if RandomString(0) != "" || len(RandomString(7)) != 7 {
+		t.Fatal("unexpected length")
+	}
+}
+
+func TestNewShortID(t *testing.T) {
+	id := NewShortID(12)
+	if len(id) != 12 {
+		t.Fatalf("got %q", id)
+	}
+	for _, r := range id {
+		if !strings.ContainsRune(ReadableCharset, r) {
+			t.Fatalf("%q is not in %q", r, ReadableCharset)
+		}
+	}
+	// the selection must be unbiased for the alphabet not of a power of 2
+	counts := map[rune]int{}
+	for _, r := range NewShortID(30000, "abc") {
+		counts[r]++
+	}
+	for _, r := range "abc" {
+		if n := counts[r]; n < 9000 || n > 11000 {
+			t.Fatalf("biased selection: %v", counts)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expect panic for duplicate characters")
+		}
+	}()
+	NewShortID(8, "aab")
+}
@@ -0,0 +1,204 @@
+package goutil
+
+import (
+	"sync/atomic"
+)
+
+// OverflowPolicy decides what RingBuffer.Put does when the buffer is full.
+type OverflowPolicy int
+
+const (
+	// DropNewest discards the value being put.
+	DropNewest OverflowPolicy = iota
+	// DropOldest discards the oldest buffered value to make room.
+	DropOldest
+	// Block waits until the consumer frees a slot.
+	Block
+)
+
+// RingBuffer is a fixed-size lock-free multi-producer single-consumer ring buffer.
+// A consumer goroutine passes the buffered values to the handler in FIFO order,
+// so it is suitable as a building block for async log writers and event pipelines.
+// It is safe for multiple goroutines to call Put concurrently.
+type RingBuffer struct {
+	_       [cacheLineSize]byte
+	head    uint64 // next position to write
+	_       [cacheLineSize - 8]byte
+	tail    uint64 // next position to read
+	_       [cacheLineSize - 8]byte
+	putting int32
+	closed  int32
+	dropped uint64
+
+	mask    uint64
+	slots   []ringSlot
+	policy  OverflowPolicy
+	handler func(interface{})
+	notify  chan struct{}
+	space   chan struct{}
+	closing chan struct{}
+	done    chan struct{}
+}
+
+type ringSlot struct {
+	seq uint64
+	val interface{}
+}
+
+// NewRingBuffer creates a new *RingBuffer and starts its consumer goroutine.
+// The size is rounded up to a power of 2, if size<=0, will use 1024.
+func NewRingBuffer(size int, policy OverflowPolicy, handler func(interface{})) *RingBuffer {
+	if size <= 0 {
+		size = 1024
+	}
+	n := 1
+	for n < size {
+		n <<= 1
+	}
+	r := &RingBuffer{
+		mask:    uint64(n - 1),
+		slots:   make([]ringSlot, n),
+		policy:  policy,
+		handler: handler,
+		notify:  make(chan struct{}, 1),
+		space:   make(chan struct{}, 1),
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	for i := range r.slots {
+		r.slots[i].seq = uint64(i)
+	}
+	go r.consume()
+	return r
+}
+
+// Put puts a value into the buffer.
+// Returns false if the value is discarded because the buffer is full (DropNewest)
+// or closed.
+func (r *RingBuffer) Put(v interface{}) bool {
+	atomic.AddInt32(&r.putting, 1)
+	defer func() {
+		// wake up the closing consumer waiting for the last Put
+		if atomic.AddInt32(&r.putting, -1) == 0 && atomic.LoadInt32(&r.closed) != 0 {
+			wake(r.notify)
+		}
+	}()
+	for {
+		if atomic.LoadInt32(&r.closed) != 0 {
+			return false
+		}
+		if r.enqueue(v) {
+			wake(r.notify)
+			return true
+		}
+		switch r.policy {
+		case DropOldest:
+			if _, ok := r.dequeue(); ok {
+				atomic.AddUint64(&r.dropped, 1)
+			}
+		case Block:
+			select {
+			case <-r.space:
+			case <-r.closing:
+			}
+		default:
+			atomic.AddUint64(&r.dropped, 1)
+			return false
+		}
+	}
+}
+
+// Len returns the number of buffered values.
+func (r *RingBuffer) Len() int {
+	n := int64(atomic.LoadUint64(&r.head) - atomic.LoadUint64(&r.tail))
+	if n < 0 {
+		return 0
+	}
+	return int(n)
+}
+
+// Cap returns the capacity of the buffer.
+func (r *RingBuffer) Cap() int {
+	return len(r.slots)
+}
+
+// Dropped returns the number of values discarded by the overflow policy.
+func (r *RingBuffer) Dropped() uint64 {
+	return atomic.LoadUint64(&r.dropped)
+}
+
+// Close stops accepting values, waits until the consumer handles
+// all the buffered values and exits.
+func (r *RingBuffer) Close() {
+	if atomic.CompareAndSwapInt32(&r.closed, 0, 1) {
+		close(r.closing)
+	}
+	<-r.done
+}
+
+func (r *RingBuffer) consume() {
+	defer close(r.done)
+	closing := r.closing
+	for {
+		if v, ok := r.dequeue(); ok {
+			wake(r.space)
+			r.handler(v)
+			continue
+		}
+		if closing == nil && atomic.LoadInt32(&r.putting) == 0 && r.Len() == 0 {
+			return
+		}
+		// once closing, the pending Puts notify when they are done
+		select {
+		case <-r.notify:
+		case <-closing:
+			closing = nil
+		}
+	}
+}
+
+func (r *RingBuffer) enqueue(v interface{}) bool {
+	pos := atomic.LoadUint64(&r.head)
+	for {
+		slot := &r.slots[pos&r.mask]
+		seq := atomic.LoadUint64(&slot.seq)
+		switch dif := int64(seq - pos); {
+		case dif == 0:
+			if atomic.CompareAndSwapUint64(&r.head, pos, pos+1) {
+				slot.val = v
+				atomic.StoreUint64(&slot.seq, pos+1)
+				return true
+			}
+		case dif < 0:
+			return false
+		}
+		pos = atomic.LoadUint64(&r.head)
+	}
+}
+
+func (r *RingBuffer) dequeue() (interface{}, bool) {
+	pos := atomic.LoadUint64(&r.tail)
+	for {
+		slot := &r.slots[pos&r.mask]
+		seq := atomic.LoadUint64(&slot.seq)
+		switch dif := int64(seq - (pos + 1)); {
+		case dif == 0:
+			if atomic.CompareAndSwapUint64(&r.tail, pos, pos+1) {
+				v := slot.val
+				slot.val = nil
+				atomic.StoreUint64(&slot.seq, pos+r.mask+1)
+				return v, true
+			}
+		case dif < 0:
+			return nil, false
+		}
+		pos = atomic.LoadUint64(&r.tail)
+	}
+}
+
+func wake(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
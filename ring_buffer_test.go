@@ -0,0 +1,96 @@
+package goutil
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRingBuffer(t *testing.T) {
+	var got []interface{}
+	r := NewRingBuffer(8, Block, func(v interface{}) {
+		got = append(got, v)
+	})
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if !r.Put(j) {
+					t.Error("Put should not fail with Block policy")
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	r.Close()
+	if len(got) != 1000 {
+		t.Fatalf("expect 1000 values, got %d", len(got))
+	}
+	if r.Put(1) {
+		t.Fatal("Put should fail after Close")
+	}
+}
+
+func TestRingBufferDrop(t *testing.T) {
+	block := make(chan struct{})
+	var got []interface{}
+	r := NewRingBuffer(4, DropOldest, func(v interface{}) {
+		<-block
+		got = append(got, v)
+	})
+	r.Put(-1)
+	for r.Len() != 0 {
+		time.Sleep(time.Millisecond)
+	}
+	for i := 0; i < 10; i++ {
+		r.Put(i)
+	}
+	close(block)
+	r.Close()
+	if r.Dropped() != 6 {
+		t.Fatalf("expect 6 dropped, got %d", r.Dropped())
+	}
+	if len(got) != 5 || got[1] != 6 || got[4] != 9 {
+		t.Fatalf("unexpected values: %v", got)
+	}
+
+	r = NewRingBuffer(2, DropNewest, func(interface{}) { select {} })
+	r.Put(0)
+	for r.Len() != 0 {
+		time.Sleep(time.Millisecond)
+	}
+	r.Put(1)
+	r.Put(2)
+	if r.Put(3) || r.Dropped() != 1 {
+		t.Fatalf("expect the newest dropped, dropped: %d", r.Dropped())
+	}
+}
+
+func TestRingBufferCloseWhilePutting(t *testing.T) {
+	for i := 0; i < 50; i++ {
+		var handled, accepted int64
+		r := NewRingBuffer(4, Block, func(interface{}) {
+			handled++
+		})
+		var wg sync.WaitGroup
+		for j := 0; j < 8; j++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for k := 0; k < 100; k++ {
+					if r.Put(k) {
+						atomic.AddInt64(&accepted, 1)
+					}
+				}
+			}()
+		}
+		r.Close()
+		wg.Wait()
+		if handled != atomic.LoadInt64(&accepted) {
+			t.Fatalf("expect %d values handled, got %d", accepted, handled)
+		}
+	}
+}
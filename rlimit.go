@@ -0,0 +1,52 @@
+package goutil
+
+import (
+	"errors"
+	"log"
+)
+
+// ErrRlimitUnsupported is returned by the rlimit helpers on the unsupported platforms.
+var ErrRlimitUnsupported = errors.New("goutil: rlimit is not supported on this platform")
+
+// RlimitResource is the resource whose limit can be raised.
+type RlimitResource int
+
+// The resources.
+const (
+	// RlimitNoFile is the max number of the open files.
+	RlimitNoFile RlimitResource = iota
+	// RlimitCore is the max size of the core file.
+	RlimitCore
+	// RlimitFileSize is the max size of the files that the process may create.
+	RlimitFileSize
+)
+
+// String returns the C name of the resource.
+func (r RlimitResource) String() string {
+	switch r {
+	case RlimitNoFile:
+		return "RLIMIT_NOFILE"
+	case RlimitCore:
+		return "RLIMIT_CORE"
+	case RlimitFileSize:
+		return "RLIMIT_FSIZE"
+	}
+	return "RLIMIT_UNKNOWN"
+}
+
+// SetRlimit sets the soft limit of the resource to cur, or to the hard limit if cur is omitted,
+// logs and returns the resulting soft limit.
+func SetRlimit(resource RlimitResource, cur ...uint64) (uint64, error) {
+	soft, hard, err := setRlimit(resource, cur...)
+	if err != nil {
+		return 0, err
+	}
+	log.Printf("goutil: %s = %d (hard %d)", resource, soft, hard)
+	return soft, nil
+}
+
+// MaxOpenFiles raises the limit of the open files to the hard limit,
+// and returns the resulting limit. It is usually called at startup by the high-connection servers.
+func MaxOpenFiles() (uint64, error) {
+	return SetRlimit(RlimitNoFile)
+}
@@ -0,0 +1,11 @@
+//go:build dragonfly || freebsd
+// +build dragonfly freebsd
+
+package goutil
+
+func toRlim(v uint64) int64 {
+	if v > 1<<63-1 {
+		return 1<<63 - 1
+	}
+	return int64(v)
+}
@@ -0,0 +1,8 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd
+
+package goutil
+
+func setRlimit(resource RlimitResource, cur ...uint64) (soft, hard uint64, err error) {
+	return 0, 0, ErrRlimitUnsupported
+}
@@ -0,0 +1,34 @@
+package goutil
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestSetRlimit(t *testing.T) {
+	n, err := MaxOpenFiles()
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		if err != ErrRlimitUnsupported {
+			t.Fatalf("got %v", err)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n == 0 {
+		t.Fatal("got zero limit")
+	}
+	if n > 64 {
+		got, err := SetRlimit(RlimitNoFile, 64)
+		if err != nil || got != 64 {
+			t.Fatalf("got %d, %v", got, err)
+		}
+		if got, _ = MaxOpenFiles(); got != n {
+			t.Fatalf("got %d, expect %d", got, n)
+		}
+	}
+	if RlimitCore.String() != "RLIMIT_CORE" {
+		t.Fatal(RlimitCore.String())
+	}
+}
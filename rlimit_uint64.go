@@ -0,0 +1,8 @@
+//go:build darwin || linux || netbsd || openbsd
+// +build darwin linux netbsd openbsd
+
+package goutil
+
+func toRlim(v uint64) uint64 {
+	return v
+}
@@ -0,0 +1,56 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+// +build darwin dragonfly freebsd linux netbsd openbsd
+
+package goutil
+
+import (
+	"errors"
+	"runtime"
+	"syscall"
+)
+
+// darwinOpenMax is OPEN_MAX, the upper bound of the RLIMIT_NOFILE soft limit on macOS.
+const darwinOpenMax = 10240
+
+func rlimitID(resource RlimitResource) (int, error) {
+	switch resource {
+	case RlimitNoFile:
+		return syscall.RLIMIT_NOFILE, nil
+	case RlimitCore:
+		return syscall.RLIMIT_CORE, nil
+	case RlimitFileSize:
+		return syscall.RLIMIT_FSIZE, nil
+	}
+	return 0, errors.New("goutil: unknown rlimit resource")
+}
+
+func setRlimit(resource RlimitResource, cur ...uint64) (soft, hard uint64, err error) {
+	id, err := rlimitID(resource)
+	if err != nil {
+		return 0, 0, err
+	}
+	var lim syscall.Rlimit
+	if err = syscall.Getrlimit(id, &lim); err != nil {
+		return 0, 0, err
+	}
+	want := lim.Max
+	if len(cur) > 0 {
+		want = toRlim(cur[0])
+	}
+	if lim.Cur != want {
+		lim.Cur = want
+		err = syscall.Setrlimit(id, &lim)
+		if err != nil && runtime.GOOS == "darwin" && id == syscall.RLIMIT_NOFILE &&
+			uint64(lim.Cur) > darwinOpenMax {
+			lim.Cur = darwinOpenMax
+			err = syscall.Setrlimit(id, &lim)
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		if err = syscall.Getrlimit(id, &lim); err != nil {
+			return 0, 0, err
+		}
+	}
+	return uint64(lim.Cur), uint64(lim.Max), nil
+}
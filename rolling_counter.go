@@ -0,0 +1,165 @@
+package goutil
+
+import (
+	"math/bits"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// latency histogram: 4 sub-bins for every power of 2 of the nanoseconds
+const (
+	latencySubBits = 2
+	latencyBins    = 64 << latencySubBits
+)
+
+type rollingBucket struct {
+	epoch   int64 // accessed atomically, keep the 64-bit fields first for the alignment
+	count   int64
+	latency [latencyBins]int64
+	mu      sync.Mutex
+}
+
+// RollingCounter records the events and latencies into the fixed time buckets rotating
+// over a sliding window, and reports the rate and percentiles of the last window,
+// e.g. for the admission control and the self-reported metrics.
+// The recording is lock-free except when a bucket rotates. It is safe for concurrent use.
+type RollingCounter struct {
+	buckets []rollingBucket
+	width   int64 // bucket width in nanoseconds
+	window  time.Duration
+	now     func() time.Time
+}
+
+// NewRollingCounter creates a counter over the window split into the buckets,
+// e.g. NewRollingCounter(10*time.Second, 10) has 1-second buckets.
+func NewRollingCounter(window time.Duration, buckets int) *RollingCounter {
+	if buckets <= 0 {
+		buckets = 10
+	}
+	if window < time.Duration(buckets) {
+		window = time.Duration(buckets)
+	}
+	return &RollingCounter{
+		buckets: make([]rollingBucket, buckets),
+		width:   int64(window) / int64(buckets),
+		window:  window,
+		now:     time.Now,
+	}
+}
+
+// bucket returns the current bucket, resetting it if it is stale.
+func (c *RollingCounter) bucket() *rollingBucket {
+	epoch := c.now().UnixNano() / c.width
+	b := &c.buckets[epoch%int64(len(c.buckets))]
+	if atomic.LoadInt64(&b.epoch) != epoch {
+		b.mu.Lock()
+		if atomic.LoadInt64(&b.epoch) != epoch {
+			atomic.StoreInt64(&b.count, 0)
+			for i := range b.latency {
+				atomic.StoreInt64(&b.latency[i], 0)
+			}
+			atomic.StoreInt64(&b.epoch, epoch)
+		}
+		b.mu.Unlock()
+	}
+	return b
+}
+
+// Add records n events.
+func (c *RollingCounter) Add(n int64) {
+	atomic.AddInt64(&c.bucket().count, n)
+}
+
+// Observe records an event with its latency.
+func (c *RollingCounter) Observe(d time.Duration) {
+	b := c.bucket()
+	atomic.AddInt64(&b.count, 1)
+	atomic.AddInt64(&b.latency[latencyBin(d)], 1)
+}
+
+// RecordLatency implements LatencyRecorder, ignoring the name.
+func (c *RollingCounter) RecordLatency(_ string, d time.Duration) {
+	c.Observe(d)
+}
+
+func latencyBin(d time.Duration) int {
+	if d <= 0 {
+		return 0
+	}
+	v := uint64(d)
+	n := bits.Len64(v)
+	if n <= latencySubBits+1 {
+		return int(v)
+	}
+	// the exponent and the sub-bits after the leading 1
+	sub := v >> uint(n-1-latencySubBits) & (1<<latencySubBits - 1)
+	return (n-latencySubBits)<<latencySubBits | int(sub)
+}
+
+// latencyBinUpper returns the upper bound of the bin.
+func latencyBinUpper(i int) time.Duration {
+	if i < 1<<(latencySubBits+1) {
+		return time.Duration(i)
+	}
+	n := uint(i>>latencySubBits) + latencySubBits
+	sub := uint64(i & (1<<latencySubBits - 1))
+	lower := uint64(1)<<(n-1) | sub<<(n-1-latencySubBits)
+	return time.Duration(lower + 1<<(n-1-latencySubBits) - 1)
+}
+
+// live calls fn for the buckets in the current window.
+func (c *RollingCounter) live(fn func(b *rollingBucket)) {
+	epoch := c.now().UnixNano() / c.width
+	for i := range c.buckets {
+		b := &c.buckets[i]
+		if e := atomic.LoadInt64(&b.epoch); e <= epoch && e > epoch-int64(len(c.buckets)) {
+			fn(b)
+		}
+	}
+}
+
+// Count returns the number of the events in the window.
+func (c *RollingCounter) Count() int64 {
+	var n int64
+	c.live(func(b *rollingBucket) { n += atomic.LoadInt64(&b.count) })
+	return n
+}
+
+// Rate returns the events per second in the window, including the partial current bucket.
+func (c *RollingCounter) Rate() float64 {
+	return float64(c.Count()) / c.window.Seconds()
+}
+
+// Percentile returns the approximate latency at the percentile p in [0, 100]
+// of the observed events in the window, within 25% of the actual value, and 0 if none.
+func (c *RollingCounter) Percentile(p float64) time.Duration {
+	var hist [latencyBins]int64
+	var total int64
+	c.live(func(b *rollingBucket) {
+		for i := range hist {
+			v := atomic.LoadInt64(&b.latency[i])
+			hist[i] += v
+			total += v
+		}
+	})
+	if total == 0 {
+		return 0
+	}
+	if p < 0 {
+		p = 0
+	} else if p > 100 {
+		p = 100
+	}
+	rank := int64(p / 100 * float64(total))
+	if rank < 1 {
+		rank = 1
+	}
+	var seen int64
+	for i, v := range hist {
+		if seen += v; seen >= rank {
+			return latencyBinUpper(i)
+		}
+	}
+	return latencyBinUpper(latencyBins - 1)
+}
@@ -0,0 +1,69 @@
+package goutil
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRollingCounter(t *testing.T) {
+	now := time.Unix(1000, 0)
+	c := NewRollingCounter(10*time.Second, 10)
+	c.now = func() time.Time { return now }
+	for i := 0; i < 10; i++ {
+		c.Add(5)
+		now = now.Add(time.Second)
+	}
+	if n := c.Count(); n != 45 {
+		t.Fatalf("got %d", n)
+	}
+	if r := c.Rate(); r != 4.5 {
+		t.Fatalf("got %v", r)
+	}
+	// the rotated buckets are dropped
+	now = now.Add(5 * time.Second)
+	c.Add(1)
+	if n := c.Count(); n != 21 {
+		t.Fatalf("got %d", n)
+	}
+	now = now.Add(time.Minute)
+	if n := c.Count(); n != 0 {
+		t.Fatalf("got %d", n)
+	}
+}
+
+func TestRollingCounterPercentile(t *testing.T) {
+	c := NewRollingCounter(time.Minute, 6)
+	if c.Percentile(99) != 0 {
+		t.Fatal("expect 0 without observations")
+	}
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 1; i <= 1000; i++ {
+				c.Observe(time.Duration(i) * time.Millisecond)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := c.Count(); n != 4000 {
+		t.Fatalf("got %d", n)
+	}
+	for _, p := range []float64{50, 90, 99} {
+		want := time.Duration(p*10) * time.Millisecond
+		if got := c.Percentile(p); got < want || got > want*5/4 {
+			t.Fatalf("p%v: got %v, want about %v", p, got, want)
+		}
+	}
+}
+
+func TestLatencyBin(t *testing.T) {
+	for _, d := range []time.Duration{0, 1, 7, 8, 9, 1000, 123456789, 1 << 62} {
+		i := latencyBin(d)
+		if upper := latencyBinUpper(i); upper < d || i > 0 && latencyBinUpper(i-1) >= d {
+			t.Fatalf("%d: bin %d has upper %d", d, i, upper)
+		}
+	}
+}
@@ -0,0 +1,308 @@
+package goutil
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RotateOptions are the options of RotateWriter.
+type RotateOptions struct {
+	// MaxSize is the max bytes of the file before rotation, 0 means no size limit.
+	MaxSize int64
+	// Interval rotates the file at the boundaries of the interval in the local time zone,
+	// e.g. 24*time.Hour rotates at the local midnight.
+	// The intervals shorter than a day are aligned to the local midnight,
+	// and the multiples of a day are aligned to the local dates.
+	// 0 means no time-based rotation.
+	Interval time.Duration
+	// MaxBackups is the max number of the rotated files to keep, 0 means keeping all.
+	MaxBackups int
+	// Compress gzips the rotated files in the background.
+	Compress bool
+	// Perm is the permission of the new files, 0644 by default.
+	Perm os.FileMode
+}
+
+// RotateWriter is an io.WriteCloser writing to a file which is rotated by size and time.
+// The rotated files are named like "app.log.20060102-150405.000[.gz]".
+// It is safe for concurrent use.
+type RotateWriter struct {
+	filename string
+	opts     RotateOptions
+	mu       sync.Mutex
+	file     *os.File
+	size     int64
+	opened   time.Time
+	millMu   sync.Mutex
+	millWg   sync.WaitGroup
+	sigCh    chan os.Signal
+	closed   bool
+}
+
+var _ io.WriteCloser = (*RotateWriter)(nil)
+
+// NewRotateWriter opens or creates the file for appending. opts may be nil.
+func NewRotateWriter(filename string, opts *RotateOptions) (*RotateWriter, error) {
+	w := &RotateWriter{filename: filename}
+	if opts != nil {
+		w.opts = *opts
+	}
+	if w.opts.Perm == 0 {
+		w.opts.Perm = 0644
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *RotateWriter) open() error {
+	if dir := filepath.Dir(w.filename); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+	f, err := os.OpenFile(w.filename, os.O_WRONLY|os.O_APPEND|os.O_CREATE, w.opts.Perm)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	w.file, w.size = f, info.Size()
+	w.opened = info.ModTime()
+	if w.size == 0 {
+		w.opened = time.Now()
+	}
+	return nil
+}
+
+// Write writes p to the file, rotating it first if necessary.
+// It returns os.ErrClosed after Close.
+func (w *RotateWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return 0, os.ErrClosed
+	}
+	if w.file == nil {
+		if err := w.open(); err != nil {
+			return 0, err
+		}
+	}
+	if w.size > 0 && (w.opts.MaxSize > 0 && w.size+int64(len(p)) > w.opts.MaxSize ||
+		w.opts.Interval > 0 && !intervalStart(time.Now(), w.opts.Interval).Equal(intervalStart(w.opened.Local(), w.opts.Interval))) {
+		if err := w.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+// intervalStart returns the start of the interval containing t, in the location of t.
+func intervalStart(t time.Time, d time.Duration) time.Time {
+	day := StartOfDay(t)
+	const oneDay = 24 * time.Hour
+	if d < oneDay || d%oneDay != 0 {
+		return day.Add(t.Sub(day).Truncate(d))
+	}
+	// the days since 1970-01-01 by the local date
+	y, m, dd := t.Date()
+	days := time.Date(y, m, dd, 0, 0, 0, 0, time.UTC).Unix() / 86400
+	n := int64(d / oneDay)
+	return day.AddDate(0, 0, -int(((days%n)+n)%n))
+}
+
+// Rotate rotates the file immediately.
+func (w *RotateWriter) Rotate() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return os.ErrClosed
+	}
+	return w.rotate()
+}
+
+func (w *RotateWriter) rotate() error {
+	if w.file != nil {
+		if err := w.file.Close(); err != nil {
+			return err
+		}
+		w.file = nil
+	}
+	backup := w.filename + "." + time.Now().Format(backupTimeLayout)
+	for i, base := 1, backup; FileExists(backup) || FileExists(backup+".gz"); i++ {
+		backup = base + "." + strconv.Itoa(i)
+	}
+	if err := os.Rename(w.filename, backup); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := w.open(); err != nil {
+		return err
+	}
+	w.millWg.Add(1)
+	go func() {
+		defer w.millWg.Done()
+		w.mill(backup)
+	}()
+	return nil
+}
+
+const backupTimeLayout = "20060102-150405.000"
+
+// isBackup reports whether the name is a backup of the filename made by rotate, i.e.
+// the filename followed by the timestamp, an optional sequence number and an optional ".gz".
+func (w *RotateWriter) isBackup(name string) bool {
+	rest := strings.TrimSuffix(strings.TrimPrefix(name, w.filename+"."), ".gz")
+	if len(rest) == len(name) || len(rest) < len(backupTimeLayout) {
+		return false
+	}
+	if _, err := time.Parse(backupTimeLayout, rest[:len(backupTimeLayout)]); err != nil {
+		return false
+	}
+	seq := rest[len(backupTimeLayout):]
+	if seq == "" {
+		return true
+	}
+	if seq[0] != '.' {
+		return false
+	}
+	_, err := strconv.ParseUint(seq[1:], 10, 64)
+	return err == nil
+}
+
+// mill compresses the new backup and removes the stale ones.
+func (w *RotateWriter) mill(backup string) {
+	w.millMu.Lock()
+	defer w.millMu.Unlock()
+	if w.opts.Compress {
+		if err := gzipFile(backup); err == nil {
+			os.Remove(backup)
+		}
+	}
+	if w.opts.MaxBackups <= 0 {
+		return
+	}
+	backups, _ := filepath.Glob(w.filename + ".*")
+	olds := make([]string, 0, len(backups))
+	for _, b := range backups {
+		if w.isBackup(b) {
+			olds = append(olds, b)
+		}
+	}
+	sort.Slice(olds, func(i, j int) bool {
+		return strings.TrimSuffix(olds[i], ".gz") < strings.TrimSuffix(olds[j], ".gz")
+	})
+	for i := 0; i < len(olds)-w.opts.MaxBackups; i++ {
+		os.Remove(olds[i])
+	}
+}
+
+func gzipFile(name string) error {
+	src, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	tmp := name + ".gz.tmp"
+	dst, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	gw := gzip.NewWriter(dst)
+	_, err = io.Copy(gw, src)
+	if err == nil {
+		err = gw.Close()
+	}
+	if e := dst.Close(); err == nil {
+		err = e
+	}
+	if err == nil {
+		err = os.Rename(tmp, name+".gz")
+	}
+	if err != nil {
+		os.Remove(tmp)
+	}
+	return err
+}
+
+// Reopen closes and reopens the file, e.g. after it is moved by the external logrotate.
+func (w *RotateWriter) Reopen() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return os.ErrClosed
+	}
+	if w.file != nil {
+		w.file.Close()
+		w.file = nil
+	}
+	return w.open()
+}
+
+// ReopenOnSignal reopens the file whenever one of the signals arrives until Close,
+// SIGUSR1 by default (no-op on Windows, Plan 9, js and wasip1). Calling it again replaces the previous signals.
+func (w *RotateWriter) ReopenOnSignal(sigs ...os.Signal) {
+	if len(sigs) == 0 {
+		sigs = reopenSignals
+	}
+	if len(sigs) == 0 {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return
+	}
+	w.stopSignal()
+	ch := make(chan os.Signal, 1)
+	w.sigCh = ch
+	signal.Notify(ch, sigs...)
+	go func() {
+		for range ch {
+			w.Reopen()
+		}
+	}()
+}
+
+// stopSignal stops the signal delivery and ends the goroutine of ReopenOnSignal.
+func (w *RotateWriter) stopSignal() {
+	if w.sigCh != nil {
+		signal.Stop(w.sigCh)
+		close(w.sigCh)
+		w.sigCh = nil
+	}
+}
+
+// Close closes the file and waits for the background compression.
+func (w *RotateWriter) Close() error {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return os.ErrClosed
+	}
+	w.closed = true
+	w.stopSignal()
+	var err error
+	if w.file != nil {
+		err = w.file.Close()
+		w.file = nil
+	}
+	w.mu.Unlock()
+	w.millWg.Wait()
+	return err
+}
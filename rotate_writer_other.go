@@ -0,0 +1,8 @@
+//go:build windows || plan9 || js || wasip1
+// +build windows plan9 js wasip1
+
+package goutil
+
+import "os"
+
+var reopenSignals []os.Signal
@@ -0,0 +1,112 @@
+package goutil
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRotateWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goutil_rotate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "app.log")
+	// not a backup, must be kept by MaxBackups
+	other := filename + ".conf"
+	ioutil.WriteFile(other, []byte("keep"), 0644)
+	w, err := NewRotateWriter(filename, &RotateOptions{MaxSize: 10, MaxBackups: 2, Compress: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"aaaaaa\n", "bbbbbb\n", "cccccc\n", "dddddd\n"} {
+		if _, err = w.Write([]byte(s)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = w.Write([]byte("x")); err != os.ErrClosed {
+		t.Fatalf("write after close: %v", err)
+	}
+	if !FileExists(other) {
+		t.Fatal("unrelated file removed")
+	}
+	os.Remove(other)
+	b, _ := ioutil.ReadFile(filename)
+	if string(b) != "dddddd\n" {
+		t.Fatalf("current file: %q", b)
+	}
+	backups, _ := filepath.Glob(filename + ".*")
+	if len(backups) != 2 {
+		t.Fatalf("expect 2 backups, got %v", backups)
+	}
+	var contents []string
+	for _, name := range backups {
+		if !strings.HasSuffix(name, ".gz") {
+			t.Fatalf("expect compressed backup: %s", name)
+		}
+		f, _ := os.Open(name)
+		gr, err := gzip.NewReader(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ = ioutil.ReadAll(gr)
+		f.Close()
+		contents = append(contents, string(b))
+	}
+	sort.Strings(contents)
+	if !reflect.DeepEqual(contents, []string{"bbbbbb\n", "cccccc\n"}) {
+		t.Fatalf("backups: %q", contents)
+	}
+}
+
+func TestRotateWriterIsBackup(t *testing.T) {
+	w := &RotateWriter{filename: "/var/log/app.log"}
+	for name, expect := range map[string]bool{
+		"/var/log/app.log.20060102-150405.000":      true,
+		"/var/log/app.log.20060102-150405.000.gz":   true,
+		"/var/log/app.log.20060102-150405.000.2.gz": true,
+		"/var/log/app.log.20060102-150405.000.tmp":  false,
+		"/var/log/app.log.conf":                     false,
+		"/var/log/app.log.1":                        false,
+		"/var/log/app.logx.20060102-150405.000":     false,
+	} {
+		if got := w.isBackup(name); got != expect {
+			t.Errorf("isBackup(%q) = %v", name, got)
+		}
+	}
+}
+
+func TestRotateIntervalStart(t *testing.T) {
+	cst := time.FixedZone("CST", 8*3600)
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2024, 1, day, hour, min, 0, 0, cst)
+	}
+	for _, c := range []struct {
+		t      time.Time
+		d      time.Duration
+		expect time.Time
+	}{
+		// 08:00 CST is the UTC midnight
+		{at(2, 7, 0), 24 * time.Hour, at(2, 0, 0)},
+		{at(2, 9, 0), 24 * time.Hour, at(2, 0, 0)},
+		{at(2, 9, 30), time.Hour, at(2, 9, 0)},
+		{at(2, 9, 30), 6 * time.Hour, at(2, 6, 0)},
+		{at(2, 23, 59), 48 * time.Hour, at(2, 0, 0)},
+		{at(3, 0, 1), 48 * time.Hour, at(2, 0, 0)},
+		{at(4, 0, 1), 48 * time.Hour, at(4, 0, 0)},
+	} {
+		if got := intervalStart(c.t, c.d); !got.Equal(c.expect) {
+			t.Errorf("intervalStart(%v, %v) = %v, expect %v", c.t, c.d, got, c.expect)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+//go:build !windows && !plan9 && !js && !wasip1
+// +build !windows,!plan9,!js,!wasip1
+
+package goutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestRotateWriterReopen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goutil_rotate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "app.log")
+	w, err := NewRotateWriter(filename, &RotateOptions{Interval: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	w.ReopenOnSignal()
+	w.Write([]byte("1\n"))
+	os.Rename(filename, filename+".moved")
+	syscall.Kill(os.Getpid(), syscall.SIGUSR1)
+	for i := 0; i < 100 && !FileExists(filename); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	w.Write([]byte("2\n"))
+	b, _ := ioutil.ReadFile(filename)
+	if string(b) != "2\n" {
+		t.Fatalf("reopened file: %q", b)
+	}
+}
@@ -0,0 +1,152 @@
+package goutil
+
+import (
+	"context"
+	"sync"
+)
+
+// RWMutexCtx is a reader/writer mutual exclusion lock whose lock methods
+// can be cancelled by a context, so that lock waits can be bounded by deadlines.
+// Writers have priority over new readers, so a writer is never starved.
+//
+// The zero RWMutexCtx is an unlocked mutex.
+// A RWMutexCtx must not be copied after first use.
+type RWMutexCtx struct {
+	mu             sync.Mutex
+	readers        int
+	writer         bool
+	waitingWriters int
+	changed        chan struct{} // closed and recreated whenever the state changes
+}
+
+// Lock locks rw for writing, blocking until the lock is available.
+func (rw *RWMutexCtx) Lock() {
+	rw.LockContext(context.Background())
+}
+
+// LockContext locks rw for writing.
+// If ctx is done before the lock is available, returns ctx.Err() without locking.
+func (rw *RWMutexCtx) LockContext(ctx context.Context) error {
+	rw.mu.Lock()
+	if rw.tryLockLocked() {
+		rw.mu.Unlock()
+		return nil
+	}
+	rw.waitingWriters++
+	for {
+		ch := rw.changedLocked()
+		rw.mu.Unlock()
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			rw.mu.Lock()
+			rw.waitingWriters--
+			rw.broadcastLocked()
+			rw.mu.Unlock()
+			return ctx.Err()
+		}
+		rw.mu.Lock()
+		if rw.tryLockLocked() {
+			rw.waitingWriters--
+			rw.mu.Unlock()
+			return nil
+		}
+	}
+}
+
+// TryLock tries to lock rw for writing without blocking and reports whether it succeeded.
+func (rw *RWMutexCtx) TryLock() bool {
+	rw.mu.Lock()
+	ok := rw.tryLockLocked()
+	rw.mu.Unlock()
+	return ok
+}
+
+// Unlock unlocks rw for writing.
+// It panics if rw is not locked for writing on entry to Unlock.
+func (rw *RWMutexCtx) Unlock() {
+	rw.mu.Lock()
+	if !rw.writer {
+		rw.mu.Unlock()
+		panic("goutil: Unlock of unlocked RWMutexCtx")
+	}
+	rw.writer = false
+	rw.broadcastLocked()
+	rw.mu.Unlock()
+}
+
+// RLock locks rw for reading, blocking until the lock is available.
+func (rw *RWMutexCtx) RLock() {
+	rw.RLockContext(context.Background())
+}
+
+// RLockContext locks rw for reading.
+// If ctx is done before the lock is available, returns ctx.Err() without locking.
+func (rw *RWMutexCtx) RLockContext(ctx context.Context) error {
+	rw.mu.Lock()
+	for !rw.tryRLockLocked() {
+		ch := rw.changedLocked()
+		rw.mu.Unlock()
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		rw.mu.Lock()
+	}
+	rw.mu.Unlock()
+	return nil
+}
+
+// TryRLock tries to lock rw for reading without blocking and reports whether it succeeded.
+func (rw *RWMutexCtx) TryRLock() bool {
+	rw.mu.Lock()
+	ok := rw.tryRLockLocked()
+	rw.mu.Unlock()
+	return ok
+}
+
+// RUnlock undoes a single RLock call.
+// It panics if rw is not locked for reading on entry to RUnlock.
+func (rw *RWMutexCtx) RUnlock() {
+	rw.mu.Lock()
+	if rw.readers <= 0 {
+		rw.mu.Unlock()
+		panic("goutil: RUnlock of unlocked RWMutexCtx")
+	}
+	rw.readers--
+	if rw.readers == 0 {
+		rw.broadcastLocked()
+	}
+	rw.mu.Unlock()
+}
+
+func (rw *RWMutexCtx) tryLockLocked() bool {
+	if rw.writer || rw.readers > 0 {
+		return false
+	}
+	rw.writer = true
+	return true
+}
+
+func (rw *RWMutexCtx) tryRLockLocked() bool {
+	if rw.writer || rw.waitingWriters > 0 {
+		return false
+	}
+	rw.readers++
+	return true
+}
+
+func (rw *RWMutexCtx) changedLocked() chan struct{} {
+	if rw.changed == nil {
+		rw.changed = make(chan struct{})
+	}
+	return rw.changed
+}
+
+func (rw *RWMutexCtx) broadcastLocked() {
+	if rw.changed != nil {
+		close(rw.changed)
+		rw.changed = nil
+	}
+}
@@ -0,0 +1,61 @@
+package goutil
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRWMutexCtx(t *testing.T) {
+	var rw RWMutexCtx
+	rw.RLock()
+	rw.RLock()
+	if rw.TryLock() {
+		t.Fatal("TryLock should fail while read-locked")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := rw.LockContext(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("expect DeadlineExceeded, got %v", err)
+	}
+	rw.RUnlock()
+	rw.RUnlock()
+
+	rw.Lock()
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel2()
+	if err := rw.RLockContext(ctx2); err != context.DeadlineExceeded {
+		t.Fatalf("expect DeadlineExceeded, got %v", err)
+	}
+	rw.Unlock()
+	if !rw.TryRLock() {
+		t.Fatal("TryRLock should succeed")
+	}
+	rw.RUnlock()
+}
+
+func TestRWMutexCtxConcurrent(t *testing.T) {
+	var rw RWMutexCtx
+	var wg sync.WaitGroup
+	var n int
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			rw.Lock()
+			n++
+			rw.Unlock()
+		}()
+		go func() {
+			defer wg.Done()
+			rw.RLock()
+			_ = n
+			rw.RUnlock()
+		}()
+	}
+	wg.Wait()
+	if n != 50 {
+		t.Fatalf("expect 50, got %d", n)
+	}
+}
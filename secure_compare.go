@@ -0,0 +1,40 @@
+package goutil
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+)
+
+// SecureCompare reports whether a and b are equal in constant time,
+// so that the comparison of secrets doesn't leak timing side channels.
+// NOTE: the length of the strings is not secret.
+func SecureCompare(a, b string) bool {
+	return subtle.ConstantTimeCompare(StringToBytes(a), StringToBytes(b)) == 1
+}
+
+// SecureCompareBytes reports whether a and b are equal in constant time.
+func SecureCompareBytes(a, b []byte) bool {
+	return subtle.ConstantTimeCompare(a, b) == 1
+}
+
+// GenerateToken returns a securely generated URL-safe random token of length n,
+// and the hex SHA-256 digest of it.
+// Give the token to the client and store only the digest, then check the
+// token presented later with VerifyToken.
+func GenerateToken(n int) (token, digest string) {
+	token = RandomString(n, URLSafeCharset)
+	return token, TokenDigest(token)
+}
+
+// TokenDigest returns the hex SHA-256 digest of the token.
+func TokenDigest(token string) string {
+	sum := sha256.Sum256(StringToBytes(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyToken reports whether the token matches the digest returned by GenerateToken,
+// in constant time.
+func VerifyToken(token, digest string) bool {
+	return SecureCompare(TokenDigest(token), digest)
+}
@@ -0,0 +1,27 @@
+package goutil
+
+import (
+	"testing"
+)
+
+func TestSecureCompare(t *testing.T) {
+	if !SecureCompare("secret", "secret") || SecureCompare("secret", "secreT") || SecureCompare("secret", "secrets") {
+		t.Fatal("SecureCompare returns wrong result")
+	}
+	if !SecureCompareBytes([]byte("a"), []byte("a")) || SecureCompareBytes([]byte("a"), nil) {
+		t.Fatal("SecureCompareBytes returns wrong result")
+	}
+}
+
+func TestToken(t *testing.T) {
+	token, digest := GenerateToken(32)
+	if len(token) != 32 || len(digest) != 64 {
+		t.Fatalf("unexpected token: %q, digest: %q", token, digest)
+	}
+	if !VerifyToken(token, digest) {
+		t.Fatal("the token should be verified")
+	}
+	if VerifyToken(token+"x", digest) || VerifyToken(token, digest[1:]) {
+		t.Fatal("the wrong token should not be verified")
+	}
+}
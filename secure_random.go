@@ -0,0 +1,64 @@
+package goutil
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// ErrInvalidSecureMax is returned by SecureInt when max <= 0.
+var ErrInvalidSecureMax = errors.New("goutil: SecureInt max must be positive")
+
+// SecureBytes returns n securely generated random bytes.
+// Unlike RandomBytes, it returns the error instead of panicking
+// when the system's secure random number generator fails.
+func SecureBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// SecureInt returns a securely generated, uniform random number in [0, max).
+func SecureInt(max int64) (int64, error) {
+	if max <= 0 {
+		return 0, ErrInvalidSecureMax
+	}
+	var b [8]byte
+	n := uint64(max)
+	// rejects the values in the last incomplete range to avoid the modulo bias
+	limit := ^uint64(0) - ^uint64(0)%n
+	for {
+		if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+			return 0, err
+		}
+		if v := binary.LittleEndian.Uint64(b[:]); v < limit {
+			return int64(v % n), nil
+		}
+	}
+}
+
+// SecureShuffle securely shuffles the n elements, like math/rand.Shuffle, using the Fisher-Yates algorithm.
+// swap swaps the elements with indexes i and j.
+func SecureShuffle(n int, swap func(i, j int)) error {
+	for i := n - 1; i > 0; i-- {
+		j, err := SecureInt(int64(i + 1))
+		if err != nil {
+			return err
+		}
+		swap(i, int(j))
+	}
+	return nil
+}
+
+// SecureToken returns a URL-safe, unpadded base64 token of n securely generated random bytes.
+func SecureToken(n int) (string, error) {
+	b, err := SecureBytes(n)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
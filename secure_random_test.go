@@ -0,0 +1,57 @@
+package goutil
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"sort"
+	"testing"
+)
+
+func TestSecureRandom(t *testing.T) {
+	b, err := SecureBytes(16)
+	if err != nil || len(b) != 16 {
+		t.Fatalf("got %d bytes, %v", len(b), err)
+	}
+	for i := 0; i < 1000; i++ {
+		if v, err := SecureInt(7); err != nil || v < 0 || v >= 7 {
+			t.Fatalf("got %d, %v", v, err)
+		}
+	}
+	if _, err = SecureInt(0); err != ErrInvalidSecureMax {
+		t.Fatalf("got %v", err)
+	}
+	a := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	if err = SecureShuffle(len(a), func(i, j int) { a[i], a[j] = a[j], a[i] }); err != nil {
+		t.Fatal(err)
+	}
+	if sort.Ints(a); a[0] != 0 || a[9] != 9 {
+		t.Fatalf("got %v", a)
+	}
+	tok, err := SecureToken(32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, err = base64.RawURLEncoding.DecodeString(tok); err != nil || len(b) != 32 {
+		t.Fatalf("got %q, %v", tok, err)
+	}
+}
+
+func TestSecureRandomError(t *testing.T) {
+	original := rand.Reader
+	rand.Reader = shortReader{}
+	defer func() {
+		rand.Reader = original
+	}()
+	if _, err := SecureBytes(tokenLength); err == nil {
+		t.Fatal("SecureBytes did not report a short read")
+	}
+	if _, err := SecureInt(10); err == nil {
+		t.Fatal("SecureInt did not report a short read")
+	}
+	if _, err := SecureToken(tokenLength); err == nil {
+		t.Fatal("SecureToken did not report a short read")
+	}
+	if err := SecureShuffle(3, func(i, j int) {}); err == nil {
+		t.Fatal("SecureShuffle did not report a short read")
+	}
+}
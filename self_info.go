@@ -0,0 +1,68 @@
+package goutil
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// BuildTime is the build time in RFC3339, which can be set by
+// -ldflags "-X github.com/henrylee2cn/goutil.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)".
+var BuildTime string
+
+var selfChecksum struct {
+	once sync.Once
+	sum  string
+	err  error
+}
+
+// SelfChecksum returns the hex SHA-256 of the running executable, which is computed once.
+// On Linux, it is the running binary even if the file has been replaced.
+func SelfChecksum() (string, error) {
+	selfChecksum.once.Do(func() {
+		ctx := context.Background()
+		if runtime.GOOS == "linux" {
+			if sum, err := FileSHA256(ctx, "/proc/self/exe"); err == nil {
+				selfChecksum.sum = sum
+				return
+			}
+		}
+		selfChecksum.sum, selfChecksum.err = FileSHA256(ctx, SelfPath())
+	})
+	return selfChecksum.sum, selfChecksum.err
+}
+
+// SelfBuildInfo is the build information of the running executable.
+type SelfBuildInfo struct {
+	// Path is the main package path.
+	Path string
+	// Version is the main module version, "(devel)" for the local builds.
+	Version   string
+	GoVersion string
+	// VCS is the version control system, e.g. "git", and the revision fields are
+	// only available for the go1.18+ builds within the repository.
+	VCS          string
+	Revision     string
+	RevisionTime time.Time
+	Modified     bool
+	// BuildTime is parsed from the BuildTime variable,
+	// or the modification time of the executable if it is not set.
+	BuildTime time.Time
+}
+
+// BuildInfo returns the build information of the running executable.
+func BuildInfo() SelfBuildInfo {
+	info := SelfBuildInfo{GoVersion: runtime.Version()}
+	readBuildInfo(&info)
+	if BuildTime != "" {
+		info.BuildTime, _ = time.Parse(time.RFC3339, BuildTime)
+	}
+	if info.BuildTime.IsZero() {
+		if fi, err := os.Stat(SelfPath()); err == nil {
+			info.BuildTime = fi.ModTime()
+		}
+	}
+	return info
+}
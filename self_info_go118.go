@@ -0,0 +1,32 @@
+//go:build go1.18
+// +build go1.18
+
+package goutil
+
+import (
+	"runtime/debug"
+	"time"
+)
+
+func readBuildInfo(info *SelfBuildInfo) {
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return
+	}
+	info.Path, info.Version = bi.Path, bi.Main.Version
+	if bi.GoVersion != "" {
+		info.GoVersion = bi.GoVersion
+	}
+	for _, s := range bi.Settings {
+		switch s.Key {
+		case "vcs":
+			info.VCS = s.Value
+		case "vcs.revision":
+			info.Revision = s.Value
+		case "vcs.time":
+			info.RevisionTime, _ = time.Parse(time.RFC3339, s.Value)
+		case "vcs.modified":
+			info.Modified = s.Value == "true"
+		}
+	}
+}
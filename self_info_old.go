@@ -0,0 +1,12 @@
+//go:build !go1.18
+// +build !go1.18
+
+package goutil
+
+import "runtime/debug"
+
+func readBuildInfo(info *SelfBuildInfo) {
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		info.Path, info.Version = bi.Path, bi.Main.Version
+	}
+}
@@ -0,0 +1,36 @@
+package goutil
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestSelfChecksum(t *testing.T) {
+	sum, err := SelfChecksum()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect, err := FileSHA256(context.Background(), SelfPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum != expect {
+		t.Fatalf("got %s, expect %s", sum, expect)
+	}
+}
+
+func TestBuildInfo(t *testing.T) {
+	old := BuildTime
+	defer func() { BuildTime = old }()
+	BuildTime = "2020-01-02T03:04:05Z"
+	info := BuildInfo()
+	if info.GoVersion == "" || !info.BuildTime.Equal(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Fatalf("got %+v", info)
+	}
+	BuildTime = ""
+	if info = BuildInfo(); info.BuildTime.IsZero() || info.GoVersion != runtime.Version() {
+		t.Fatalf("got %+v", info)
+	}
+}
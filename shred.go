@@ -0,0 +1,70 @@
+package goutil
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// ShredFile overwrites the content of the regular file with random data passes times
+// (3 if passes <= 0), fsyncing after each pass, then truncates, renames and removes it.
+//
+// It is only best-effort: on SSDs (wear leveling), copy-on-write or journaling file systems
+// (btrfs, ZFS, APFS, ext4 with data=journal), snapshots, backups and swap, the old content may
+// survive elsewhere on the disk. Prefer keeping the secrets out of the disk or encrypting them.
+func ShredFile(path string, passes int) error {
+	if passes <= 0 {
+		passes = 3
+	}
+	fi, err := os.Lstat(path)
+	if err != nil {
+		return err
+	}
+	if !fi.Mode().IsRegular() {
+		return errors.New("goutil: ShredFile target is not a regular file: " + path)
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	size := fi.Size()
+	buf := make([]byte, 32*1024)
+	for i := 0; i < passes && err == nil; i++ {
+		if _, err = f.Seek(0, io.SeekStart); err != nil {
+			break
+		}
+		for left := size; left > 0 && err == nil; {
+			n := int64(len(buf))
+			if n > left {
+				n = left
+			}
+			if _, err = rand.Read(buf[:n]); err == nil {
+				_, err = f.Write(buf[:n])
+			}
+			left -= n
+		}
+		if err == nil {
+			err = f.Sync()
+		}
+	}
+	if err == nil {
+		err = f.Truncate(0)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	// hides the original name in the directory
+	var b [8]byte
+	rand.Read(b[:])
+	tmp := filepath.Join(filepath.Dir(path), "."+hex.EncodeToString(b[:]))
+	if os.Rename(path, tmp) == nil {
+		path = tmp
+	}
+	return os.Remove(path)
+}
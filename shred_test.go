@@ -0,0 +1,27 @@
+package goutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestShredFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "shred")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "secret")
+	ioutil.WriteFile(name, make([]byte, 100000), 0600)
+	if err = ShredFile(name, 2); err != nil {
+		t.Fatal(err)
+	}
+	if infos, _ := ioutil.ReadDir(dir); len(infos) != 0 {
+		t.Fatalf("got %v", infos)
+	}
+	if err = ShredFile(dir, 1); err == nil {
+		t.Fatal("expect error for the directory")
+	}
+}
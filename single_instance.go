@@ -0,0 +1,103 @@
+package goutil
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ErrAlreadyRunning is returned by EnsureSingleInstance if another instance is running.
+var ErrAlreadyRunning = errors.New("goutil: another instance is already running")
+
+// SingleInstance is the lock of a single-instance process.
+type SingleInstance struct {
+	lock    *FileLock
+	pidFile string
+}
+
+func instanceBase(name string) string {
+	if strings.ContainsAny(name, `/\`) {
+		return name
+	}
+	return filepath.Join(os.TempDir(), name)
+}
+
+// EnsureSingleInstance locks name.lock and writes the pid into name.pid,
+// in the temporary directory unless name is a path, and returns ErrAlreadyRunning
+// if another instance holds the lock.
+// The file lock is released by the OS when the process dies, so a stale pid file is overwritten.
+// On the platforms without file locking, only the pid file is used and the dead pid in it is
+// detected by PidExists.
+func EnsureSingleInstance(name string) (*SingleInstance, error) {
+	base := instanceBase(name)
+	s := &SingleInstance{lock: NewFileLock(base + ".lock"), pidFile: base + ".pid"}
+	ok, err := s.lock.TryLock()
+	if err == ErrFileLockUnsupported {
+		s.lock = nil
+		return s, s.createPidFile()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrAlreadyRunning
+	}
+	if err = writePidFile(s.pidFile); err != nil {
+		s.lock.Unlock()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *SingleInstance) createPidFile() error {
+	for i := 0; i < 2; i++ {
+		f, err := os.OpenFile(s.pidFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err == nil {
+			_, err = f.WriteString(strconv.Itoa(os.Getpid()) + "\n")
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			return err
+		}
+		if !os.IsExist(err) {
+			return err
+		}
+		if pid := readPidFile(s.pidFile); pid > 0 && PidExists(pid) {
+			return ErrAlreadyRunning
+		}
+		os.Remove(s.pidFile)
+	}
+	return ErrAlreadyRunning
+}
+
+func readPidFile(filename string) int {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return 0
+	}
+	pid, _ := strconv.Atoi(strings.TrimSpace(string(b)))
+	return pid
+}
+
+// InstancePid returns the pid of the running instance locked by EnsureSingleInstance(name), or 0.
+func InstancePid(name string) int {
+	pid := readPidFile(instanceBase(name) + ".pid")
+	if pid <= 0 || !PidExists(pid) {
+		return 0
+	}
+	return pid
+}
+
+// Release removes the pid file and releases the lock.
+func (s *SingleInstance) Release() error {
+	err := os.Remove(s.pidFile)
+	if s.lock != nil {
+		if uerr := s.lock.Unlock(); err == nil {
+			err = uerr
+		}
+	}
+	return err
+}
@@ -0,0 +1,42 @@
+package goutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEnsureSingleInstance(t *testing.T) {
+	dir, err := ioutil.TempDir("", "instance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "app")
+	// stale pid file
+	if err = ioutil.WriteFile(name+".pid", []byte("999999999\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	s, err := EnsureSingleInstance(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pid := InstancePid(name); pid != os.Getpid() {
+		t.Fatalf("got pid %d", pid)
+	}
+	if _, err = EnsureSingleInstance(name); err != ErrAlreadyRunning {
+		t.Fatalf("got %v", err)
+	}
+	if err = s.Release(); err != nil {
+		t.Fatal(err)
+	}
+	if pid := InstancePid(name); pid != 0 {
+		t.Fatalf("got pid %d", pid)
+	}
+	s, err = EnsureSingleInstance(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Release()
+}
@@ -0,0 +1,49 @@
+package goutil
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/henrylee2cn/goutil/graceful"
+)
+
+// ErrShuttingDown is returned by SleepContext and WaitUntil when the process starts
+// the graceful shutdown or reboot.
+var ErrShuttingDown = errors.New("goutil: process is shutting down")
+
+// SleepContext pauses for the duration like time.Sleep, but returns early with the error
+// if the context is done or the graceful shutdown starts, e.g. in the long-running loops.
+func SleepContext(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-graceful.ShuttingDown():
+		return ErrShuttingDown
+	}
+}
+
+// WaitUntil checks cond at once and then every poll until it returns true,
+// and returns early with the error if the context is done or the graceful shutdown starts.
+// If poll<=0, will use 100ms.
+func WaitUntil(ctx context.Context, cond func() bool, poll time.Duration) error {
+	if poll <= 0 {
+		poll = 100 * time.Millisecond
+	}
+	for !cond() {
+		if err := SleepContext(ctx, poll); err != nil {
+			return err
+		}
+	}
+	return nil
+}
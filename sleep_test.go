@@ -0,0 +1,37 @@
+package goutil
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSleepContext(t *testing.T) {
+	start := time.Now()
+	if err := SleepContext(context.Background(), 10*time.Millisecond); err != nil || time.Since(start) < 10*time.Millisecond {
+		t.Fatalf("got %v after %v", err, time.Since(start))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start = time.Now()
+	if err := SleepContext(ctx, time.Hour); err != context.DeadlineExceeded || time.Since(start) > time.Second {
+		t.Fatalf("got %v after %v", err, time.Since(start))
+	}
+	if err := SleepContext(ctx, 0); err != context.DeadlineExceeded {
+		t.Fatalf("got %v", err)
+	}
+}
+
+func TestWaitUntil(t *testing.T) {
+	var n int32
+	cond := func() bool { return atomic.AddInt32(&n, 1) >= 3 }
+	if err := WaitUntil(context.Background(), cond, time.Millisecond); err != nil || atomic.LoadInt32(&n) != 3 {
+		t.Fatalf("got %v, %d checks", err, n)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := WaitUntil(ctx, func() bool { return false }, time.Millisecond); err != context.DeadlineExceeded {
+		t.Fatalf("got %v", err)
+	}
+}
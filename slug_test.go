@@ -0,0 +1,28 @@
+package goutil
+
+import (
+	"testing"
+)
+
+func TestSlugify(t *testing.T) {
+	cases := []struct {
+		s      string
+		maxLen int
+		slug   string
+	}{
+		{"Hello, Wörld!", 0, "hello-world"},
+		{"  --Go  is__fun--  ", 0, "go-is-fun"},
+		{"Ærøskøbing Straße", 0, "aeroskobing-strasse"},
+		{"Москва 2024", 0, "moskva-2024"},
+		{"Ελλάδα", 0, "ellada"},
+		{"中文 title", 0, "title"},
+		{"A quick brown fox", 12, "a-quick"},
+		{"A quick brown fox", 13, "a-quick-brown"},
+		{"abcdefghij", 5, "abcde"},
+	}
+	for _, c := range cases {
+		if slug := Slugify(c.s, c.maxLen); slug != c.slug {
+			t.Errorf("Slugify(%q, %d) = %q, expect %q", c.s, c.maxLen, slug, c.slug)
+		}
+	}
+}
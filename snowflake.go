@@ -0,0 +1,136 @@
+package goutil
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrClockBackwards is returned by Snowflake if the clock moves backwards beyond SnowflakeOptions.MaxBackwardsWait.
+var ErrClockBackwards = errors.New("goutil: clock moved backwards")
+
+// SnowflakeOptions is the options of NewSnowflake.
+type SnowflakeOptions struct {
+	// Epoch is the start of the timestamps, 2020-01-01 UTC by default.
+	Epoch time.Time
+	// NodeBits is the bits of the node id, 10 by default.
+	NodeBits uint8
+	// SeqBits is the bits of the sequence in a millisecond, 12 by default.
+	// The rest of the 63 bits are the milliseconds since Epoch.
+	SeqBits uint8
+	// MaxBackwardsWait is how long to wait for the clock moving backwards to catch up,
+	// 0 means returning ErrClockBackwards immediately.
+	MaxBackwardsWait time.Duration
+}
+
+// Snowflake generates the sortable 64-bit ids: the milliseconds, node id and sequence.
+// It is safe for concurrent use.
+type Snowflake struct {
+	mu       sync.Mutex
+	epoch    int64 // in milliseconds
+	node     int64
+	nodeBits uint8
+	seqBits  uint8
+	maxWait  time.Duration
+	lastMs   int64
+	seq      int64
+}
+
+var defaultSnowflakeEpoch = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// NewSnowflake creates a *Snowflake for the node id. opts may be nil.
+func NewSnowflake(node int64, opts *SnowflakeOptions) (*Snowflake, error) {
+	var o SnowflakeOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Epoch.IsZero() {
+		o.Epoch = defaultSnowflakeEpoch
+	}
+	if o.NodeBits == 0 {
+		o.NodeBits = 10
+	}
+	if o.SeqBits == 0 {
+		o.SeqBits = 12
+	}
+	if int(o.NodeBits)+int(o.SeqBits) > 31 {
+		return nil, errors.New("goutil: snowflake node and sequence bits exceed 31")
+	}
+	if node < 0 || node >= 1<<o.NodeBits {
+		return nil, errors.New("goutil: snowflake node id out of range: " + strconv.FormatInt(node, 10))
+	}
+	return &Snowflake{
+		epoch:    o.Epoch.UnixNano() / int64(time.Millisecond),
+		node:     node,
+		nodeBits: o.NodeBits,
+		seqBits:  o.SeqBits,
+		maxWait:  o.MaxBackwardsWait,
+		lastMs:   -1,
+	}, nil
+}
+
+func (s *Snowflake) nowMs() int64 {
+	return time.Now().UnixNano()/int64(time.Millisecond) - s.epoch
+}
+
+// Next returns the next id.
+func (s *Snowflake) Next() (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.nextLocked()
+}
+
+// NextN returns n ids in ascending order.
+func (s *Snowflake) NextN(n int) ([]int64, error) {
+	ids := make([]int64, n)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range ids {
+		id, err := s.nextLocked()
+		if err != nil {
+			return ids[:i], err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+func (s *Snowflake) nextLocked() (int64, error) {
+	ms := s.nowMs()
+	if ms < s.lastMs {
+		d := time.Duration(s.lastMs-ms) * time.Millisecond
+		if d > s.maxWait {
+			return 0, ErrClockBackwards
+		}
+		time.Sleep(d)
+		if ms = s.nowMs(); ms < s.lastMs {
+			return 0, ErrClockBackwards
+		}
+	}
+	if ms == s.lastMs {
+		if s.seq++; s.seq >= 1<<s.seqBits {
+			// the sequence is exhausted, so waits for the next millisecond
+			for ms <= s.lastMs {
+				time.Sleep(100 * time.Microsecond)
+				ms = s.nowMs()
+			}
+			s.seq = 0
+		}
+	} else {
+		s.seq = 0
+	}
+	s.lastMs = ms
+	if ms >= 1<<(63-s.nodeBits-s.seqBits) {
+		return 0, errors.New("goutil: snowflake timestamp overflows")
+	}
+	return ms<<(s.nodeBits+s.seqBits) | s.node<<s.seqBits | s.seq, nil
+}
+
+// Parse returns the time, node id and sequence of the id.
+func (s *Snowflake) Parse(id int64) (t time.Time, node, seq int64) {
+	ms := id>>(s.nodeBits+s.seqBits) + s.epoch
+	node = id >> s.seqBits & (1<<s.nodeBits - 1)
+	seq = id & (1<<s.seqBits - 1)
+	return time.Unix(ms/1000, ms%1000*int64(time.Millisecond)), node, seq
+}
@@ -0,0 +1,46 @@
+package goutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSnowflake(t *testing.T) {
+	s, err := NewSnowflake(5, &SnowflakeOptions{NodeBits: 4, SeqBits: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids, err := s.NextN(100)
+	if err != nil || len(ids) != 100 {
+		t.Fatalf("got %d, %v", len(ids), err)
+	}
+	for i := 1; i < len(ids); i++ {
+		if ids[i] <= ids[i-1] {
+			t.Fatalf("not ascending: %d, %d", ids[i-1], ids[i])
+		}
+	}
+	ts, node, seq := s.Parse(ids[99])
+	if node != 5 || seq >= 16 || time.Since(ts) > time.Second || time.Until(ts) > time.Millisecond {
+		t.Fatalf("got %v, %d, %d", ts, node, seq)
+	}
+	if _, err = NewSnowflake(16, &SnowflakeOptions{NodeBits: 4}); err == nil {
+		t.Fatal("expect node out of range")
+	}
+}
+
+func TestSnowflakeClockBackwards(t *testing.T) {
+	s, _ := NewSnowflake(1, nil)
+	s.Next()
+	s.lastMs += 50
+	if _, err := s.Next(); err != ErrClockBackwards {
+		t.Fatalf("got %v", err)
+	}
+	s.maxWait = time.Second
+	start := time.Now()
+	if _, err := s.Next(); err != nil {
+		t.Fatal(err)
+	}
+	if time.Since(start) < 40*time.Millisecond {
+		t.Fatal("expect waiting for the clock")
+	}
+}
@@ -0,0 +1,14 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !windows
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!windows
+
+package goutil
+
+import "os"
+
+func isSparse(fi os.FileInfo) bool {
+	return false
+}
+
+func seekErr(err error) error {
+	return err
+}
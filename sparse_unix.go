@@ -0,0 +1,22 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+// +build darwin dragonfly freebsd linux netbsd openbsd
+
+package goutil
+
+import (
+	"os"
+	"syscall"
+)
+
+func isSparse(fi os.FileInfo) bool {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	return ok && uint64(st.Blocks)*512 < uint64(fi.Size())
+}
+
+// seekErr returns nil for ENXIO, which means no more data or hole.
+func seekErr(err error) error {
+	if pe, ok := err.(*os.PathError); ok && pe.Err == syscall.ENXIO {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,20 @@
+//go:build windows
+// +build windows
+
+package goutil
+
+import (
+	"os"
+	"syscall"
+)
+
+const fileAttributeSparseFile = 0x200
+
+func isSparse(fi os.FileInfo) bool {
+	d, ok := fi.Sys().(*syscall.Win32FileAttributeData)
+	return ok && d.FileAttributes&fileAttributeSparseFile != 0
+}
+
+func seekErr(err error) error {
+	return err
+}
@@ -0,0 +1,93 @@
+package goutil
+
+import (
+	"sync"
+	"time"
+)
+
+// LatencyRecorder receives the measured durations, e.g. an adapter of a metrics registry.
+type LatencyRecorder interface {
+	RecordLatency(name string, d time.Duration)
+}
+
+// LatencyRecorderFunc is an adapter to use the ordinary function as a LatencyRecorder.
+type LatencyRecorderFunc func(name string, d time.Duration)
+
+// RecordLatency calls f(name, d).
+func (f LatencyRecorderFunc) RecordLatency(name string, d time.Duration) {
+	f(name, d)
+}
+
+// Stopwatch measures the elapsed time and laps using the monotonic clock.
+// It is safe for concurrent use.
+type Stopwatch struct {
+	mu    sync.Mutex
+	start time.Time
+	last  time.Time
+	laps  []time.Duration
+}
+
+// StartStopwatch creates and starts a stopwatch.
+func StartStopwatch() *Stopwatch {
+	now := time.Now()
+	return &Stopwatch{start: now, last: now}
+}
+
+// Lap records and returns the duration since the previous lap, or the start.
+func (sw *Stopwatch) Lap() time.Duration {
+	now := time.Now()
+	sw.mu.Lock()
+	d := now.Sub(sw.last)
+	sw.last = now
+	sw.laps = append(sw.laps, d)
+	sw.mu.Unlock()
+	return d
+}
+
+// Laps returns a copy of the recorded laps.
+func (sw *Stopwatch) Laps() []time.Duration {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	return append([]time.Duration(nil), sw.laps...)
+}
+
+// Elapsed returns the duration since the start.
+func (sw *Stopwatch) Elapsed() time.Duration {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	return time.Since(sw.start)
+}
+
+// Reset restarts the stopwatch and clears the laps.
+func (sw *Stopwatch) Reset() {
+	now := time.Now()
+	sw.mu.Lock()
+	sw.start, sw.last, sw.laps = now, now, nil
+	sw.mu.Unlock()
+}
+
+// Record feeds the elapsed duration into the recorder under the name, and returns it.
+func (sw *Stopwatch) Record(rec LatencyRecorder, name string) time.Duration {
+	d := sw.Elapsed()
+	rec.RecordLatency(name, d)
+	return d
+}
+
+// TimeIt returns the duration of calling fn.
+func TimeIt(fn func()) time.Duration {
+	start := time.Now()
+	fn()
+	return time.Since(start)
+}
+
+// RecordTime calls fn, and feeds its duration into the recorder under the name.
+// The duration is recorded even if fn panics.
+func RecordTime(rec LatencyRecorder, name string, fn func()) (d time.Duration) {
+	start := time.Now()
+	defer func() {
+		d = time.Since(start)
+		rec.RecordLatency(name, d)
+	}()
+	fn()
+	return
+}
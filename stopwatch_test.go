@@ -0,0 +1,51 @@
+package goutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStopwatch(t *testing.T) {
+	sw := StartStopwatch()
+	time.Sleep(10 * time.Millisecond)
+	lap1 := sw.Lap()
+	time.Sleep(10 * time.Millisecond)
+	lap2 := sw.Lap()
+	if lap1 < 10*time.Millisecond || lap2 < 10*time.Millisecond {
+		t.Fatalf("got laps %v, %v", lap1, lap2)
+	}
+	if laps := sw.Laps(); len(laps) != 2 || sw.Elapsed() < lap1+lap2 {
+		t.Fatalf("got %v, elapsed %v", laps, sw.Elapsed())
+	}
+	var got time.Duration
+	rec := LatencyRecorderFunc(func(name string, d time.Duration) {
+		if name == "op" {
+			got = d
+		}
+	})
+	if d := sw.Record(rec, "op"); d != got || d < 20*time.Millisecond {
+		t.Fatalf("got %v, recorded %v", d, got)
+	}
+	sw.Reset()
+	if len(sw.Laps()) != 0 || sw.Elapsed() >= 10*time.Millisecond {
+		t.Fatal("the stopwatch is not reset")
+	}
+}
+
+func TestTimeIt(t *testing.T) {
+	if d := TimeIt(func() { time.Sleep(5 * time.Millisecond) }); d < 5*time.Millisecond {
+		t.Fatalf("got %v", d)
+	}
+	var got time.Duration
+	rec := LatencyRecorderFunc(func(name string, d time.Duration) { got = d })
+	func() {
+		defer func() { recover() }()
+		RecordTime(rec, "panic", func() {
+			time.Sleep(5 * time.Millisecond)
+			panic("boom")
+		})
+	}()
+	if got < 5*time.Millisecond {
+		t.Fatalf("recorded %v", got)
+	}
+}
@@ -0,0 +1,170 @@
+package goutil
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultEllipsis is the default ellipsis appended by TruncateRunes and TruncateWidth.
+const DefaultEllipsis = "..."
+
+// wideTable contains the East Asian wide and fullwidth runes,
+// which take two columns in a terminal.
+var wideTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x1100, 0x115f, 1}, {0x231a, 0x231b, 1}, {0x2329, 0x232a, 1},
+		{0x23e9, 0x23ec, 1}, {0x23f0, 0x23f0, 1}, {0x23f3, 0x23f3, 1},
+		{0x25fd, 0x25fe, 1}, {0x2614, 0x2615, 1}, {0x2648, 0x2653, 1},
+		{0x267f, 0x267f, 1}, {0x2693, 0x2693, 1}, {0x26a1, 0x26a1, 1},
+		{0x26aa, 0x26ab, 1}, {0x26bd, 0x26be, 1}, {0x26c4, 0x26c5, 1},
+		{0x26ce, 0x26ce, 1}, {0x26d4, 0x26d4, 1}, {0x26ea, 0x26ea, 1},
+		{0x26f2, 0x26f3, 1}, {0x26f5, 0x26f5, 1}, {0x26fa, 0x26fa, 1},
+		{0x26fd, 0x26fd, 1}, {0x2705, 0x2705, 1}, {0x270a, 0x270b, 1},
+		{0x2728, 0x2728, 1}, {0x274c, 0x274c, 1}, {0x274e, 0x274e, 1},
+		{0x2753, 0x2755, 1}, {0x2757, 0x2757, 1}, {0x2795, 0x2797, 1},
+		{0x27b0, 0x27b0, 1}, {0x27bf, 0x27bf, 1}, {0x2b1b, 0x2b1c, 1},
+		{0x2b50, 0x2b50, 1}, {0x2b55, 0x2b55, 1}, {0x2e80, 0x303e, 1},
+		{0x3041, 0x33ff, 1}, {0x3400, 0x4dbf, 1}, {0x4e00, 0x9fff, 1},
+		{0xa000, 0xa4cf, 1}, {0xa960, 0xa97f, 1}, {0xac00, 0xd7a3, 1},
+		{0xf900, 0xfaff, 1}, {0xfe10, 0xfe19, 1}, {0xfe30, 0xfe6f, 1},
+		{0xff00, 0xff60, 1}, {0xffe0, 0xffe6, 1},
+	},
+	R32: []unicode.Range32{
+		{0x16fe0, 0x16fe4, 1}, {0x17000, 0x18aff, 1}, {0x1b000, 0x1b2ff, 1},
+		{0x1f004, 0x1f004, 1}, {0x1f0cf, 0x1f0cf, 1}, {0x1f18e, 0x1f18e, 1},
+		{0x1f191, 0x1f19a, 1}, {0x1f200, 0x1f251, 1}, {0x1f300, 0x1f64f, 1},
+		{0x1f680, 0x1f6ff, 1}, {0x1f900, 0x1f9ff, 1}, {0x1fa70, 0x1faff, 1},
+		{0x20000, 0x2fffd, 1}, {0x30000, 0x3fffd, 1},
+	},
+}
+
+// RuneWidth returns the number of terminal columns the rune takes:
+// 2 for East Asian wide runes, 0 for control and combining runes, otherwise 1.
+func RuneWidth(r rune) int {
+	switch {
+	case r < 0x20 || (r >= 0x7f && r < 0xa0):
+		return 0
+	case r < 0x300:
+		return 1
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	case unicode.Is(wideTable, r):
+		return 2
+	}
+	return 1
+}
+
+// StringWidth returns the number of terminal columns the string takes.
+func StringWidth(s string) int {
+	var w int
+	for _, r := range s {
+		w += RuneWidth(r)
+	}
+	return w
+}
+
+// TruncateRunes truncates s to at most n runes without splitting multi-byte characters.
+// If s is truncated, the ellipsis (DefaultEllipsis by default) is appended
+// within the n runes; if n is too small to hold the ellipsis, it is omitted.
+func TruncateRunes(s string, n int, ellipsis ...string) string {
+	if n <= 0 {
+		return ""
+	}
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	e := pickEllipsis(ellipsis)
+	if en := utf8.RuneCountInString(e); en < n {
+		n -= en
+	} else {
+		e = ""
+	}
+	for i := range s {
+		if n == 0 {
+			return s[:i] + e
+		}
+		n--
+	}
+	return s
+}
+
+// TruncateWidth truncates s to at most cols terminal columns without splitting
+// multi-byte characters, East Asian wide runes count as 2 columns.
+// If s is truncated, the ellipsis (DefaultEllipsis by default) is appended
+// within the cols columns; if cols is too small to hold the ellipsis, it is omitted.
+func TruncateWidth(s string, cols int, ellipsis ...string) string {
+	if cols <= 0 {
+		return ""
+	}
+	if StringWidth(s) <= cols {
+		return s
+	}
+	e := pickEllipsis(ellipsis)
+	if ew := StringWidth(e); ew < cols {
+		cols -= ew
+	} else {
+		e = ""
+	}
+	var w int
+	for i, r := range s {
+		if w += RuneWidth(r); w > cols {
+			return s[:i] + e
+		}
+	}
+	return s
+}
+
+func pickEllipsis(ellipsis []string) string {
+	if len(ellipsis) > 0 {
+		return ellipsis[0]
+	}
+	return DefaultEllipsis
+}
+
+// PadLeft pads s on the left to width terminal columns, East Asian wide runes count as 2 columns.
+// The pad rune is ' ' by default, if a wide pad rune can't fill the last column, ' ' is used.
+func PadLeft(s string, width int, pad ...rune) string {
+	n := width - StringWidth(s)
+	if n <= 0 {
+		return s
+	}
+	return padding(n, pad) + s
+}
+
+// PadRight pads s on the right to width terminal columns, East Asian wide runes count as 2 columns.
+// The pad rune is ' ' by default, if a wide pad rune can't fill the last column, ' ' is used.
+func PadRight(s string, width int, pad ...rune) string {
+	n := width - StringWidth(s)
+	if n <= 0 {
+		return s
+	}
+	return s + padding(n, pad)
+}
+
+// Center pads s on both sides to width terminal columns, East Asian wide runes count as 2 columns.
+// If the padding is odd, the right side gets one more column.
+// The pad rune is ' ' by default, if a wide pad rune can't fill the last column, ' ' is used.
+func Center(s string, width int, pad ...rune) string {
+	n := width - StringWidth(s)
+	if n <= 0 {
+		return s
+	}
+	return padding(n/2, pad) + s + padding(n-n/2, pad)
+}
+
+func padding(n int, pad []rune) string {
+	r := ' '
+	if len(pad) > 0 {
+		r = pad[0]
+	}
+	w := RuneWidth(r)
+	if w <= 0 {
+		r, w = ' ', 1
+	}
+	s := strings.Repeat(string(r), n/w)
+	if n%w != 0 {
+		s += strings.Repeat(" ", n%w)
+	}
+	return s
+}
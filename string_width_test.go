@@ -0,0 +1,88 @@
+package goutil
+
+import (
+	"testing"
+)
+
+func TestStringWidth(t *testing.T) {
+	cases := []struct {
+		s string
+		w int
+	}{
+		{"abc", 3},
+		{"中文", 4},
+		{"한국어", 6},
+		{"ｆｕｌｌ", 8},
+		{"é", 1},
+		{"a\tb", 2},
+	}
+	for _, c := range cases {
+		if w := StringWidth(c.s); w != c.w {
+			t.Errorf("StringWidth(%q) = %d, expect %d", c.s, w, c.w)
+		}
+	}
+}
+
+func TestTruncateRunes(t *testing.T) {
+	cases := []struct {
+		s        string
+		n        int
+		ellipsis []string
+		out      string
+	}{
+		{"hello", 10, nil, "hello"},
+		{"hello world", 8, nil, "hello..."},
+		{"你好世界你好世界", 5, []string{"…"}, "你好世界…"},
+		{"hello", 2, nil, "he"},
+		{"hello", 3, []string{""}, "hel"},
+		{"hello", 0, nil, ""},
+	}
+	for _, c := range cases {
+		if out := TruncateRunes(c.s, c.n, c.ellipsis...); out != c.out {
+			t.Errorf("TruncateRunes(%q, %d) = %q, expect %q", c.s, c.n, out, c.out)
+		}
+	}
+}
+
+func TestTruncateWidth(t *testing.T) {
+	cases := []struct {
+		s        string
+		cols     int
+		ellipsis []string
+		out      string
+	}{
+		{"hello", 5, nil, "hello"},
+		{"你好世界", 7, nil, "你好..."},
+		{"你好世界", 6, []string{"…"}, "你好…"},
+		{"你好世界", 3, []string{""}, "你"},
+		{"a你好", 4, []string{""}, "a你"},
+	}
+	for _, c := range cases {
+		if out := TruncateWidth(c.s, c.cols, c.ellipsis...); out != c.out {
+			t.Errorf("TruncateWidth(%q, %d) = %q, expect %q", c.s, c.cols, out, c.out)
+		}
+	}
+}
+
+func TestPad(t *testing.T) {
+	cases := []struct {
+		fn    func(string, int, ...rune) string
+		s     string
+		width int
+		pad   []rune
+		out   string
+	}{
+		{PadLeft, "ab", 5, nil, "   ab"},
+		{PadLeft, "中文", 5, nil, " 中文"},
+		{PadRight, "中文", 6, []rune{'.'}, "中文.."},
+		{PadRight, "abc", 2, nil, "abc"},
+		{Center, "中", 7, nil, "  中   "},
+		{Center, "a", 6, []rune{'－'}, "－a－ "},
+	}
+	for _, c := range cases {
+		out := c.fn(c.s, c.width, c.pad...)
+		if out != c.out {
+			t.Errorf("pad(%q, %d) = %q, expect %q", c.s, c.width, out, c.out)
+		}
+	}
+}
@@ -0,0 +1,423 @@
+package goutil
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// DefaultStructTag is the default tag used by Struct2Map and Map2Struct.
+const DefaultStructTag = "json"
+
+// Struct2Map converts the struct (or pointer to struct) into map[string]interface{}.
+// The keys are named by the tag (DefaultStructTag by default), '-' skips the field,
+// and 'omitempty' skips the zero value.
+// The nested structs (except time.Time), including those in slices and maps,
+// are converted into maps too, and the untagged embedded structs are flattened.
+func Struct2Map(v interface{}, tag ...string) (map[string]interface{}, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("goutil: Struct2Map requires a struct, got %T", v)
+	}
+	m := make(map[string]interface{})
+	struct2Map(rv, pickStructTag(tag), m)
+	return m, nil
+}
+
+// Map2Struct fills the struct which ptr points to with the map,
+// following the same tag rules as Struct2Map.
+// The values are coerced into the field types when possible,
+// e.g. float64 to int, string to number/bool/time.Time/time.Duration,
+// map[string]interface{} to nested struct,
+// and fail if the number overflows the field or loses the fraction.
+// The fields missing in the map, including those of the nested structs, keep their values.
+func Map2Struct(m map[string]interface{}, ptr interface{}, tag ...string) error {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("goutil: Map2Struct requires a non-nil pointer to struct")
+	}
+	return map2Struct(m, rv.Elem(), pickStructTag(tag))
+}
+
+func pickStructTag(tag []string) string {
+	if len(tag) > 0 && tag[0] != "" {
+		return tag[0]
+	}
+	return DefaultStructTag
+}
+
+func parseStructTag(sf reflect.StructField, tagName string) (name string, omitempty, skip bool) {
+	tag, ok := sf.Tag.Lookup(tagName)
+	if tag == "-" {
+		return "", false, true
+	}
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	if !ok || name == "" {
+		name = sf.Name
+	}
+	for _, p := range parts[1:] {
+		if p == "omitempty" {
+			omitempty = true
+		}
+	}
+	return name, omitempty, false
+}
+
+func isFlattened(sf reflect.StructField, tagName string) bool {
+	if !sf.Anonymous {
+		return false
+	}
+	if name := strings.Split(sf.Tag.Get(tagName), ",")[0]; name != "" {
+		return false
+	}
+	t := sf.Type
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
+
+func struct2Map(rv reflect.Value, tagName string, m map[string]interface{}) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		f := rv.Field(i)
+		if isFlattened(sf, tagName) {
+			if f.Kind() == reflect.Ptr {
+				if f.IsNil() {
+					continue
+				}
+				f = f.Elem()
+			}
+			struct2Map(f, tagName, m)
+			continue
+		}
+		if sf.PkgPath != "" {
+			continue
+		}
+		name, omitempty, skip := parseStructTag(sf, tagName)
+		if skip || (omitempty && isEmptyValue(f)) {
+			continue
+		}
+		m[name] = toMapValue(f, tagName)
+	}
+}
+
+func toMapValue(v reflect.Value, tagName string) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		if e := v.Elem(); e.Kind() == reflect.Struct && e.Type() != timeType {
+			return toMapValue(e, tagName)
+		}
+	case reflect.Struct:
+		if v.Type() != timeType {
+			m := make(map[string]interface{})
+			struct2Map(v, tagName, m)
+			return m
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			break
+		}
+		if !containsStruct(v.Type().Elem()) {
+			break
+		}
+		s := make([]interface{}, v.Len())
+		for i := range s {
+			s[i] = toMapValue(v.Index(i), tagName)
+		}
+		return s
+	case reflect.Map:
+		if v.IsNil() || !containsStruct(v.Type().Elem()) {
+			break
+		}
+		m := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			m[fmt.Sprint(iter.Key().Interface())] = toMapValue(iter.Value(), tagName)
+		}
+		return m
+	}
+	return v.Interface()
+}
+
+func containsStruct(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		return t != timeType
+	case reflect.Interface:
+		return true
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return containsStruct(t.Elem())
+	}
+	return false
+}
+
+func map2Struct(m map[string]interface{}, rv reflect.Value, tagName string) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		f := rv.Field(i)
+		if isFlattened(sf, tagName) {
+			if f.Kind() == reflect.Ptr {
+				if f.IsNil() {
+					if sf.PkgPath != "" {
+						continue
+					}
+					f.Set(reflect.New(f.Type().Elem()))
+				}
+				f = f.Elem()
+			}
+			if err := map2Struct(m, f, tagName); err != nil {
+				return err
+			}
+			continue
+		}
+		if sf.PkgPath != "" {
+			continue
+		}
+		name, _, skip := parseStructTag(sf, tagName)
+		if skip {
+			continue
+		}
+		src, ok := m[name]
+		if !ok {
+			continue
+		}
+		if err := coerceValue(f, src, tagName); err != nil {
+			return fmt.Errorf("goutil: field %q: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// coerceValue sets src into dst, converting the type when possible.
+func coerceValue(dst reflect.Value, src interface{}, tagName string) error {
+	if src == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+	sv := reflect.ValueOf(src)
+	dt := dst.Type()
+	if sv.Type().AssignableTo(dt) {
+		dst.Set(sv)
+		return nil
+	}
+	switch dt.Kind() {
+	case reflect.Ptr:
+		if sv.Kind() == reflect.Ptr {
+			if sv.IsNil() {
+				dst.Set(reflect.Zero(dt))
+				return nil
+			}
+			return coerceValue(dst, sv.Elem().Interface(), tagName)
+		}
+		nv := reflect.New(dt.Elem())
+		if !dst.IsNil() {
+			nv.Elem().Set(dst.Elem())
+		}
+		if err := coerceValue(nv.Elem(), src, tagName); err != nil {
+			return err
+		}
+		dst.Set(nv)
+		return nil
+	case reflect.Struct:
+		if dt == timeType {
+			break
+		}
+		sm, ok := src.(map[string]interface{})
+		if !ok {
+			break
+		}
+		// the fields missing in the map keep their values
+		nv := reflect.New(dt).Elem()
+		nv.Set(dst)
+		if err := map2Struct(sm, nv, tagName); err != nil {
+			return err
+		}
+		dst.Set(nv)
+		return nil
+	case reflect.Slice:
+		if sv.Kind() != reflect.Slice && sv.Kind() != reflect.Array {
+			break
+		}
+		ns := reflect.MakeSlice(dt, sv.Len(), sv.Len())
+		for i := 0; i < sv.Len(); i++ {
+			if err := coerceValue(ns.Index(i), sv.Index(i).Interface(), tagName); err != nil {
+				return fmt.Errorf("index %d: %v", i, err)
+			}
+		}
+		dst.Set(ns)
+		return nil
+	case reflect.Map:
+		if sv.Kind() != reflect.Map {
+			break
+		}
+		nm := reflect.MakeMapWithSize(dt, sv.Len())
+		iter := sv.MapRange()
+		for iter.Next() {
+			k := reflect.New(dt.Key()).Elem()
+			if err := coerceValue(k, iter.Key().Interface(), tagName); err != nil {
+				return fmt.Errorf("key %v: %v", iter.Key().Interface(), err)
+			}
+			e := reflect.New(dt.Elem()).Elem()
+			if err := coerceValue(e, iter.Value().Interface(), tagName); err != nil {
+				return fmt.Errorf("key %v: %v", iter.Key().Interface(), err)
+			}
+			nm.SetMapIndex(k, e)
+		}
+		dst.Set(nm)
+		return nil
+	}
+	return coerceScalar(dst, sv)
+}
+
+// setNumber sets the number sv into the numeric dst, failing if it overflows or loses the fraction.
+func setNumber(dst, sv reflect.Value) error {
+	overflow := func() error {
+		return fmt.Errorf("%v overflows %s", sv, dst.Type())
+	}
+	switch sv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := sv.Int()
+		switch dst.Kind() {
+		case reflect.Float32, reflect.Float64:
+			dst.SetFloat(float64(n))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if n < 0 || dst.OverflowUint(uint64(n)) {
+				return overflow()
+			}
+			dst.SetUint(uint64(n))
+		default:
+			if dst.OverflowInt(n) {
+				return overflow()
+			}
+			dst.SetInt(n)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n := sv.Uint()
+		switch dst.Kind() {
+		case reflect.Float32, reflect.Float64:
+			dst.SetFloat(float64(n))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if dst.OverflowUint(n) {
+				return overflow()
+			}
+			dst.SetUint(n)
+		default:
+			if n > math.MaxInt64 || dst.OverflowInt(int64(n)) {
+				return overflow()
+			}
+			dst.SetInt(int64(n))
+		}
+	default:
+		f := sv.Float()
+		switch dst.Kind() {
+		case reflect.Float32, reflect.Float64:
+			if dst.OverflowFloat(f) {
+				return overflow()
+			}
+			dst.SetFloat(f)
+			return nil
+		}
+		if f != math.Trunc(f) {
+			return fmt.Errorf("cannot convert %v to %s without losing precision", f, dst.Type())
+		}
+		switch dst.Kind() {
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if f < 0 || f >= 1<<64 || dst.OverflowUint(uint64(f)) {
+				return overflow()
+			}
+			dst.SetUint(uint64(f))
+		default:
+			if f < math.MinInt64 || f >= math.MaxInt64 || dst.OverflowInt(int64(f)) {
+				return overflow()
+			}
+			dst.SetInt(int64(f))
+		}
+	}
+	return nil
+}
+
+func coerceScalar(dst reflect.Value, sv reflect.Value) error {
+	dt := dst.Type()
+	fail := func() error {
+		return fmt.Errorf("cannot convert %s to %s", sv.Type(), dt)
+	}
+	if sv.Kind() == reflect.String {
+		s := sv.String()
+		switch dt.Kind() {
+		case reflect.String:
+			dst.SetString(s)
+			return nil
+		case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+			reflect.Float32, reflect.Float64, reflect.Struct:
+			return setScalar(dst, strings.TrimSpace(s), "")
+		}
+		return fail()
+	}
+	switch dt.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		switch sv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+			reflect.Float32, reflect.Float64:
+			return setNumber(dst, sv)
+		case reflect.Bool:
+			if sv.Bool() {
+				dst.Set(reflect.ValueOf(1).Convert(dt))
+			} else {
+				dst.Set(reflect.Zero(dt))
+			}
+			return nil
+		}
+	case reflect.String:
+		switch sv.Kind() {
+		case reflect.Bool:
+			dst.SetString(strconv.FormatBool(sv.Bool()))
+			return nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+			reflect.Float32, reflect.Float64:
+			s, err := formatScalar(sv, "")
+			if err != nil {
+				return err
+			}
+			dst.SetString(s)
+			return nil
+		}
+	case reflect.Bool:
+		switch sv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			dst.SetBool(sv.Int() != 0)
+			return nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			dst.SetBool(sv.Uint() != 0)
+			return nil
+		case reflect.Float32, reflect.Float64:
+			dst.SetBool(sv.Float() != 0)
+			return nil
+		}
+	}
+	if sv.Kind() == dt.Kind() && sv.Type().ConvertibleTo(dt) {
+		dst.Set(sv.Convert(dt))
+		return nil
+	}
+	return fail()
+}
@@ -0,0 +1,123 @@
+package goutil
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type smBase struct {
+	ID int64 `json:"id"`
+}
+
+type smItem struct {
+	Name  string  `json:"name"`
+	Price float64 `json:"price,omitempty"`
+}
+
+type smOrder struct {
+	smBase
+	User    string            `json:"user"`
+	Paid    bool              `json:"paid"`
+	Items   []smItem          `json:"items"`
+	Main    *smItem           `json:"main,omitempty"`
+	Tags    map[string]string `json:"tags,omitempty"`
+	Created time.Time         `json:"created"`
+	Timeout time.Duration     `json:"timeout" db:"-"`
+	Note    string            `json:"-" db:"note"`
+}
+
+func TestStruct2Map(t *testing.T) {
+	created := time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC)
+	o := smOrder{
+		smBase:  smBase{ID: 7},
+		User:    "henry",
+		Items:   []smItem{{Name: "a", Price: 1.5}, {Name: "b"}},
+		Created: created,
+		Note:    "n",
+	}
+	m, err := Struct2Map(&o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := map[string]interface{}{
+		"id":      int64(7),
+		"user":    "henry",
+		"paid":    false,
+		"items":   []interface{}{map[string]interface{}{"name": "a", "price": 1.5}, map[string]interface{}{"name": "b"}},
+		"created": created,
+		"timeout": time.Duration(0),
+	}
+	if !reflect.DeepEqual(m, expect) {
+		t.Fatalf("got %#v", m)
+	}
+	m, _ = Struct2Map(o, "db")
+	if _, ok := m["Timeout"]; ok || m["note"] != "n" || m["User"] != "henry" {
+		t.Fatalf("custom tag: %#v", m)
+	}
+	if _, err = Struct2Map(1); err == nil {
+		t.Fatal("expect error for non-struct")
+	}
+}
+
+func TestMap2Struct(t *testing.T) {
+	m := map[string]interface{}{
+		"id":      float64(7),
+		"user":    "henry",
+		"paid":    "true",
+		"items":   []interface{}{map[string]interface{}{"name": "a", "price": "1.5"}},
+		"main":    map[string]interface{}{"name": "m", "price": 2},
+		"tags":    map[string]interface{}{"k": "v"},
+		"created": "2018-01-02T03:04:05Z",
+		"timeout": "1s",
+	}
+	var o smOrder
+	if err := Map2Struct(m, &o); err != nil {
+		t.Fatal(err)
+	}
+	expect := smOrder{
+		smBase:  smBase{ID: 7},
+		User:    "henry",
+		Paid:    true,
+		Items:   []smItem{{Name: "a", Price: 1.5}},
+		Main:    &smItem{Name: "m", Price: 2},
+		Tags:    map[string]string{"k": "v"},
+		Created: time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC),
+		Timeout: time.Second,
+	}
+	if !reflect.DeepEqual(o, expect) {
+		t.Fatalf("got %+v", o)
+	}
+	main := o.Main
+	if err := Map2Struct(map[string]interface{}{"main": map[string]interface{}{"price": 3}}, &o); err != nil {
+		t.Fatal(err)
+	}
+	if o.Main.Name != "m" || o.Main.Price != 3 || main.Price != 2 {
+		t.Fatalf("nested fields should be kept: %+v", o.Main)
+	}
+	if err := Map2Struct(map[string]interface{}{"id": 1.5}, &o); err == nil {
+		t.Fatal("expect error for lossy float")
+	}
+	if err := Map2Struct(map[string]interface{}{"user": []int{1}}, &o); err == nil {
+		t.Fatal("expect error for slice to string")
+	}
+	var narrow struct {
+		I8  int8    `json:"i8"`
+		U16 uint16  `json:"u16"`
+		F32 float32 `json:"f32"`
+	}
+	for _, m := range []map[string]interface{}{
+		{"i8": 300}, {"i8": float64(-129)}, {"i8": uint64(math.MaxUint64)},
+		{"u16": -1}, {"u16": 70000}, {"u16": 1e10},
+		{"f32": 1e300}, {"i8": 1e20},
+	} {
+		if err := Map2Struct(m, &narrow); err == nil {
+			t.Errorf("expect overflow error for %v, got %+v", m, narrow)
+		}
+	}
+	if err := Map2Struct(map[string]interface{}{"i8": -128, "u16": uint8(255), "f32": 1.5}, &narrow); err != nil ||
+		narrow.I8 != -128 || narrow.U16 != 255 || narrow.F32 != 1.5 {
+		t.Fatalf("got %+v, %v", narrow, err)
+	}
+}
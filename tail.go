@@ -0,0 +1,199 @@
+package goutil
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// TailOptions are the options of NewTail.
+type TailOptions struct {
+	// FromStart reads the file from the beginning, instead of from the end.
+	FromStart bool
+	// PollInterval is the interval of checking new data, truncation and rotation, 250ms by default.
+	PollInterval time.Duration
+	// Buffer is the capacity of the lines channel, 64 by default.
+	Buffer int
+}
+
+// Tail follows a file like `tail -F`.
+// It waits for the file to appear, reopens it after rotation
+// and rereads it from the beginning after truncation.
+type Tail struct {
+	filename string
+	opts     TailOptions
+	lines    chan string
+	cancel   context.CancelFunc
+	done     chan struct{}
+	mu       sync.Mutex
+	err      error
+}
+
+// NewTail starts following the file until the ctx is done or Stop is called.
+// opts may be nil.
+func NewTail(ctx context.Context, filename string, opts *TailOptions) *Tail {
+	t := &Tail{
+		filename: filename,
+		done:     make(chan struct{}),
+	}
+	if opts != nil {
+		t.opts = *opts
+	}
+	if t.opts.PollInterval <= 0 {
+		t.opts.PollInterval = 250 * time.Millisecond
+	}
+	if t.opts.Buffer <= 0 {
+		t.opts.Buffer = 64
+	}
+	t.lines = make(chan string, t.opts.Buffer)
+	ctx, t.cancel = context.WithCancel(ctx)
+	go t.run(ctx)
+	return t
+}
+
+// Lines returns the channel of the lines without the line endings,
+// which is closed when the tail stops.
+func (t *Tail) Lines() <-chan string {
+	return t.lines
+}
+
+// Stop stops following and waits for the Lines channel to be closed.
+func (t *Tail) Stop() {
+	t.cancel()
+	<-t.done
+}
+
+// Err returns the error which stopped the tail, nil if it was stopped by Stop or the ctx.
+func (t *Tail) Err() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.err
+}
+
+func (t *Tail) run(ctx context.Context) {
+	defer close(t.done)
+	defer close(t.lines)
+	err := t.follow(ctx)
+	if err != nil && ctx.Err() == nil {
+		t.mu.Lock()
+		t.err = err
+		t.mu.Unlock()
+	}
+}
+
+func (t *Tail) sleep(ctx context.Context) bool {
+	timer := time.NewTimer(t.opts.PollInterval)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func (t *Tail) emit(ctx context.Context, line []byte) bool {
+	line = bytes.TrimSuffix(line, []byte{'\r'})
+	select {
+	case t.lines <- string(line):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (t *Tail) open(ctx context.Context, seekEnd bool) (*os.File, int64, error) {
+	for {
+		f, err := os.Open(t.filename)
+		if err == nil {
+			var offset int64
+			if seekEnd {
+				offset, err = f.Seek(0, io.SeekEnd)
+				if err != nil {
+					f.Close()
+					return nil, 0, err
+				}
+			}
+			return f, offset, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, 0, err
+		}
+		if !t.sleep(ctx) {
+			return nil, 0, ctx.Err()
+		}
+	}
+}
+
+func (t *Tail) follow(ctx context.Context) error {
+	f, offset, err := t.open(ctx, !t.opts.FromStart)
+	if err != nil {
+		return err
+	}
+	defer func() { f.Close() }()
+	r := bufio.NewReader(f)
+	var partial []byte
+	for {
+		line, err := r.ReadSlice('\n')
+		offset += int64(len(line))
+		switch err {
+		case nil:
+			if len(partial) > 0 {
+				line = append(partial, line...)
+				partial = partial[:0]
+			}
+			if !t.emit(ctx, line[:len(line)-1]) {
+				return ctx.Err()
+			}
+			continue
+		case bufio.ErrBufferFull:
+			partial = append(partial, line...)
+			continue
+		case io.EOF:
+			partial = append(partial, line...)
+		default:
+			return err
+		}
+
+		if !t.sleep(ctx) {
+			return ctx.Err()
+		}
+		cur, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		info, err := os.Stat(t.filename)
+		switch {
+		case err != nil && !os.IsNotExist(err):
+			return err
+		case err != nil || !os.SameFile(cur, info):
+			// Rotated: drain the old file before switching to the new one.
+			if cur.Size() > offset {
+				continue
+			}
+			if len(partial) > 0 {
+				if !t.emit(ctx, partial) {
+					return ctx.Err()
+				}
+				partial = partial[:0]
+			}
+			f.Close()
+			if f, offset, err = t.open(ctx, false); err != nil {
+				return err
+			}
+			r.Reset(f)
+		case info.Size() < offset:
+			// Truncated: reread from the beginning.
+			if _, err = f.Seek(0, io.SeekStart); err != nil {
+				return err
+			}
+			offset = 0
+			partial = partial[:0]
+			r.Reset(f)
+		}
+	}
+}
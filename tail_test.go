@@ -0,0 +1,74 @@
+package goutil
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTail(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goutil_tail")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "app.log")
+	if err = ioutil.WriteFile(filename, []byte("old\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tail := NewTail(context.Background(), filename, &TailOptions{PollInterval: 10 * time.Millisecond})
+	defer tail.Stop()
+	expect := func(want ...string) {
+		for _, w := range want {
+			select {
+			case line := <-tail.Lines():
+				if line != w {
+					t.Fatalf("got %q, expect %q", line, w)
+				}
+			case <-time.After(2 * time.Second):
+				t.Fatalf("timeout waiting for %q", w)
+			}
+		}
+	}
+	appendFile := func(s string) {
+		f, err := os.OpenFile(filename, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.WriteString(s)
+		f.Close()
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	appendFile("a\r\nb")
+	expect("a")
+	appendFile("c\n")
+	expect("bc")
+
+	// truncation
+	if err = ioutil.WriteFile(filename, []byte("t\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	expect("t")
+
+	// rotation
+	appendFile("before")
+	if err = os.Rename(filename, filename+".1"); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(50 * time.Millisecond)
+	appendFile("new\n")
+	expect("before", "new")
+
+	tail.Stop()
+	if _, ok := <-tail.Lines(); ok {
+		t.Fatal("expect closed channel")
+	}
+	if tail.Err() != nil {
+		t.Fatal(tail.Err())
+	}
+}
@@ -0,0 +1,84 @@
+package goutil
+
+import (
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/henrylee2cn/goutil/graceful"
+)
+
+var liveTemps = struct {
+	sync.Mutex
+	paths map[string]struct{}
+}{paths: make(map[string]struct{})}
+
+func init() {
+	graceful.AddPostCloseHook(SweepTempFiles)
+}
+
+func trackTemp(path string) {
+	liveTemps.Lock()
+	liveTemps.paths[path] = struct{}{}
+	liveTemps.Unlock()
+}
+
+func untrackTemp(path string) {
+	liveTemps.Lock()
+	delete(liveTemps.paths, path)
+	liveTemps.Unlock()
+}
+
+// WithTempDir creates a temporary directory, calls fn with it,
+// and removes it after fn returns, even on panic.
+func WithTempDir(fn func(dir string) error) (err error) {
+	dir, err := ioutil.TempDir("", "goutil")
+	if err != nil {
+		return err
+	}
+	trackTemp(dir)
+	defer func() {
+		rmErr := os.RemoveAll(dir)
+		untrackTemp(dir)
+		if err == nil {
+			err = rmErr
+		}
+	}()
+	return fn(dir)
+}
+
+// WithTempFile creates a temporary file by the pattern like ioutil.TempFile, calls fn with it,
+// and closes and removes it after fn returns, even on panic.
+func WithTempFile(pattern string, fn func(f *os.File) error) (err error) {
+	f, err := ioutil.TempFile("", pattern)
+	if err != nil {
+		return err
+	}
+	name := f.Name()
+	trackTemp(name)
+	defer func() {
+		f.Close()
+		rmErr := os.Remove(name)
+		untrackTemp(name)
+		if err == nil && !os.IsNotExist(rmErr) {
+			err = rmErr
+		}
+	}()
+	return fn(f)
+}
+
+// SweepTempFiles removes the temporary files and directories of the running
+// WithTempDir and WithTempFile calls.
+// It is registered as a graceful post-close hook, so nothing is left when the process shuts down.
+func SweepTempFiles() error {
+	liveTemps.Lock()
+	defer liveTemps.Unlock()
+	var err error
+	for path := range liveTemps.paths {
+		if e := os.RemoveAll(path); e != nil && err == nil {
+			err = e
+		}
+		delete(liveTemps.paths, path)
+	}
+	return err
+}
@@ -0,0 +1,66 @@
+package goutil
+
+import (
+	"errors"
+	"os"
+	"testing"
+)
+
+func TestWithTempDir(t *testing.T) {
+	var dir string
+	errFn := errors.New("fn error")
+	err := WithTempDir(func(d string) error {
+		dir = d
+		return errFn
+	})
+	if err != errFn {
+		t.Fatalf("expect the fn error, got %v", err)
+	}
+	if FileExists(dir) {
+		t.Fatal("dir should be removed")
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expect panic")
+			}
+		}()
+		WithTempDir(func(d string) error {
+			dir = d
+			panic("boom")
+		})
+	}()
+	if FileExists(dir) {
+		t.Fatal("dir should be removed on panic")
+	}
+}
+
+func TestWithTempFile(t *testing.T) {
+	var name string
+	err := WithTempFile("goutil_*.txt", func(f *os.File) error {
+		name = f.Name()
+		_, err := f.WriteString("hello")
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if FileExists(name) {
+		t.Fatal("file should be removed")
+	}
+
+	err = WithTempFile("", func(f *os.File) error {
+		name = f.Name()
+		if err := SweepTempFiles(); err != nil {
+			return err
+		}
+		if FileExists(name) {
+			t.Fatal("file should be swept")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,88 @@
+package goutil
+
+import (
+	"time"
+)
+
+// StartOfDay returns the midnight of the day of t, in the location of t.
+func StartOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// EndOfDay returns the last nanosecond of the day of t, in the location of t.
+func EndOfDay(t time.Time) time.Time {
+	return StartOfDay(t).AddDate(0, 0, 1).Add(-1)
+}
+
+// StartOfWeek returns the midnight of the first day of the week of t, in the location of t.
+// The week starts on weekStart, Monday by default.
+func StartOfWeek(t time.Time, weekStart ...time.Weekday) time.Time {
+	start := time.Monday
+	if len(weekStart) > 0 {
+		start = weekStart[0]
+	}
+	days := (int(t.Weekday()) - int(start) + 7) % 7
+	return StartOfDay(t).AddDate(0, 0, -days)
+}
+
+// EndOfWeek returns the last nanosecond of the week of t, like StartOfWeek.
+func EndOfWeek(t time.Time, weekStart ...time.Weekday) time.Time {
+	return StartOfWeek(t, weekStart...).AddDate(0, 0, 7).Add(-1)
+}
+
+// StartOfMonth returns the midnight of the first day of the month of t, in the location of t.
+func StartOfMonth(t time.Time) time.Time {
+	y, m, _ := t.Date()
+	return time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
+}
+
+// EndOfMonth returns the last nanosecond of the month of t, in the location of t.
+func EndOfMonth(t time.Time) time.Time {
+	return StartOfMonth(t).AddDate(0, 1, 0).Add(-1)
+}
+
+// StartOfQuarter returns the midnight of the first day of the quarter of t, in the location of t.
+func StartOfQuarter(t time.Time) time.Time {
+	y, m, _ := t.Date()
+	return time.Date(y, (m-1)/3*3+1, 1, 0, 0, 0, 0, t.Location())
+}
+
+// EndOfQuarter returns the last nanosecond of the quarter of t, in the location of t.
+func EndOfQuarter(t time.Time) time.Time {
+	return StartOfQuarter(t).AddDate(0, 3, 0).Add(-1)
+}
+
+// StartOfYear returns the midnight of the first day of the year of t, in the location of t.
+func StartOfYear(t time.Time) time.Time {
+	return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location())
+}
+
+// EndOfYear returns the last nanosecond of the year of t, in the location of t.
+func EndOfYear(t time.Time) time.Time {
+	return StartOfYear(t).AddDate(1, 0, 0).Add(-1)
+}
+
+// IsWeekend reports whether t is on Saturday or Sunday, in the location of t.
+func IsWeekend(t time.Time) bool {
+	wd := t.Weekday()
+	return wd == time.Saturday || wd == time.Sunday
+}
+
+// AddBusinessDays adds n (may be negative) business days to t, skipping the weekends
+// and the days for which isHoliday returns true. The clock time of t is kept.
+// If t is not a business day, the counting starts from it as if it were.
+func AddBusinessDays(t time.Time, n int, isHoliday ...func(time.Time) bool) time.Time {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		t = t.AddDate(0, 0, step)
+		if IsWeekend(t) || len(isHoliday) > 0 && isHoliday[0] != nil && isHoliday[0](t) {
+			continue
+		}
+		n--
+	}
+	return t
+}
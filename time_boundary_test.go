@@ -0,0 +1,57 @@
+package goutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeBoundary(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*3600)
+	// Thursday
+	ts := time.Date(2020, 8, 20, 15, 4, 5, 6, loc)
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, loc) }
+	cases := []struct {
+		name      string
+		got, want time.Time
+	}{
+		{"StartOfDay", StartOfDay(ts), date(2020, 8, 20)},
+		{"EndOfDay", EndOfDay(ts), date(2020, 8, 21).Add(-1)},
+		{"StartOfWeek", StartOfWeek(ts), date(2020, 8, 17)},
+		{"StartOfWeek(Sunday)", StartOfWeek(ts, time.Sunday), date(2020, 8, 16)},
+		{"EndOfWeek", EndOfWeek(ts), date(2020, 8, 24).Add(-1)},
+		{"StartOfMonth", StartOfMonth(ts), date(2020, 8, 1)},
+		{"EndOfMonth", EndOfMonth(ts), date(2020, 9, 1).Add(-1)},
+		{"StartOfQuarter", StartOfQuarter(ts), date(2020, 7, 1)},
+		{"EndOfQuarter", EndOfQuarter(ts), date(2020, 10, 1).Add(-1)},
+		{"StartOfYear", StartOfYear(ts), date(2020, 1, 1)},
+		{"EndOfYear", EndOfYear(ts), date(2021, 1, 1).Add(-1)},
+	}
+	for _, c := range cases {
+		if !c.got.Equal(c.want) || c.got.Location() != loc {
+			t.Errorf("%s: got %v, want %v", c.name, c.got, c.want)
+		}
+	}
+	if !StartOfWeek(date(2020, 8, 17)).Equal(date(2020, 8, 17)) {
+		t.Error("the week start should be its own start")
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	fri := time.Date(2020, 8, 21, 9, 0, 0, 0, time.UTC)
+	if IsWeekend(fri) || !IsWeekend(fri.AddDate(0, 0, 1)) {
+		t.Fatal("unexpected IsWeekend")
+	}
+	if got := AddBusinessDays(fri, 1); !got.Equal(fri.AddDate(0, 0, 3)) {
+		t.Fatalf("got %v", got)
+	}
+	if got := AddBusinessDays(fri, -5); !got.Equal(fri.AddDate(0, 0, -7)) {
+		t.Fatalf("got %v", got)
+	}
+	holiday := func(t time.Time) bool { return t.Month() == 8 && t.Day() == 24 }
+	if got := AddBusinessDays(fri, 1, holiday); !got.Equal(fri.AddDate(0, 0, 4)) {
+		t.Fatalf("got %v", got)
+	}
+	if got := AddBusinessDays(fri, 0); !got.Equal(fri) {
+		t.Fatalf("got %v", got)
+	}
+}
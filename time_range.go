@@ -0,0 +1,140 @@
+package goutil
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// ErrInvalidTimeRange is returned when the end of the time range is before its start.
+var ErrInvalidTimeRange = errors.New("goutil: time range end is before start")
+
+// TimeRange is the half-open time range [Start, End),
+// e.g. for the maintenance windows, rate-limit windows and scheduling.
+type TimeRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// NewTimeRange creates a validated time range.
+func NewTimeRange(start, end time.Time) (TimeRange, error) {
+	r := TimeRange{Start: start, End: end}
+	return r, r.Validate()
+}
+
+// Validate returns ErrInvalidTimeRange if the end is before the start.
+func (r TimeRange) Validate() error {
+	if r.End.Before(r.Start) {
+		return ErrInvalidTimeRange
+	}
+	return nil
+}
+
+// IsEmpty reports whether the range contains no instant.
+func (r TimeRange) IsEmpty() bool {
+	return !r.Start.Before(r.End)
+}
+
+// Duration returns the length of the range.
+func (r TimeRange) Duration() time.Duration {
+	if r.IsEmpty() {
+		return 0
+	}
+	return r.End.Sub(r.Start)
+}
+
+// Contains reports whether t is in the range.
+func (r TimeRange) Contains(t time.Time) bool {
+	return !t.Before(r.Start) && t.Before(r.End)
+}
+
+// ContainsRange reports whether the non-empty o is entirely in the range.
+func (r TimeRange) ContainsRange(o TimeRange) bool {
+	return !o.IsEmpty() && !o.Start.Before(r.Start) && !o.End.After(r.End)
+}
+
+// Overlaps reports whether the two ranges share any instant.
+func (r TimeRange) Overlaps(o TimeRange) bool {
+	return r.Start.Before(o.End) && o.Start.Before(r.End)
+}
+
+// Intersect returns the overlapping part of the two ranges, and false if they do not overlap.
+func (r TimeRange) Intersect(o TimeRange) (TimeRange, bool) {
+	if !r.Overlaps(o) {
+		return TimeRange{}, false
+	}
+	return TimeRange{Start: maxTime(r.Start, o.Start), End: minTime(r.End, o.End)}, true
+}
+
+// Union returns the range covering both ranges, and false if they neither overlap nor are adjacent,
+// in which case the union is not a single range.
+func (r TimeRange) Union(o TimeRange) (TimeRange, bool) {
+	if r.Start.After(o.End) || o.Start.After(r.End) {
+		return TimeRange{}, false
+	}
+	return TimeRange{Start: minTime(r.Start, o.Start), End: maxTime(r.End, o.End)}, true
+}
+
+// SplitByDay splits the range at the midnights in loc, time.Local if loc is nil.
+func (r TimeRange) SplitByDay(loc *time.Location) []TimeRange {
+	if r.IsEmpty() {
+		return nil
+	}
+	if loc == nil {
+		loc = time.Local
+	}
+	var ranges []TimeRange
+	start := r.Start
+	for {
+		s := start.In(loc)
+		// AddDate keeps the midnight across the daylight saving time changes
+		next := time.Date(s.Year(), s.Month(), s.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1)
+		if !next.Before(r.End) {
+			return append(ranges, TimeRange{Start: start, End: r.End})
+		}
+		ranges = append(ranges, TimeRange{Start: start, End: next})
+		start = next
+	}
+}
+
+// String returns the range in the form "start/end" of RFC3339.
+func (r TimeRange) String() string {
+	return r.Start.Format(time.RFC3339Nano) + "/" + r.End.Format(time.RFC3339Nano)
+}
+
+// MergeTimeRanges merges the overlapping and adjacent ranges,
+// and returns the sorted disjoint ranges without the empty ones.
+func MergeTimeRanges(ranges []TimeRange) []TimeRange {
+	sorted := make([]TimeRange, 0, len(ranges))
+	for _, r := range ranges {
+		if !r.IsEmpty() {
+			sorted = append(sorted, r)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start.Before(sorted[j].Start) })
+	var merged []TimeRange
+	for _, r := range sorted {
+		if n := len(merged); n > 0 {
+			if u, ok := merged[n-1].Union(r); ok {
+				merged[n-1] = u
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
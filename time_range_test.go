@@ -0,0 +1,59 @@
+package goutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeRange(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2020, 1, 1, h, 0, 0, 0, time.UTC) }
+	r, err := NewTimeRange(at(1), at(5))
+	if err != nil || r.Duration() != 4*time.Hour {
+		t.Fatalf("got %v, %v", r, err)
+	}
+	if _, err = NewTimeRange(at(5), at(1)); err != ErrInvalidTimeRange {
+		t.Fatalf("got %v", err)
+	}
+	if !r.Contains(at(1)) || r.Contains(at(5)) || !r.ContainsRange(TimeRange{at(2), at(5)}) {
+		t.Fatal("unexpected Contains")
+	}
+	o := TimeRange{at(3), at(8)}
+	if !r.Overlaps(o) || r.Overlaps(TimeRange{at(5), at(6)}) {
+		t.Fatal("unexpected Overlaps")
+	}
+	if got, ok := r.Intersect(o); !ok || got != (TimeRange{at(3), at(5)}) {
+		t.Fatalf("got %v, %v", got, ok)
+	}
+	if _, ok := r.Intersect(TimeRange{at(6), at(7)}); ok {
+		t.Fatal("expect no intersection")
+	}
+	if got, ok := r.Union(TimeRange{at(5), at(6)}); !ok || got != (TimeRange{at(1), at(6)}) {
+		t.Fatalf("got %v, %v", got, ok)
+	}
+	if _, ok := r.Union(TimeRange{at(6), at(7)}); ok {
+		t.Fatal("expect no union")
+	}
+	merged := MergeTimeRanges([]TimeRange{{at(6), at(7)}, o, r, {at(9), at(9)}, {at(10), at(11)}})
+	if len(merged) != 2 || merged[0] != (TimeRange{at(1), at(8)}) || merged[1] != (TimeRange{at(10), at(11)}) {
+		t.Fatalf("got %v", merged)
+	}
+}
+
+func TestTimeRangeSplitByDay(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*3600)
+	r := TimeRange{
+		Start: time.Date(2020, 1, 1, 20, 0, 0, 0, loc),
+		End:   time.Date(2020, 1, 3, 6, 0, 0, 0, loc),
+	}
+	days := r.SplitByDay(loc)
+	if len(days) != 3 {
+		t.Fatalf("got %v", days)
+	}
+	if !days[1].Start.Equal(time.Date(2020, 1, 2, 0, 0, 0, 0, loc)) || days[1].Duration() != 24*time.Hour ||
+		!days[2].End.Equal(r.End) || days[0].Duration() != 4*time.Hour {
+		t.Fatalf("got %v", days)
+	}
+	if (TimeRange{}).SplitByDay(nil) != nil {
+		t.Fatal("expect nil for the empty range")
+	}
+}
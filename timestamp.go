@@ -0,0 +1,88 @@
+package goutil
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"time"
+)
+
+// UnixMilli returns the local time of the Unix milliseconds.
+func UnixMilli(ms int64) time.Time {
+	return time.Unix(ms/1e3, ms%1e3*1e6)
+}
+
+// UnixMicro returns the local time of the Unix microseconds.
+func UnixMicro(us int64) time.Time {
+	return time.Unix(us/1e6, us%1e6*1e3)
+}
+
+// ToUnixMilli returns t in the Unix milliseconds.
+func ToUnixMilli(t time.Time) int64 {
+	return t.Unix()*1e3 + int64(t.Nanosecond())/1e6
+}
+
+// ToUnixMicro returns t in the Unix microseconds.
+func ToUnixMicro(t time.Time) int64 {
+	return t.Unix()*1e6 + int64(t.Nanosecond())/1e3
+}
+
+// Timestamp is the time serialized in JSON as the Unix milliseconds,
+// since the mixed-language systems rarely agree on RFC3339.
+// The zero Timestamp is serialized as null.
+type Timestamp struct {
+	time.Time
+}
+
+// NewTimestamp returns the Timestamp of t, truncated to the millisecond.
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp{UnixMilli(ToUnixMilli(t))}
+}
+
+// Millis returns the Unix milliseconds.
+func (ts Timestamp) Millis() int64 {
+	return ToUnixMilli(ts.Time)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (ts Timestamp) MarshalJSON() ([]byte, error) {
+	if ts.IsZero() {
+		return []byte("null"), nil
+	}
+	return strconv.AppendInt(nil, ts.Millis(), 10), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting the Unix milliseconds
+// as a number or a string, the RFC3339 string and null.
+func (ts *Timestamp) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		ts.Time = time.Time{}
+		return nil
+	}
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		s := string(data[1 : len(data)-1])
+		if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+			ts.Time = UnixMilli(ms)
+			return nil
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return errors.New("goutil: invalid Timestamp " + string(data))
+		}
+		ts.Time = t
+		return nil
+	}
+	ms, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		// tolerates the fractional milliseconds
+		f, ferr := strconv.ParseFloat(string(data), 64)
+		if ferr != nil {
+			return errors.New("goutil: invalid Timestamp " + string(data))
+		}
+		ts.Time = UnixMicro(int64(f * 1e3))
+		return nil
+	}
+	ts.Time = UnixMilli(ms)
+	return nil
+}
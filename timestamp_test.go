@@ -0,0 +1,52 @@
+package goutil
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestUnixMilliMicro(t *testing.T) {
+	now := time.Unix(1600000000, 123456789)
+	if ms := ToUnixMilli(now); ms != 1600000000123 || !UnixMilli(ms).Equal(time.Unix(1600000000, 123000000)) {
+		t.Fatalf("got %d", ms)
+	}
+	if us := ToUnixMicro(now); us != 1600000000123456 || !UnixMicro(us).Equal(time.Unix(1600000000, 123456000)) {
+		t.Fatalf("got %d", us)
+	}
+	before := time.Unix(-1, 500000000)
+	if ms := ToUnixMilli(before); ms != -500 || !UnixMilli(ms).Equal(before) {
+		t.Fatalf("got %d", ms)
+	}
+}
+
+func TestTimestampJSON(t *testing.T) {
+	type event struct {
+		At   Timestamp  `json:"at"`
+		Zero Timestamp  `json:"zero"`
+		Ptr  *Timestamp `json:"ptr,omitempty"`
+	}
+	e := event{At: NewTimestamp(time.Unix(1600000000, 123456789))}
+	b, err := json.Marshal(e)
+	if err != nil || string(b) != `{"at":1600000000123,"zero":null}` {
+		t.Fatalf("got %s, %v", b, err)
+	}
+	var got event
+	if err = json.Unmarshal(b, &got); err != nil || !got.At.Equal(e.At.Time) || !got.Zero.IsZero() {
+		t.Fatalf("got %+v, %v", got, err)
+	}
+	for in, want := range map[string]int64{
+		`"1600000000123"`:            1600000000123,
+		`"2020-09-13T12:26:40.123Z"`: 1600000000123,
+		`1600000000123.9`:            1600000000123,
+	} {
+		var ts Timestamp
+		if err = ts.UnmarshalJSON([]byte(in)); err != nil || ts.Millis() != want {
+			t.Errorf("%s: got %d, %v", in, ts.Millis(), err)
+		}
+	}
+	var ts Timestamp
+	if err = json.Unmarshal([]byte(`"yesterday"`), &ts); err == nil {
+		t.Error("expect error")
+	}
+}
@@ -0,0 +1,313 @@
+// timewheel package implements a hierarchical timing wheel, scheduling a large number of timers
+// of the long delays (hours or days) in O(1), with the API compatible with time.AfterFunc.
+package timewheel
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	levelBits = 6
+	levelSize = 1 << levelBits
+	levelMask = levelSize - 1
+	numLevels = 6
+	// maxTicks is the span of all the levels, the longer delays are re-cascaded when they get near.
+	maxTicks = 1<<(levelBits*numLevels) - 1
+)
+
+// Options is the options of New.
+type Options struct {
+	// Tick is the resolution of the innermost wheel, 10ms by default.
+	// The outer wheels are 64 times coarser level by level, and 6 levels cover 2^36 ticks.
+	Tick time.Duration
+}
+
+// Scheduler returns the next run time after prev, or the zero time to stop.
+type Scheduler interface {
+	Next(prev time.Time) time.Time
+}
+
+// Every returns a Scheduler running every interval.
+func Every(interval time.Duration) Scheduler {
+	return every(interval)
+}
+
+type every time.Duration
+
+func (e every) Next(prev time.Time) time.Time {
+	return prev.Add(time.Duration(e))
+}
+
+// Stats is the metrics of the wheel.
+type Stats struct {
+	// Pending is the number of the timers waiting to fire.
+	Pending int
+	// Fired is the number of the fired timers.
+	Fired uint64
+	// Stopped is the number of the timers stopped before firing.
+	Stopped uint64
+}
+
+// TimingWheel is a hierarchical timing wheel. It is safe for concurrent use.
+type TimingWheel struct {
+	mu     sync.Mutex
+	tick   time.Duration
+	start  time.Time
+	now    uint64 // the next tick to process
+	levels [numLevels][levelSize]timerList
+	stats  Stats
+	stop   chan struct{}
+	done   chan struct{}
+	closed bool
+}
+
+// New creates and starts a timing wheel. opts may be nil.
+func New(opts *Options) *TimingWheel {
+	tick := 10 * time.Millisecond
+	if opts != nil && opts.Tick > 0 {
+		tick = opts.Tick
+	}
+	tw := &TimingWheel{
+		tick:  tick,
+		start: time.Now(),
+		stop:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+	for l := range tw.levels {
+		for s := range tw.levels[l] {
+			tw.levels[l][s].init()
+		}
+	}
+	go tw.run()
+	return tw
+}
+
+// Tick returns the resolution of the wheel.
+func (tw *TimingWheel) Tick() time.Duration {
+	return tw.tick
+}
+
+// AfterFunc waits for the duration to elapse and then calls f in its own goroutine,
+// like time.AfterFunc, rounded up to the tick.
+// If the wheel is stopped, f is never called.
+func (tw *TimingWheel) AfterFunc(d time.Duration, f func()) *Timer {
+	t := &Timer{tw: tw, f: f}
+	tw.mu.Lock()
+	tw.add(t, d)
+	tw.mu.Unlock()
+	return t
+}
+
+// After waits for the duration to elapse and then sends the current time on the returned channel,
+// like time.After.
+func (tw *TimingWheel) After(d time.Duration) <-chan time.Time {
+	c := make(chan time.Time, 1)
+	tw.AfterFunc(d, func() {
+		select {
+		case c <- time.Now():
+		default:
+		}
+	})
+	return c
+}
+
+// Schedule calls f in its own goroutine at every time returned by s,
+// until the timer is stopped or s returns the zero time.
+func (tw *TimingWheel) Schedule(s Scheduler, f func()) *Timer {
+	t := &Timer{tw: tw, f: f, sched: s}
+	tw.mu.Lock()
+	t.next = s.Next(time.Now())
+	if !t.next.IsZero() {
+		tw.add(t, time.Until(t.next))
+	}
+	tw.mu.Unlock()
+	return t
+}
+
+// Stats returns the metrics of the wheel.
+func (tw *TimingWheel) Stats() Stats {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	return tw.stats
+}
+
+// Stop stops the wheel, the pending timers will never fire.
+func (tw *TimingWheel) Stop() {
+	tw.mu.Lock()
+	if tw.closed {
+		tw.mu.Unlock()
+		return
+	}
+	tw.closed = true
+	tw.mu.Unlock()
+	close(tw.stop)
+	<-tw.done
+}
+
+func (tw *TimingWheel) run() {
+	defer close(tw.done)
+	ticker := time.NewTicker(tw.tick)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			// catches up with the wall clock if the ticks were dropped
+			tw.advance(uint64(now.Sub(tw.start) / tw.tick))
+		case <-tw.stop:
+			return
+		}
+	}
+}
+
+// add places the timer by its delay, the caller must hold tw.mu.
+// The expiration counts from the wall clock rather than the last processed tick,
+// so that the timer never fires before d elapses.
+func (tw *TimingWheel) add(t *Timer, d time.Duration) {
+	if d < 0 {
+		d = 0
+	}
+	tw.addAt(t, time.Since(tw.start)+d)
+}
+
+// addAt places the timer expiring at the offset since the start of the wheel, rounded up to the tick.
+func (tw *TimingWheel) addAt(t *Timer, at time.Duration) {
+	if tw.closed {
+		return
+	}
+	t.expires = uint64((at + tw.tick - 1) / tw.tick)
+	tw.place(t)
+	tw.stats.Pending++
+}
+
+// place links the timer into the slot of its expiration.
+func (tw *TimingWheel) place(t *Timer) {
+	delta := t.expires - tw.now
+	if t.expires < tw.now {
+		delta, t.expires = 0, tw.now
+	}
+	expires := t.expires
+	if delta > maxTicks {
+		// re-cascaded when it gets into the span
+		expires = tw.now + maxTicks
+		delta = maxTicks
+	}
+	level := 0
+	for delta >= 1<<(levelBits*uint(level+1)) {
+		level++
+	}
+	tw.levels[level][expires>>(levelBits*uint(level))&levelMask].push(t)
+}
+
+// advance processes the ticks up to target.
+func (tw *TimingWheel) advance(target uint64) {
+	var expired []*Timer
+	tw.mu.Lock()
+	for tw.now <= target {
+		// cascades the outer slots whose time comes when the inner wheel wraps
+		for level := 1; level < numLevels; level++ {
+			if tw.now&(1<<(levelBits*uint(level))-1) != 0 {
+				break
+			}
+			tw.levels[level][tw.now>>(levelBits*uint(level))&levelMask].drain(tw.place)
+		}
+		tw.levels[0][tw.now&levelMask].drain(func(t *Timer) {
+			if t.expires > tw.now {
+				// a capped long delay
+				tw.place(t)
+				return
+			}
+			expired = append(expired, t)
+		})
+		tw.now++
+	}
+	for _, t := range expired {
+		tw.stats.Pending--
+		tw.stats.Fired++
+		if t.sched != nil {
+			if t.next = t.sched.Next(t.next); !t.next.IsZero() {
+				tw.add(t, time.Until(t.next))
+			}
+		}
+	}
+	tw.mu.Unlock()
+	for _, t := range expired {
+		go t.f()
+	}
+}
+
+// Timer is a timer of the wheel.
+type Timer struct {
+	tw         *TimingWheel
+	f          func()
+	sched      Scheduler
+	next       time.Time
+	expires    uint64
+	list       *timerList
+	prev, link *Timer
+}
+
+// Stop prevents the timer from firing, like (*time.Timer).Stop.
+// It returns false if the timer has already fired or been stopped.
+func (t *Timer) Stop() bool {
+	t.tw.mu.Lock()
+	defer t.tw.mu.Unlock()
+	if t.list == nil {
+		t.sched = nil
+		return false
+	}
+	t.list.remove(t)
+	t.sched = nil
+	t.tw.stats.Pending--
+	t.tw.stats.Stopped++
+	return true
+}
+
+// Reset changes the timer to fire after the duration, like (*time.Timer).Reset.
+// It returns true if the timer had been active.
+func (t *Timer) Reset(d time.Duration) bool {
+	tw := t.tw
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	active := t.list != nil
+	if active {
+		t.list.remove(t)
+		tw.stats.Pending--
+	}
+	tw.add(t, d)
+	return active
+}
+
+// timerList is a circular doubly linked list with a sentinel, for the O(1) removals.
+type timerList struct {
+	root Timer
+}
+
+func (l *timerList) init() {
+	l.root.link, l.root.prev = &l.root, &l.root
+}
+
+func (l *timerList) push(t *Timer) {
+	t.list = l
+	t.prev, t.link = l.root.prev, &l.root
+	l.root.prev.link = t
+	l.root.prev = t
+}
+
+func (l *timerList) remove(t *Timer) {
+	t.prev.link = t.link
+	t.link.prev = t.prev
+	t.prev, t.link, t.list = nil, nil, nil
+}
+
+// drain unlinks all the timers and calls fn for each, which may push into the list again.
+func (l *timerList) drain(fn func(*Timer)) {
+	first, last := l.root.link, &l.root
+	l.init()
+	for t := first; t != last; {
+		next := t.link
+		t.prev, t.link, t.list = nil, nil, nil
+		fn(t)
+		t = next
+	}
+}
@@ -0,0 +1,135 @@
+package timewheel
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAfterFunc(t *testing.T) {
+	tw := New(&Options{Tick: time.Millisecond})
+	defer tw.Stop()
+	start := time.Now()
+	done := make(chan time.Duration, 3)
+	for _, d := range []time.Duration{30, 10, 80} {
+		tw.AfterFunc(d*time.Millisecond, func() { done <- time.Since(start) })
+	}
+	last := time.Duration(0)
+	for i := 0; i < 3; i++ {
+		select {
+		case d := <-done:
+			if d < last {
+				t.Fatalf("fired out of order: %v after %v", d, last)
+			}
+			last = d
+		case <-time.After(2 * time.Second):
+			t.Fatal("timeout")
+		}
+	}
+	if last < 80*time.Millisecond {
+		t.Fatalf("fired too early: %v", last)
+	}
+	if s := tw.Stats(); s.Pending != 0 || s.Fired != 3 {
+		t.Fatalf("got %+v", s)
+	}
+}
+
+func TestAfterFuncNotEarly(t *testing.T) {
+	tw := New(&Options{Tick: 100 * time.Millisecond})
+	defer tw.Stop()
+	// in the middle of a tick
+	time.Sleep(90 * time.Millisecond)
+	start := time.Now()
+	select {
+	case <-tw.After(100 * time.Millisecond):
+		if d := time.Since(start); d < 100*time.Millisecond {
+			t.Fatalf("fired too early: %v", d)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout")
+	}
+}
+
+func TestTimerStopReset(t *testing.T) {
+	tw := New(&Options{Tick: time.Millisecond})
+	defer tw.Stop()
+	var fired int32
+	timer := tw.AfterFunc(20*time.Millisecond, func() { atomic.AddInt32(&fired, 1) })
+	if tw.Stats().Pending != 1 || !timer.Stop() || timer.Stop() {
+		t.Fatal("unexpected Stop")
+	}
+	if timer.Reset(10 * time.Millisecond) {
+		t.Fatal("the stopped timer should not be active")
+	}
+	select {
+	case <-tw.After(50 * time.Millisecond):
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout")
+	}
+	if atomic.LoadInt32(&fired) != 1 {
+		t.Fatalf("fired %d times", fired)
+	}
+	if s := tw.Stats(); s.Stopped != 1 || s.Pending != 0 {
+		t.Fatalf("got %+v", s)
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	tw := New(&Options{Tick: time.Millisecond})
+	defer tw.Stop()
+	var n int32
+	timer := tw.Schedule(Every(5*time.Millisecond), func() { atomic.AddInt32(&n, 1) })
+	time.Sleep(60 * time.Millisecond)
+	timer.Stop()
+	got := atomic.LoadInt32(&n)
+	if got < 3 {
+		t.Fatalf("fired %d times", got)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if atomic.LoadInt32(&n) > got+1 {
+		t.Fatal("fired after Stop")
+	}
+}
+
+func TestCascade(t *testing.T) {
+	// a wheel without the ticker goroutine, driven by hand
+	tw := &TimingWheel{tick: time.Nanosecond}
+	for l := range tw.levels {
+		for s := range tw.levels[l] {
+			tw.levels[l][s].init()
+		}
+	}
+	expect := []uint64{0, 1, 63, 64, 65, 4095, 4096, 4097, 262143, 262144, 300000}
+	tw.mu.Lock()
+	for _, ticks := range expect {
+		tw.addAt(&Timer{tw: tw, f: func() {}}, time.Duration(ticks))
+	}
+	long := &Timer{tw: tw, f: func() {}}
+	tw.addAt(long, maxTicks+100)
+	tw.mu.Unlock()
+	if long.list != &tw.levels[numLevels-1][(maxTicks>>(levelBits*(numLevels-1)))&levelMask] {
+		t.Fatal("the too long delay should be capped in the outermost wheel")
+	}
+	var fired []uint64
+	for now := uint64(0); now <= 300000; now++ {
+		before := tw.Stats().Fired
+		tw.advance(now)
+		if n := tw.Stats().Fired - before; n > 0 {
+			if n != 1 {
+				t.Fatalf("tick %d fired %d timers", now, n)
+			}
+			fired = append(fired, now)
+		}
+	}
+	if len(fired) != len(expect) {
+		t.Fatalf("got %v", fired)
+	}
+	for i := range expect {
+		if fired[i] != expect[i] {
+			t.Fatalf("got %v, want %v", fired, expect)
+		}
+	}
+	if s := tw.Stats(); s.Pending != 1 {
+		t.Fatalf("got %+v", s)
+	}
+}
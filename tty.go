@@ -0,0 +1,29 @@
+package goutil
+
+import (
+	"errors"
+	"os"
+)
+
+// ErrNotTerminal is returned by TerminalSize if the fd is not a terminal.
+var ErrNotTerminal = errors.New("goutil: not a terminal")
+
+// IsTerminal reports whether the fd is a terminal, e.g. IsTerminal(os.Stdout.Fd()).
+func IsTerminal(fd uintptr) bool {
+	return isTerminal(fd)
+}
+
+// TerminalSize returns the width and height of the terminal fd in characters.
+func TerminalSize(fd uintptr) (width, height int, err error) {
+	return terminalSize(fd)
+}
+
+// IsPiped reports whether the file is not a character device,
+// i.e. a pipe or a redirected file, e.g. IsPiped(os.Stdin).
+func IsPiped(f *os.File) bool {
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice == 0
+}
@@ -0,0 +1,8 @@
+//go:build linux
+// +build linux
+
+package goutil
+
+import "syscall"
+
+const ioctlGetTermios = syscall.TCGETS
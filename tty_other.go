@@ -0,0 +1,12 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !windows
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!windows
+
+package goutil
+
+func isTerminal(fd uintptr) bool {
+	return false
+}
+
+func terminalSize(fd uintptr) (width, height int, err error) {
+	return 0, 0, ErrNotTerminal
+}
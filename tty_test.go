@@ -0,0 +1,34 @@
+package goutil
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestIsTerminal(t *testing.T) {
+	f, err := ioutil.TempFile("", "tty")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if IsTerminal(f.Fd()) {
+		t.Fatal("a regular file is not a terminal")
+	}
+	if _, _, err = TerminalSize(f.Fd()); err != ErrNotTerminal {
+		t.Fatalf("got %v", err)
+	}
+	if !IsPiped(f) {
+		t.Fatal("a regular file is redirected")
+	}
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+	if !IsPiped(r) || IsTerminal(r.Fd()) {
+		t.Fatal("expect a pipe")
+	}
+}
@@ -0,0 +1,31 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+// +build darwin dragonfly freebsd linux netbsd openbsd
+
+package goutil
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+type winsize struct {
+	row, col, xpixel, ypixel uint16
+}
+
+func isTerminal(fd uintptr) bool {
+	var t syscall.Termios
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, ioctlGetTermios, uintptr(unsafe.Pointer(&t)))
+	return errno == 0
+}
+
+func terminalSize(fd uintptr) (width, height int, err error) {
+	var ws winsize
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, syscall.TIOCGWINSZ, uintptr(unsafe.Pointer(&ws)))
+	if errno != 0 {
+		if errno == syscall.ENOTTY {
+			return 0, 0, ErrNotTerminal
+		}
+		return 0, 0, errno
+	}
+	return int(ws.col), int(ws.row), nil
+}
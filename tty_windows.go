@@ -0,0 +1,40 @@
+//go:build windows
+// +build windows
+
+package goutil
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+var procGetConsoleScreenBufferInfo = modkernel32.NewProc("GetConsoleScreenBufferInfo")
+
+type smallRect struct {
+	left, top, right, bottom int16
+}
+
+type consoleScreenBufferInfo struct {
+	sizeX, sizeY           int16
+	cursorX, cursorY       int16
+	attributes             uint16
+	window                 smallRect
+	maxWindowX, maxWindowY int16
+}
+
+func isTerminal(fd uintptr) bool {
+	var mode uint32
+	return syscall.GetConsoleMode(syscall.Handle(fd), &mode) == nil
+}
+
+func terminalSize(fd uintptr) (width, height int, err error) {
+	if !isTerminal(fd) {
+		return 0, 0, ErrNotTerminal
+	}
+	var info consoleScreenBufferInfo
+	r, _, e := procGetConsoleScreenBufferInfo.Call(fd, uintptr(unsafe.Pointer(&info)))
+	if r == 0 {
+		return 0, 0, e
+	}
+	return int(info.window.right-info.window.left) + 1, int(info.window.bottom-info.window.top) + 1, nil
+}
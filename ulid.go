@@ -0,0 +1,143 @@
+package goutil
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ULID is a lexicographically sortable identifier of the 48-bit Unix milliseconds
+// and 80-bit entropy, encoded in 26 Crockford's base32 characters.
+type ULID [16]byte
+
+// ErrInvalidULID is returned when parsing an invalid ULID.
+var ErrInvalidULID = errors.New("goutil: invalid ULID")
+
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+var crockfordDecoding = func() (d [256]byte) {
+	for i := range d {
+		d[i] = 0xff
+	}
+	for i := 0; i < len(crockfordAlphabet); i++ {
+		c := crockfordAlphabet[i]
+		d[c] = byte(i)
+		if c >= 'A' {
+			d[c+'a'-'A'] = byte(i)
+		}
+	}
+	for _, c := range "oO" {
+		d[c] = 0
+	}
+	for _, c := range "iIlL" {
+		d[c] = 1
+	}
+	return
+}()
+
+var ulidState struct {
+	mu   sync.Mutex
+	last ULID
+}
+
+// NewULID returns a ULID of the current time, whose entropy is incremented
+// (monotonic) for the ULIDs in the same millisecond. It will panic
+// if the system's secure random number generator fails to function correctly.
+func NewULID() ULID {
+	var u ULID
+	ms := uint64(time.Now().UnixNano() / int64(time.Millisecond))
+	ulidState.mu.Lock()
+	defer ulidState.mu.Unlock()
+	if last := ulidState.last.ms(); ms <= last {
+		u = ulidState.last
+		// increments the 80-bit entropy, borrowing the next millisecond on overflow
+		i := 15
+		for ; i >= 6; i-- {
+			if u[i]++; u[i] != 0 {
+				break
+			}
+		}
+		if i < 6 {
+			u.setMs(last + 1)
+		}
+	} else {
+		uuidRand.mu.Lock()
+		readUUIDRand(u[6:])
+		uuidRand.mu.Unlock()
+		u.setMs(ms)
+	}
+	ulidState.last = u
+	return u
+}
+
+func (u *ULID) setMs(ms uint64) {
+	u[0], u[1], u[2] = byte(ms>>40), byte(ms>>32), byte(ms>>24)
+	u[3], u[4], u[5] = byte(ms>>16), byte(ms>>8), byte(ms)
+}
+
+func (u ULID) ms() uint64 {
+	return uint64(u[0])<<40 | uint64(u[1])<<32 | uint64(u[2])<<24 | uint64(u[3])<<16 | uint64(u[4])<<8 | uint64(u[5])
+}
+
+// Time returns the timestamp of the ULID.
+func (u ULID) Time() time.Time {
+	ms := int64(u.ms())
+	return time.Unix(ms/1000, ms%1000*int64(time.Millisecond))
+}
+
+// String returns the 26 characters of the ULID.
+func (u ULID) String() string {
+	b, _ := u.MarshalText()
+	return BytesToString(b)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (u ULID) MarshalText() ([]byte, error) {
+	out := make([]byte, 26)
+	// the 128 bits are left-padded with 2 zero bits
+	for i := range out {
+		var v byte
+		for pos := i*5 - 2; pos < i*5+3; pos++ {
+			v <<= 1
+			if pos >= 0 {
+				v |= u[pos/8] >> uint(7-pos%8) & 1
+			}
+		}
+		out[i] = crockfordAlphabet[v]
+	}
+	return out, nil
+}
+
+// ParseULID parses the 26 characters of the ULID, case-insensitively.
+func ParseULID(s string) (ULID, error) {
+	var u ULID
+	if len(s) != 26 {
+		return u, ErrInvalidULID
+	}
+	for i := 0; i < 26; i++ {
+		v := crockfordDecoding[s[i]]
+		if v == 0xff || i == 0 && v > 7 {
+			return NilULID, ErrInvalidULID
+		}
+		for b := 0; b < 5; b++ {
+			pos := i*5 - 2 + b
+			if pos >= 0 && v>>uint(4-b)&1 == 1 {
+				u[pos/8] |= 1 << uint(7-pos%8)
+			}
+		}
+	}
+	return u, nil
+}
+
+// NilULID is the all-zero ULID.
+var NilULID ULID
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (u *ULID) UnmarshalText(text []byte) error {
+	v, err := ParseULID(string(text))
+	if err != nil {
+		return err
+	}
+	*u = v
+	return nil
+}
@@ -0,0 +1,43 @@
+package goutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewULID(t *testing.T) {
+	start := time.Now().Truncate(time.Millisecond)
+	prev := NewULID()
+	for i := 0; i < 10000; i++ {
+		u := NewULID()
+		if u.String() <= prev.String() {
+			t.Fatalf("got %s after %s", u, prev)
+		}
+		prev = u
+	}
+	if ts := prev.Time(); ts.Before(start) || ts.After(time.Now().Add(time.Second)) {
+		t.Fatalf("got time %v", ts)
+	}
+}
+
+func TestParseULID(t *testing.T) {
+	u := NewULID()
+	s := u.String()
+	if len(s) != 26 {
+		t.Fatalf("got %q", s)
+	}
+	got, err := ParseULID(s)
+	if err != nil || got != u {
+		t.Fatalf("got %s, %v", got, err)
+	}
+	// the example of the spec
+	u, err = ParseULID("01arz3ndektsv4rrffq69g5fav")
+	if err != nil || u.String() != "01ARZ3NDEKTSV4RRFFQ69G5FAV" || u.Time().UnixNano()/int64(time.Millisecond) != 1469922850259 {
+		t.Fatalf("got %s, %v, %v", u, u.Time(), err)
+	}
+	for _, in := range []string{"", "01ARZ3NDEKTSV4RRFFQ69G5FA", "81ARZ3NDEKTSV4RRFFQ69G5FAV", "01ARZ3NDEKTSV4RRFFQ69G5FA*", "01ARZ3NDEKTSV4RRFFQ69G5FUV"} {
+		if _, err = ParseULID(in); err != ErrInvalidULID {
+			t.Fatalf("%q: got %v", in, err)
+		}
+	}
+}
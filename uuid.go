@@ -0,0 +1,214 @@
+package goutil
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// UUID is a RFC 9562 universally unique identifier.
+type UUID [16]byte
+
+// NilUUID is the all-zero UUID.
+var NilUUID UUID
+
+// ErrInvalidUUID is returned when parsing an invalid UUID.
+var ErrInvalidUUID = errors.New("goutil: invalid UUID")
+
+// uuidRand buffers the random bytes so that the generation doesn't allocate
+// or make a syscall for each UUID.
+var uuidRand struct {
+	mu  sync.Mutex
+	buf [16 * 128]byte
+	pos int
+	// the state of v7
+	lastMs  int64
+	counter uint16
+}
+
+func init() {
+	uuidRand.pos = len(uuidRand.buf)
+}
+
+// readUUIDRand fills b with the buffered random bytes, the lock must be held.
+func readUUIDRand(b []byte) {
+	if uuidRand.pos+len(b) > len(uuidRand.buf) {
+		cryptoFill(uuidRand.buf[:])
+		uuidRand.pos = 0
+	}
+	uuidRand.pos += copy(b, uuidRand.buf[uuidRand.pos:])
+}
+
+// NewUUID returns a random (version 4) UUID. It will panic
+// if the system's secure random number generator fails to function correctly.
+func NewUUID() UUID {
+	var u UUID
+	uuidRand.mu.Lock()
+	readUUIDRand(u[:])
+	uuidRand.mu.Unlock()
+	u[6] = u[6]&0x0f | 0x40
+	u[8] = u[8]&0x3f | 0x80
+	return u
+}
+
+// NewUUIDv7 returns a time-ordered (version 7) UUID with the Unix milliseconds,
+// which is monotonic within the process by a 12-bit counter in the same millisecond.
+// It will panic if the system's secure random number generator fails to function correctly.
+func NewUUIDv7() UUID {
+	var u UUID
+	ms := time.Now().UnixNano() / int64(time.Millisecond)
+	uuidRand.mu.Lock()
+	readUUIDRand(u[6:])
+	if ms <= uuidRand.lastMs {
+		if uuidRand.counter++; uuidRand.counter > 0xfff {
+			// the counter overflows, so borrows the next millisecond
+			uuidRand.lastMs++
+			uuidRand.counter = 0
+		}
+		ms = uuidRand.lastMs
+	} else {
+		uuidRand.lastMs = ms
+		// starts from a random value under the half to leave room for the increments
+		uuidRand.counter = (uint16(u[6])<<8 | uint16(u[7])) & 0x7ff
+	}
+	counter := uuidRand.counter
+	uuidRand.mu.Unlock()
+	u[0], u[1], u[2] = byte(ms>>40), byte(ms>>32), byte(ms>>24)
+	u[3], u[4], u[5] = byte(ms>>16), byte(ms>>8), byte(ms)
+	u[6] = 0x70 | byte(counter>>8)
+	u[7] = byte(counter)
+	u[8] = u[8]&0x3f | 0x80
+	return u
+}
+
+// ParseUUID parses the canonical form "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx",
+// optionally with the braces or "urn:uuid:" prefix, or the 32 hex digits.
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+	switch len(s) {
+	case 36 + 9:
+		if !strings.EqualFold(s[:9], "urn:uuid:") {
+			return u, ErrInvalidUUID
+		}
+		s = s[9:]
+	case 36 + 2:
+		if s[0] != '{' || s[37] != '}' {
+			return u, ErrInvalidUUID
+		}
+		s = s[1:37]
+	case 32:
+		if _, err := hex.Decode(u[:], []byte(s)); err != nil {
+			return NilUUID, ErrInvalidUUID
+		}
+		return u, nil
+	}
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return u, ErrInvalidUUID
+	}
+	j := 0
+	for i := 0; i < 36; i += 2 {
+		if i == 8 || i == 13 || i == 18 || i == 23 {
+			i++
+		}
+		hi, ok1 := fromHexChar(s[i])
+		lo, ok2 := fromHexChar(s[i+1])
+		if !ok1 || !ok2 {
+			return NilUUID, ErrInvalidUUID
+		}
+		u[j] = hi<<4 | lo
+		j++
+	}
+	return u, nil
+}
+
+func fromHexChar(c byte) (byte, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0', true
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10, true
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+// AppendFormat appends the canonical form of the UUID to dst.
+func (u UUID) AppendFormat(dst []byte) []byte {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return append(dst, buf[:]...)
+}
+
+// String returns the canonical form "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx".
+func (u UUID) String() string {
+	return BytesToString(u.AppendFormat(make([]byte, 0, 36)))
+}
+
+// Version returns the version of the UUID.
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// IsNil reports whether the UUID is NilUUID.
+func (u UUID) IsNil() bool {
+	return u == NilUUID
+}
+
+// Time returns the timestamp of the version 7 UUID, or the zero time for the other versions.
+func (u UUID) Time() time.Time {
+	if u.Version() != 7 {
+		return time.Time{}
+	}
+	ms := int64(u[0])<<40 | int64(u[1])<<32 | int64(u[2])<<24 | int64(u[3])<<16 | int64(u[4])<<8 | int64(u[5])
+	return time.Unix(ms/1000, ms%1000*int64(time.Millisecond))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (u UUID) MarshalText() ([]byte, error) {
+	return u.AppendFormat(nil), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (u *UUID) UnmarshalText(text []byte) error {
+	v, err := ParseUUID(string(text))
+	if err != nil {
+		return err
+	}
+	*u = v
+	return nil
+}
+
+// Value implements driver.Valuer, storing the canonical string.
+func (u UUID) Value() (driver.Value, error) {
+	return u.String(), nil
+}
+
+// Scan implements sql.Scanner, accepting the string, the 16 raw bytes, the text bytes and nil.
+func (u *UUID) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*u = NilUUID
+		return nil
+	case string:
+		return u.UnmarshalText([]byte(v))
+	case []byte:
+		if len(v) == 16 {
+			copy(u[:], v)
+			return nil
+		}
+		return u.UnmarshalText(v)
+	}
+	return errors.New("goutil: cannot scan into UUID")
+}
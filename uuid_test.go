@@ -0,0 +1,68 @@
+package goutil
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNewUUID(t *testing.T) {
+	seen := make(map[UUID]bool)
+	for i := 0; i < 1000; i++ {
+		u := NewUUID()
+		if u.Version() != 4 || u[8]&0xc0 != 0x80 || seen[u] {
+			t.Fatalf("got %s", u)
+		}
+		seen[u] = true
+	}
+	if n := testing.AllocsPerRun(100, func() { NewUUID() }); n != 0 {
+		t.Fatalf("got %v allocs", n)
+	}
+}
+
+func TestNewUUIDv7(t *testing.T) {
+	start := time.Now().Truncate(time.Millisecond)
+	prev := NewUUIDv7()
+	for i := 0; i < 10000; i++ {
+		u := NewUUIDv7()
+		if u.Version() != 7 || u[8]&0xc0 != 0x80 || u.String() <= prev.String() {
+			t.Fatalf("got %s after %s", u, prev)
+		}
+		prev = u
+	}
+	if ts := prev.Time(); ts.Before(start) || ts.After(time.Now().Add(time.Second)) {
+		t.Fatalf("got time %v", ts)
+	}
+}
+
+func TestParseUUID(t *testing.T) {
+	u := NewUUID()
+	s := u.String()
+	for _, in := range []string{s, "{" + s + "}", "urn:uuid:" + s, s[:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]} {
+		got, err := ParseUUID(in)
+		if err != nil || got != u {
+			t.Fatalf("%q: got %s, %v", in, got, err)
+		}
+	}
+	for _, in := range []string{"", "x", s[:35] + "g", s[:8] + "+" + s[9:]} {
+		if _, err := ParseUUID(in); err != ErrInvalidUUID {
+			t.Fatalf("%q: got %v", in, err)
+		}
+	}
+	b, _ := json.Marshal(u)
+	var v UUID
+	if err := json.Unmarshal(b, &v); err != nil || v != u {
+		t.Fatalf("got %s, %v", v, err)
+	}
+	val, _ := u.Value()
+	var w UUID
+	if err := w.Scan(val); err != nil || w != u {
+		t.Fatalf("got %s, %v", w, err)
+	}
+	if err := w.Scan(u[:]); err != nil || w != u {
+		t.Fatalf("got %s, %v", w, err)
+	}
+	if err := w.Scan(nil); err != nil || !w.IsNil() {
+		t.Fatalf("got %s, %v", w, err)
+	}
+}
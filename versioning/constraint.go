@@ -0,0 +1,159 @@
+package versioning
+
+import (
+	"errors"
+	"strings"
+)
+
+// Constraint is a set of version conditions, such as ">=1.2.0, <2 || 3.x".
+// The conditions separated by ',' (or spaces) must all be satisfied,
+// and the groups separated by "||" are alternatives.
+//
+// Supported operators: =, !=, >, >=, <, <=,
+// ~ (~1.2.3 means >=1.2.3, <1.3.0), ^ (^1.2.3 means >=1.2.3, <2.0.0),
+// and the wildcards x, X, * (1.2.x means >=1.2.0, <1.3.0).
+// NOTE: pre-release versions are compared by precedence only,
+// so 2.0.0-rc.1 satisfies "<2".
+type Constraint struct {
+	raw    string
+	groups [][]condition
+}
+
+type condition struct {
+	op string
+	v  *Version
+}
+
+// ErrInvalidConstraint is returned when parsing an invalid constraint.
+var ErrInvalidConstraint = errors.New("versioning: invalid version constraint")
+
+// ParseConstraint parses the version constraint.
+func ParseConstraint(s string) (*Constraint, error) {
+	c := &Constraint{raw: s}
+	for _, group := range strings.Split(s, "||") {
+		var conds []condition
+		fields := strings.FieldsFunc(group, func(r rune) bool { return r == ',' || r == ' ' })
+		for i := 0; i < len(fields); i++ {
+			f := fields[i]
+			// Allow a space between the operator and the version, such as ">= 1.2".
+			if strings.Trim(f, "=!<>~^") == "" && i+1 < len(fields) {
+				i++
+				f += fields[i]
+			}
+			cs, err := parseCondition(f)
+			if err != nil {
+				return nil, err
+			}
+			conds = append(conds, cs...)
+		}
+		if len(conds) == 0 {
+			return nil, ErrInvalidConstraint
+		}
+		c.groups = append(c.groups, conds)
+	}
+	return c, nil
+}
+
+func parseCondition(s string) ([]condition, error) {
+	op := s[:len(s)-len(strings.TrimLeft(s, "=!<>~^"))]
+	vs := s[len(op):]
+	switch op {
+	case "", "=", "==":
+		op = "="
+	case "!=", ">", ">=", "<", "<=", "~", "^":
+	default:
+		return nil, ErrInvalidConstraint
+	}
+	// Wildcards: *, 1.x, 1.2.*
+	parts := strings.Split(strings.TrimPrefix(vs, "v"), ".")
+	for i, p := range parts {
+		if p == "x" || p == "X" || p == "*" {
+			if op != "=" || i+1 != len(parts) && strings.Trim(strings.Join(parts[i+1:], ""), "xX*") != "" {
+				return nil, ErrInvalidConstraint
+			}
+			if i == 0 {
+				return []condition{{op: ">=", v: new(Version)}}, nil
+			}
+			vs, op = strings.Join(parts[:i], "."), "~wild"
+			break
+		}
+	}
+	v, n, err := parse(vs)
+	if err != nil {
+		return nil, ErrInvalidConstraint
+	}
+	switch op {
+	case "~wild", "~":
+		// ~1 and 1.x mean >=1.0.0, <2.0.0; ~1.2 and 1.2.x mean >=1.2.0, <1.3.0.
+		upper := &Version{Major: v.Major + 1}
+		if n >= 2 {
+			upper = &Version{Major: v.Major, Minor: v.Minor + 1}
+		}
+		return []condition{{">=", v}, {"<", upper}}, nil
+	case "^":
+		upper := &Version{Major: v.Major + 1}
+		switch {
+		case v.Major == 0 && v.Minor == 0 && n == 3:
+			upper = &Version{Patch: v.Patch + 1}
+		case v.Major == 0 && n >= 2:
+			upper = &Version{Minor: v.Minor + 1}
+		}
+		return []condition{{">=", v}, {"<", upper}}, nil
+	case "=":
+		if n < 3 {
+			// =1.2 means 1.2.x
+			return parseCondition(vs + ".x")
+		}
+	}
+	return []condition{{op, v}}, nil
+}
+
+// Check reports whether the version satisfies the constraint.
+func (c *Constraint) Check(v *Version) bool {
+	for _, group := range c.groups {
+		ok := true
+		for _, cond := range group {
+			if !cond.check(v) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the original constraint string.
+func (c *Constraint) String() string {
+	return c.raw
+}
+
+func (cond condition) check(v *Version) bool {
+	r := v.Compare(cond.v)
+	switch cond.op {
+	case "=":
+		return r == 0
+	case "!=":
+		return r != 0
+	case ">":
+		return r > 0
+	case ">=":
+		return r >= 0
+	case "<":
+		return r < 0
+	case "<=":
+		return r <= 0
+	}
+	return false
+}
+
+// Satisfies reports whether the version satisfies the constraint string.
+func (v *Version) Satisfies(constraint string) (bool, error) {
+	c, err := ParseConstraint(constraint)
+	if err != nil {
+		return false, err
+	}
+	return c.Check(v), nil
+}
@@ -0,0 +1,186 @@
+// versioning package parses and compares semantic versions (https://semver.org).
+package versioning
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Version is a semantic version.
+type Version struct {
+	Major, Minor, Patch uint64
+	// Pre is the pre-release identifiers, such as ["rc", "1"] of "1.0.0-rc.1".
+	Pre []string
+	// Build is the build metadata, such as "20180101" of "1.0.0+20180101".
+	// It is ignored when comparing versions.
+	Build string
+}
+
+// ErrInvalidVersion is returned when parsing an invalid version.
+var ErrInvalidVersion = errors.New("versioning: invalid semantic version")
+
+// Parse parses the semantic version, the prefix 'v' is optional.
+// The omitted minor and patch numbers are zero, such as "v2" is parsed as "2.0.0".
+func Parse(s string) (*Version, error) {
+	v, _, err := parse(s)
+	return v, err
+}
+
+// MustParse is like Parse but panics if the version cannot be parsed.
+func MustParse(s string) *Version {
+	v, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// parse returns the version and the number of the specified components.
+func parse(s string) (*Version, int, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "v")
+	v := new(Version)
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		v.Build = s[i+1:]
+		s = s[:i]
+		if !validIdents(v.Build, false) {
+			return nil, 0, ErrInvalidVersion
+		}
+	}
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		pre := s[i+1:]
+		s = s[:i]
+		if !validIdents(pre, true) {
+			return nil, 0, ErrInvalidVersion
+		}
+		v.Pre = strings.Split(pre, ".")
+	}
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return nil, 0, ErrInvalidVersion
+	}
+	nums := [3]*uint64{&v.Major, &v.Minor, &v.Patch}
+	for i, p := range parts {
+		if p == "" || (len(p) > 1 && p[0] == '0') {
+			return nil, 0, ErrInvalidVersion
+		}
+		n, err := strconv.ParseUint(p, 10, 64)
+		if err != nil {
+			return nil, 0, ErrInvalidVersion
+		}
+		*nums[i] = n
+	}
+	return v, len(parts), nil
+}
+
+func validIdents(s string, noLeadingZero bool) bool {
+	for _, id := range strings.Split(s, ".") {
+		if id == "" {
+			return false
+		}
+		numeric := true
+		for i := 0; i < len(id); i++ {
+			c := id[i]
+			switch {
+			case c >= '0' && c <= '9':
+			case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '-':
+				numeric = false
+			default:
+				return false
+			}
+		}
+		if noLeadingZero && numeric && len(id) > 1 && id[0] == '0' {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the version string without the prefix 'v'.
+func (v *Version) String() string {
+	s := strconv.FormatUint(v.Major, 10) + "." + strconv.FormatUint(v.Minor, 10) + "." + strconv.FormatUint(v.Patch, 10)
+	if len(v.Pre) > 0 {
+		s += "-" + strings.Join(v.Pre, ".")
+	}
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+	return s
+}
+
+// Compare compares v and o by the semantic versioning precedence.
+// A pre-release version has lower precedence than the normal version.
+// Returns -1 if v<o, 0 if v==o, +1 if v>o.
+func (v *Version) Compare(o *Version) int {
+	if r := compareUint(v.Major, o.Major); r != 0 {
+		return r
+	}
+	if r := compareUint(v.Minor, o.Minor); r != 0 {
+		return r
+	}
+	if r := compareUint(v.Patch, o.Patch); r != 0 {
+		return r
+	}
+	switch {
+	case len(v.Pre) == 0 && len(o.Pre) == 0:
+		return 0
+	case len(v.Pre) == 0:
+		return 1
+	case len(o.Pre) == 0:
+		return -1
+	}
+	for i := 0; i < len(v.Pre) && i < len(o.Pre); i++ {
+		if r := compareIdent(v.Pre[i], o.Pre[i]); r != 0 {
+			return r
+		}
+	}
+	return compareUint(uint64(len(v.Pre)), uint64(len(o.Pre)))
+}
+
+// LessThan reports whether v<o.
+func (v *Version) LessThan(o *Version) bool {
+	return v.Compare(o) < 0
+}
+
+// Equal reports whether v and o have the same precedence.
+func (v *Version) Equal(o *Version) bool {
+	return v.Compare(o) == 0
+}
+
+// Compare parses and compares the version strings a and b.
+func Compare(a, b string) (int, error) {
+	va, err := Parse(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := Parse(b)
+	if err != nil {
+		return 0, err
+	}
+	return va.Compare(vb), nil
+}
+
+func compareIdent(a, b string) int {
+	na, errA := strconv.ParseUint(a, 10, 64)
+	nb, errB := strconv.ParseUint(b, 10, 64)
+	switch {
+	case errA == nil && errB == nil:
+		return compareUint(na, nb)
+	case errA == nil:
+		// Numeric identifiers have lower precedence.
+		return -1
+	case errB == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
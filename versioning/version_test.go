@@ -0,0 +1,86 @@
+package versioning
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		s, out string
+		err    bool
+	}{
+		{"1.2.3", "1.2.3", false},
+		{"v1.2.3-rc.1+build.5", "1.2.3-rc.1+build.5", false},
+		{"2", "2.0.0", false},
+		{"1.2", "1.2.0", false},
+		{"01.2.3", "", true},
+		{"1.2.3.4", "", true},
+		{"1.2.3-", "", true},
+		{"1.2.3-01", "", true},
+		{"a.b.c", "", true},
+	}
+	for _, c := range cases {
+		v, err := Parse(c.s)
+		if (err != nil) != c.err || (err == nil && v.String() != c.out) {
+			t.Errorf("Parse(%q) = %v, %v, expect %q", c.s, v, err, c.out)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	ordered := []string{
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta",
+		"1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.10.0", "2.0.0",
+	}
+	vs := make([]*Version, len(ordered))
+	for i := range ordered {
+		vs[len(vs)-1-i] = MustParse(ordered[i])
+	}
+	sort.Slice(vs, func(i, j int) bool { return vs[i].LessThan(vs[j]) })
+	for i, v := range vs {
+		if v.String() != ordered[i] {
+			t.Fatalf("index %d: got %s, expect %s", i, v, ordered[i])
+		}
+	}
+	if r, err := Compare("1.0.0+a", "v1.0.0+b"); err != nil || r != 0 {
+		t.Fatalf("build metadata should be ignored: %d, %v", r, err)
+	}
+}
+
+func TestConstraint(t *testing.T) {
+	cases := []struct {
+		c  string
+		v  string
+		ok bool
+	}{
+		{">=1.2.0, <2", "1.5.0", true},
+		{">=1.2.0, <2", "2.0.0", false},
+		{">= 1.2.0 < 2", "1.1.9", false},
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0", false},
+		{"^1.2.3", "1.9.0", true},
+		{"^1.2.3", "2.0.0", false},
+		{"^0.2.3", "0.3.0", false},
+		{"^0.0.3", "0.0.4", false},
+		{"1.2.x", "1.2.7", true},
+		{"1.2.x", "1.3.0", false},
+		{"*", "0.0.1", true},
+		{"1.2", "1.2.5", true},
+		{"!=1.2.3", "1.2.3", false},
+		{"<1.0.0 || >=2", "2.1.0", true},
+		{"<1.0.0 || >=2", "1.1.0", false},
+		{"=1.0.0-rc.1", "1.0.0-rc.1", true},
+	}
+	for _, c := range cases {
+		ok, err := MustParse(c.v).Satisfies(c.c)
+		if err != nil || ok != c.ok {
+			t.Errorf("%q satisfies %q: %v, %v, expect %v", c.v, c.c, ok, err, c.ok)
+		}
+	}
+	for _, s := range []string{"", ">>1", ">=1.x", "1.x.2", "=>1"} {
+		if _, err := ParseConstraint(s); err == nil {
+			t.Errorf("ParseConstraint(%q) expect error", s)
+		}
+	}
+}
@@ -0,0 +1,529 @@
+// wal package is a minimal append-only write-ahead log with the CRC framed records,
+// fsync policies and segment rotation.
+package wal
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrCorrupt is returned by the iterator if a record fails the CRC check,
+	// or by Open if a corrupt record in the last segment is not its torn tail.
+	ErrCorrupt = errors.New("wal: corrupt record")
+	// ErrClosed is returned after the log is closed.
+	ErrClosed = errors.New("wal: log is closed")
+	// ErrNotFound is returned by Iterator if the index is out of the range.
+	ErrNotFound = errors.New("wal: index not found")
+	// ErrTooLarge is returned by Append if the framed record exceeds the segment size.
+	ErrTooLarge = errors.New("wal: record too large")
+)
+
+// SyncPolicy is when the appended records are fsynced.
+type SyncPolicy int
+
+// The sync policies.
+const (
+	// SyncAlways fsyncs after each Append.
+	SyncAlways SyncPolicy = iota
+	// SyncInterval fsyncs every Options.SyncInterval in the background.
+	SyncInterval
+	// SyncBatch fsyncs after every Options.SyncBatch records.
+	SyncBatch
+	// SyncNever leaves the fsync to Sync, Close and the OS.
+	SyncNever
+)
+
+// Options is the options of Open.
+type Options struct {
+	Sync SyncPolicy
+	// SyncInterval is the interval of SyncInterval, 1s by default.
+	SyncInterval time.Duration
+	// SyncBatch is the number of records of SyncBatch, 100 by default.
+	SyncBatch int
+	// SegmentSize is the size to rotate the segment files at, 64MB by default.
+	// It also bounds the size of a framed record, so it must not shrink between the opens.
+	SegmentSize int64
+}
+
+const (
+	headerSize = 8
+	segmentExt = ".wal"
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// crcSeed makes the CRC of the zeroed header non-zero, so a zero-filled tail
+// is not taken for the empty records.
+const crcSeed = 0x57414c31 // "WAL1"
+
+// recordCRC is the CRC of the length bytes followed by the data.
+func recordCRC(length, data []byte) uint32 {
+	return crc32.Update(crc32.Update(crcSeed, crcTable, length), crcTable, data)
+}
+
+type segment struct {
+	first uint64 // the index of the first record
+	path  string
+}
+
+// Log is a write-ahead log in a directory, whose records are indexed from 1.
+// It is safe for concurrent use.
+type Log struct {
+	dir      string
+	opts     Options
+	mu       sync.Mutex
+	segments []segment
+	f        *os.File
+	size     int64
+	last     uint64 // the index of the last record, first-1 if empty
+	first    uint64
+	unsynced int
+	closed   bool
+	done     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// Open opens or creates the log in dir. The torn record at the tail, left by a crash,
+// is truncated, i.e. an incomplete record reaching the end of the last segment, or a zero-filled tail.
+// A corrupt record followed by more data fails with ErrCorrupt instead of dropping the later records.
+// opts may be nil.
+func Open(dir string, opts *Options) (*Log, error) {
+	l := &Log{dir: dir, done: make(chan struct{})}
+	if opts != nil {
+		l.opts = *opts
+	}
+	if l.opts.SyncInterval <= 0 {
+		l.opts.SyncInterval = time.Second
+	}
+	if l.opts.SyncBatch <= 0 {
+		l.opts.SyncBatch = 100
+	}
+	if l.opts.SegmentSize <= 0 {
+		l.opts.SegmentSize = 64 << 20
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	segs, err := listSegments(dir)
+	if err != nil {
+		return nil, err
+	}
+	l.segments = segs
+	if len(segs) == 0 {
+		if err = l.createSegment(1); err != nil {
+			return nil, err
+		}
+	} else if err = l.openTail(); err != nil {
+		return nil, err
+	}
+	l.first = l.segments[0].first
+	if l.opts.Sync == SyncInterval {
+		l.wg.Add(1)
+		go l.syncLoop()
+	}
+	return l, nil
+}
+
+func segmentName(first uint64) string {
+	return fmt.Sprintf("%020d%s", first, segmentExt)
+}
+
+func listSegments(dir string) ([]segment, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var segs []segment
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+		first, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		segs = append(segs, segment{first: first, path: filepath.Join(dir, name)})
+	}
+	sort.Slice(segs, func(i, j int) bool { return segs[i].first < segs[j].first })
+	return segs, nil
+}
+
+func (l *Log) createSegment(first uint64) error {
+	path := filepath.Join(l.dir, segmentName(first))
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	l.segments = append(l.segments, segment{first: first, path: path})
+	l.f, l.size, l.last = f, 0, first-1
+	return syncDir(l.dir)
+}
+
+// openTail scans the last segment for the valid records, and truncates the torn tail.
+func (l *Log) openTail() error {
+	seg := l.segments[len(l.segments)-1]
+	f, err := os.OpenFile(seg.path, os.O_RDWR, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	r := bufio.NewReader(f)
+	var size int64
+	n := uint64(0)
+	for {
+		data, err := readRecord(r, l.opts.SegmentSize)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			torn, terr := isTornTail(f, size, info.Size(), l.opts.SegmentSize)
+			if terr == nil && !torn {
+				terr = fmt.Errorf("%w: %s at offset %d", ErrCorrupt, seg.path, size)
+			}
+			if terr != nil {
+				f.Close()
+				return terr
+			}
+			break
+		}
+		size += headerSize + int64(len(data))
+		n++
+	}
+	if err = f.Truncate(size); err != nil {
+		f.Close()
+		return err
+	}
+	if _, err = f.Seek(size, io.SeekStart); err != nil {
+		f.Close()
+		return err
+	}
+	l.f, l.size, l.last = f, size, seg.first+n-1
+	return nil
+}
+
+// isTornTail reports whether the invalid record at off is the torn tail left by a crash:
+// the record (or its header if the length is out of range) reaches the end of the file,
+// or the rest of the file is all zeros.
+func isTornTail(f *os.File, off, fileSize, segmentSize int64) (bool, error) {
+	if fileSize-off < headerSize {
+		return true, nil
+	}
+	var h [headerSize]byte
+	if _, err := f.ReadAt(h[:], off); err != nil {
+		return false, err
+	}
+	end := off + headerSize
+	if n := int64(binary.LittleEndian.Uint32(h[:4])); n <= segmentSize-headerSize {
+		end += n
+	}
+	if end >= fileSize {
+		return true, nil
+	}
+	buf := make([]byte, 32<<10)
+	r := io.NewSectionReader(f, off, fileSize-off)
+	for {
+		n, err := r.Read(buf)
+		for _, c := range buf[:n] {
+			if c != 0 {
+				return false, nil
+			}
+		}
+		if err == io.EOF {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+}
+
+// readRecord reads a framed record, the length beyond the segment size
+// is taken as corrupt without allocating it.
+func readRecord(r io.Reader, segmentSize int64) ([]byte, error) {
+	var h [headerSize]byte
+	if _, err := io.ReadFull(r, h[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, ErrCorrupt
+		}
+		return nil, err
+	}
+	n := binary.LittleEndian.Uint32(h[:4])
+	if int64(n) > segmentSize-headerSize {
+		return nil, ErrCorrupt
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, ErrCorrupt
+	}
+	if recordCRC(h[:4], data) != binary.LittleEndian.Uint32(h[4:]) {
+		return nil, ErrCorrupt
+	}
+	return data, nil
+}
+
+// Append appends the record and returns its index.
+// If the fsync of SyncAlways or SyncBatch fails, the record is still written and its index consumed,
+// so the index is returned with the error, which means the record may not be durable.
+func (l *Log) Append(data []byte) (uint64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return 0, ErrClosed
+	}
+	if headerSize+int64(len(data)) > l.opts.SegmentSize {
+		return 0, ErrTooLarge
+	}
+	if l.size > 0 && l.size+headerSize+int64(len(data)) > l.opts.SegmentSize {
+		if err := l.rotateLocked(); err != nil {
+			return 0, err
+		}
+	}
+	buf := make([]byte, headerSize+len(data))
+	binary.LittleEndian.PutUint32(buf[:4], uint32(len(data)))
+	binary.LittleEndian.PutUint32(buf[4:8], recordCRC(buf[:4], data))
+	copy(buf[headerSize:], data)
+	if _, err := l.f.Write(buf); err != nil {
+		// drops the partial record
+		l.f.Truncate(l.size)
+		l.f.Seek(l.size, io.SeekStart)
+		return 0, err
+	}
+	l.size += int64(len(buf))
+	l.last++
+	l.unsynced++
+	switch l.opts.Sync {
+	case SyncAlways:
+		if err := l.syncLocked(); err != nil {
+			return l.last, err
+		}
+	case SyncBatch:
+		if l.unsynced >= l.opts.SyncBatch {
+			if err := l.syncLocked(); err != nil {
+				return l.last, err
+			}
+		}
+	}
+	return l.last, nil
+}
+
+func (l *Log) rotateLocked() error {
+	if err := l.f.Sync(); err != nil {
+		return err
+	}
+	if err := l.f.Close(); err != nil {
+		return err
+	}
+	l.unsynced = 0
+	return l.createSegment(l.last + 1)
+}
+
+func (l *Log) syncLocked() error {
+	if l.unsynced == 0 {
+		return nil
+	}
+	l.unsynced = 0
+	return l.f.Sync()
+}
+
+func (l *Log) syncLoop() {
+	defer l.wg.Done()
+	ticker := time.NewTicker(l.opts.SyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.done:
+			return
+		case <-ticker.C:
+			l.Sync()
+		}
+	}
+}
+
+// Sync fsyncs the appended records.
+func (l *Log) Sync() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return ErrClosed
+	}
+	return l.syncLocked()
+}
+
+// FirstIndex returns the index of the first record.
+func (l *Log) FirstIndex() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.first
+}
+
+// LastIndex returns the index of the last record, FirstIndex()-1 if the log is empty.
+func (l *Log) LastIndex() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.last
+}
+
+// TruncateFront removes the segments whose records are all before index,
+// so the records before index may be partially kept.
+func (l *Log) TruncateFront(index uint64) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return ErrClosed
+	}
+	n := 0
+	for n < len(l.segments)-1 && l.segments[n+1].first <= index {
+		if err := os.Remove(l.segments[n].path); err != nil {
+			return err
+		}
+		n++
+	}
+	l.segments = l.segments[n:]
+	l.first = l.segments[0].first
+	return nil
+}
+
+// Close syncs and closes the log.
+func (l *Log) Close() error {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return ErrClosed
+	}
+	l.closed = true
+	close(l.done)
+	err := l.f.Sync()
+	if cerr := l.f.Close(); err == nil {
+		err = cerr
+	}
+	l.mu.Unlock()
+	l.wg.Wait()
+	return err
+}
+
+// Iterator returns an *Iterator replaying the records from index to the current last one.
+func (l *Log) Iterator(index uint64) (*Iterator, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return nil, ErrClosed
+	}
+	if index < l.first || index > l.last+1 {
+		return nil, ErrNotFound
+	}
+	it := &Iterator{last: l.last, next: index, segmentSize: l.opts.SegmentSize}
+	for i := len(l.segments) - 1; i >= 0; i-- {
+		if l.segments[i].first <= index {
+			it.segments = append([]segment(nil), l.segments[i:]...)
+			break
+		}
+	}
+	return it, nil
+}
+
+// Iterator replays the records of the log.
+type Iterator struct {
+	segments    []segment
+	segmentSize int64
+	f           *os.File
+	r           *bufio.Reader
+	seg         uint64 // the index of the next record in the open segment
+	next        uint64
+	last        uint64
+	index       uint64
+	record      []byte
+	err         error
+}
+
+// Next reads the next record, and returns false at the end or on error.
+func (it *Iterator) Next() bool {
+	if it.err != nil || it.next > it.last {
+		return false
+	}
+	for {
+		if it.r == nil {
+			if len(it.segments) == 0 {
+				it.err = ErrNotFound
+				return false
+			}
+			f, err := os.Open(it.segments[0].path)
+			if err != nil {
+				it.err = err
+				return false
+			}
+			it.f, it.r, it.seg = f, bufio.NewReader(f), it.segments[0].first
+			it.segments = it.segments[1:]
+		}
+		data, err := readRecord(it.r, it.segmentSize)
+		if err == io.EOF {
+			it.f.Close()
+			it.f, it.r = nil, nil
+			continue
+		}
+		if err != nil {
+			it.err = err
+			return false
+		}
+		idx := it.seg
+		it.seg++
+		if idx < it.next {
+			continue
+		}
+		it.index, it.record = idx, data
+		it.next = idx + 1
+		return true
+	}
+}
+
+// Index returns the index of the current record.
+func (it *Iterator) Index() uint64 {
+	return it.index
+}
+
+// Record returns the current record.
+func (it *Iterator) Record() []byte {
+	return it.record
+}
+
+// Err returns the error stopping the iteration.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close closes the iterator.
+func (it *Iterator) Close() error {
+	if it.f != nil {
+		err := it.f.Close()
+		it.f, it.r = nil, nil
+		return err
+	}
+	return nil
+}
+
+// syncDir fsyncs the directory to persist the new segment, which is not supported on Windows.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	d.Sync()
+	return d.Close()
+}
@@ -0,0 +1,203 @@
+package wal
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func collect(t *testing.T, l *Log, from uint64) []string {
+	it, err := l.Iterator(from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer it.Close()
+	var got []string
+	for it.Next() {
+		if it.Index() != from+uint64(len(got)) {
+			t.Fatalf("got index %d", it.Index())
+		}
+		got = append(got, string(it.Record()))
+	}
+	if it.Err() != nil {
+		t.Fatal(it.Err())
+	}
+	return got
+}
+
+func TestLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	l, err := Open(dir, &Options{Sync: SyncBatch, SyncBatch: 3, SegmentSize: 64})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 20; i++ {
+		idx, err := l.Append([]byte("record-" + strconv.Itoa(i)))
+		if err != nil || idx != uint64(i) {
+			t.Fatalf("got %d, %v", idx, err)
+		}
+	}
+	if got := collect(t, l, 5); len(got) != 16 || got[0] != "record-5" || got[15] != "record-20" {
+		t.Fatalf("got %v", got)
+	}
+	segs, _ := filepath.Glob(filepath.Join(dir, "*.wal"))
+	if len(segs) < 5 {
+		t.Fatalf("got %d segments", len(segs))
+	}
+	if err = l.TruncateFront(10); err != nil {
+		t.Fatal(err)
+	}
+	if first := l.FirstIndex(); first > 10 || first < 7 {
+		t.Fatalf("got first %d", first)
+	}
+	if _, err = l.Iterator(1); err != ErrNotFound {
+		t.Fatalf("got %v", err)
+	}
+	if err = l.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// a torn tail
+	segs, _ = filepath.Glob(filepath.Join(dir, "*.wal"))
+	f, _ := os.OpenFile(segs[len(segs)-1], os.O_WRONLY|os.O_APPEND, 0644)
+	f.Write([]byte{10, 0, 0, 0, 1, 2})
+	f.Close()
+	l, err = Open(dir, &Options{Sync: SyncInterval, SegmentSize: 64})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	if l.LastIndex() != 20 {
+		t.Fatalf("got last %d", l.LastIndex())
+	}
+	if idx, err := l.Append([]byte("record-21")); err != nil || idx != 21 {
+		t.Fatalf("got %d, %v", idx, err)
+	}
+	if got := collect(t, l, 20); len(got) != 2 || got[1] != "record-21" {
+		t.Fatalf("got %v", got)
+	}
+}
+
+func TestIteratorCorrupt(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	l, err := Open(dir, &Options{SegmentSize: 32})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	for i := 0; i < 4; i++ {
+		l.Append([]byte("0123456789"))
+	}
+	f, _ := os.OpenFile(filepath.Join(dir, segmentName(1)), os.O_WRONLY, 0644)
+	f.WriteAt([]byte("x"), headerSize)
+	f.Close()
+	it, _ := l.Iterator(1)
+	defer it.Close()
+	if it.Next() || it.Err() != ErrCorrupt {
+		t.Fatalf("got %v", it.Err())
+	}
+}
+
+func TestZeroedTail(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	l, err := Open(dir, &Options{SegmentSize: 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 3; i++ {
+		l.Append([]byte("record-" + strconv.Itoa(i)))
+	}
+	if _, err = l.Append(make([]byte, 1024)); err != ErrTooLarge {
+		t.Fatalf("got %v", err)
+	}
+	l.Close()
+
+	// the preallocated or zero-filled tail after a crash
+	f, _ := os.OpenFile(filepath.Join(dir, segmentName(1)), os.O_WRONLY|os.O_APPEND, 0644)
+	f.Write(make([]byte, 64))
+	f.Close()
+	l, err = Open(dir, &Options{SegmentSize: 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	if l.LastIndex() != 3 {
+		t.Fatalf("got last %d", l.LastIndex())
+	}
+	if idx, err := l.Append([]byte("record-4")); err != nil || idx != 4 {
+		t.Fatalf("got %d, %v", idx, err)
+	}
+	if got := collect(t, l, 1); len(got) != 4 || got[3] != "record-4" {
+		t.Fatalf("got %v", got)
+	}
+}
+
+func TestHugeLength(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	l, err := Open(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.Append([]byte("record-1"))
+	l.Close()
+
+	// a torn header claiming a 4GB record
+	f, _ := os.OpenFile(filepath.Join(dir, segmentName(1)), os.O_WRONLY|os.O_APPEND, 0644)
+	f.Write([]byte{0xff, 0xff, 0xff, 0xff, 0, 0, 0, 0})
+	f.Close()
+	l, err = Open(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	if l.LastIndex() != 1 {
+		t.Fatalf("got last %d", l.LastIndex())
+	}
+}
+
+func TestCorruptMiddle(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	l, err := Open(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 3; i++ {
+		l.Append([]byte("record-" + strconv.Itoa(i)))
+	}
+	l.Close()
+
+	// a flipped byte in the first record, followed by the valid ones
+	path := filepath.Join(dir, segmentName(1))
+	f, _ := os.OpenFile(path, os.O_WRONLY, 0644)
+	f.WriteAt([]byte("x"), headerSize)
+	f.Close()
+	if _, err = Open(dir, nil); !errors.Is(err, ErrCorrupt) {
+		t.Fatalf("expect ErrCorrupt, got %v", err)
+	}
+	if info, _ := os.Stat(path); info.Size() != 3*(headerSize+8) {
+		t.Fatalf("the segment should be kept, got size %d", info.Size())
+	}
+}
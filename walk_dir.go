@@ -0,0 +1,314 @@
+package goutil
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/henrylee2cn/goutil/fs"
+)
+
+// WalkType is the bitmask of the entry types visited by WalkDir.
+type WalkType int
+
+const (
+	// WalkTypeFile is the regular files and the other non-directory entries.
+	WalkTypeFile WalkType = 1 << iota
+	// WalkTypeDir is the directories.
+	WalkTypeDir
+	// WalkTypeSymlink is the symbolic links, which are never followed.
+	WalkTypeSymlink
+)
+
+// WalkOptions are the options of WalkDir.
+type WalkOptions struct {
+	// Ignore are the .gitignore-style patterns relative to the root.
+	Ignore []string
+	// IgnoreFiles are the names of the ignore files (e.g. ".gitignore") read from
+	// each directory, whose patterns are relative to that directory.
+	IgnoreFiles []string
+	// MaxDepth limits the depth of the entries, the children of the root are 1.
+	// 0 means no limit.
+	MaxDepth int
+	// Types are the entry types passed to fn, all types by default.
+	Types WalkType
+	// Workers is the max number of the goroutines reading the directories,
+	// runtime.NumCPU() by default. With 1 worker, the entries are visited in lexical order.
+	Workers int
+}
+
+// WalkDir walks the directory tree under root in parallel, calling fn for each entry
+// (except the root itself) that is not ignored and matches the types.
+// fn is called serially, and the order of the entries is not deterministic
+// with more than one worker.
+// If fn returns filepath.SkipDir on a directory, its content is skipped;
+// any other error stops the walk and is returned.
+// The ignored directories are not read at all. opts may be nil.
+func WalkDir(root string, opts *WalkOptions, fn func(path string, info os.FileInfo) error) error {
+	return WalkDirFS(fs.OS, root, opts, fn)
+}
+
+// WalkDirFS is WalkDir on the file system fsys.
+func WalkDirFS(fsys fs.FS, root string, opts *WalkOptions, fn func(path string, info os.FileInfo) error) error {
+	if opts == nil {
+		opts = new(WalkOptions)
+	}
+	w := &dirWalker{
+		fsys:  fsys,
+		root:  root,
+		opts:  opts,
+		fn:    fn,
+		types: opts.Types,
+	}
+	if w.types == 0 {
+		w.types = WalkTypeFile | WalkTypeDir | WalkTypeSymlink
+	}
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	w.sem = make(chan struct{}, workers-1)
+	var rules []ignoreRule
+	for _, p := range opts.Ignore {
+		if r, ok := parseIgnoreRule(p, ""); ok {
+			rules = append(rules, r)
+		}
+	}
+	if _, err := fsys.Stat(root); err != nil {
+		return err
+	}
+	w.walk(root, "", 0, rules)
+	w.wg.Wait()
+	return w.err
+}
+
+type dirWalker struct {
+	fsys  fs.FS
+	root  string
+	opts  *WalkOptions
+	fn    func(string, os.FileInfo) error
+	types WalkType
+	sem   chan struct{}
+	wg    sync.WaitGroup
+	mu    sync.Mutex // serializes fn and protects err
+	err   error
+}
+
+func (w *dirWalker) stopped() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.err != nil
+}
+
+func (w *dirWalker) fail(err error) {
+	w.mu.Lock()
+	if w.err == nil {
+		w.err = err
+	}
+	w.mu.Unlock()
+}
+
+func (w *dirWalker) walk(dir, rel string, depth int, rules []ignoreRule) {
+	if w.stopped() {
+		return
+	}
+	for _, name := range w.opts.IgnoreFiles {
+		more, err := readIgnoreFile(w.fsys, filepath.Join(dir, name), rel)
+		if err != nil {
+			w.fail(err)
+			return
+		}
+		if len(more) > 0 {
+			rules = append(rules[:len(rules):len(rules)], more...)
+		}
+	}
+	f, err := w.fsys.Open(dir)
+	if err != nil {
+		w.fail(err)
+		return
+	}
+	infos, err := f.Readdir(-1)
+	f.Close()
+	if err != nil {
+		w.fail(err)
+		return
+	}
+	if cap(w.sem) == 0 {
+		sort.Slice(infos, func(i, j int) bool { return infos[i].Name() < infos[j].Name() })
+	}
+	for _, info := range infos {
+		childRel := info.Name()
+		if rel != "" {
+			childRel = rel + "/" + childRel
+		}
+		isDir := info.IsDir()
+		if matchIgnoreRules(rules, childRel, isDir) {
+			continue
+		}
+		p := filepath.Join(dir, info.Name())
+		if w.matchType(info) {
+			w.mu.Lock()
+			if w.err != nil {
+				w.mu.Unlock()
+				return
+			}
+			err = w.fn(p, info)
+			if err != nil && !(err == filepath.SkipDir && isDir) {
+				w.err = err
+			}
+			w.mu.Unlock()
+			if err == filepath.SkipDir && isDir {
+				continue
+			}
+			if err != nil {
+				return
+			}
+		}
+		if !isDir || (w.opts.MaxDepth > 0 && depth+1 >= w.opts.MaxDepth) {
+			continue
+		}
+		select {
+		case w.sem <- struct{}{}:
+			w.wg.Add(1)
+			go func(p, childRel string) {
+				defer func() {
+					<-w.sem
+					w.wg.Done()
+				}()
+				w.walk(p, childRel, depth+1, rules)
+			}(p, childRel)
+		default:
+			w.walk(p, childRel, depth+1, rules)
+		}
+	}
+}
+
+func (w *dirWalker) matchType(info os.FileInfo) bool {
+	switch {
+	case info.Mode()&os.ModeSymlink != 0:
+		return w.types&WalkTypeSymlink != 0
+	case info.IsDir():
+		return w.types&WalkTypeDir != 0
+	}
+	return w.types&WalkTypeFile != 0
+}
+
+// ignoreRule is a .gitignore pattern.
+type ignoreRule struct {
+	base    string // the relative directory of the ignore file
+	re      *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+func readIgnoreFile(fsys fs.FS, filename, base string) ([]ignoreRule, error) {
+	f, err := fsys.Open(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	var rules []ignoreRule
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		if r, ok := parseIgnoreRule(s.Text(), base); ok {
+			rules = append(rules, r)
+		}
+	}
+	return rules, s.Err()
+}
+
+func parseIgnoreRule(line, base string) (ignoreRule, bool) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || line[0] == '#' {
+		return ignoreRule{}, false
+	}
+	r := ignoreRule{base: base}
+	if line[0] == '!' {
+		r.negate = true
+		line = line[1:]
+	} else if line[0] == '\\' {
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		r.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if line == "" {
+		return ignoreRule{}, false
+	}
+	anchored := strings.Contains(line, "/")
+	line = strings.TrimPrefix(line, "/")
+	var b strings.Builder
+	b.WriteByte('^')
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case strings.HasPrefix(line[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(line[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		case c == '[':
+			j := strings.IndexByte(line[i+1:], ']')
+			if j < 0 {
+				b.WriteString(`\[`)
+				continue
+			}
+			class := line[i+1 : i+1+j]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + strings.Replace(class, `\`, `\\`, -1) + "]")
+			i += j + 1
+		case c == '\\' && i+1 < len(line):
+			i++
+			b.WriteString(regexp.QuoteMeta(line[i : i+1]))
+		default:
+			b.WriteString(regexp.QuoteMeta(line[i : i+1]))
+		}
+	}
+	b.WriteByte('$')
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return ignoreRule{}, false
+	}
+	r.re = re
+	return r, true
+}
+
+// matchIgnoreRules reports whether the relative path is ignored, the last matching rule wins.
+func matchIgnoreRules(rules []ignoreRule, rel string, isDir bool) bool {
+	ignored := false
+	for _, r := range rules {
+		if r.dirOnly && !isDir {
+			continue
+		}
+		p := rel
+		if r.base != "" {
+			if !strings.HasPrefix(rel, r.base+"/") {
+				continue
+			}
+			p = rel[len(r.base)+1:]
+		}
+		if r.re.MatchString(p) {
+			ignored = !r.negate
+		}
+	}
+	return ignored
+}
@@ -0,0 +1,155 @@
+package goutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/henrylee2cn/goutil/fs"
+)
+
+func createWalkTree(t *testing.T) string {
+	root, err := ioutil.TempDir("", "goutil_walk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range map[string]string{
+		".gitignore":           "*.log\n/build/\n!keep.log\n# comment\n",
+		"main.go":              "",
+		"debug.log":            "",
+		"keep.log":             "",
+		"build/out.bin":        "",
+		"pkg/build/gen.go":     "",
+		"pkg/a.go":             "",
+		"pkg/.gitignore":       "tmp/\n",
+		"pkg/tmp/x.go":         "",
+		"pkg/deep/er/b.go":     "",
+		"docs/api/index.md":    "",
+		"node_modules/m/m.js":  "",
+		"docs/api/generated.x": "",
+	} {
+		p := filepath.Join(root, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(p), 0755)
+		if err = ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func collectWalk(t *testing.T, root string, opts *WalkOptions) []string {
+	var got []string
+	err := WalkDir(root, opts, func(path string, info os.FileInfo) error {
+		rel, _ := filepath.Rel(root, path)
+		got = append(got, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(got)
+	return got
+}
+
+func TestWalkDir(t *testing.T) {
+	root := createWalkTree(t)
+	defer os.RemoveAll(root)
+
+	got := collectWalk(t, root, &WalkOptions{
+		IgnoreFiles: []string{".gitignore"},
+		Ignore:      []string{"node_modules/", "docs/**/*.x"},
+		Types:       WalkTypeFile,
+		Workers:     4,
+	})
+	expect := []string{".gitignore", "docs/api/index.md", "keep.log", "main.go", "pkg/.gitignore", "pkg/a.go", "pkg/build/gen.go", "pkg/deep/er/b.go"}
+	if !reflect.DeepEqual(got, expect) {
+		t.Fatalf("got %v\nexpect %v", got, expect)
+	}
+
+	got = collectWalk(t, root, &WalkOptions{MaxDepth: 1, Types: WalkTypeDir, Workers: 1})
+	expect = []string{"build", "docs", "node_modules", "pkg"}
+	if !reflect.DeepEqual(got, expect) {
+		t.Fatalf("got %v", got)
+	}
+
+	var visited []string
+	err := WalkDir(root, &WalkOptions{Workers: 1}, func(path string, info os.FileInfo) error {
+		if info.IsDir() && (info.Name() == "build" || info.Name() == "docs") {
+			return filepath.SkipDir
+		}
+		visited = append(visited, info.Name())
+		if info.Name() == "b.go" {
+			return os.ErrExist
+		}
+		return nil
+	})
+	if err != os.ErrExist {
+		t.Fatalf("expect the fn error, got %v", err)
+	}
+	for _, name := range visited {
+		if name == "out.bin" || name == "index.md" || name == "gen.go" {
+			t.Fatalf("%s should be skipped: %v", name, visited)
+		}
+	}
+	if err = WalkDir(filepath.Join(root, "missing"), nil, func(string, os.FileInfo) error { return nil }); err == nil {
+		t.Fatal("expect error for missing root")
+	}
+}
+
+func TestIgnoreRules(t *testing.T) {
+	for _, c := range []struct {
+		pattern string
+		path    string
+		isDir   bool
+		match   bool
+	}{
+		{"*.o", "a/b/c.o", false, true},
+		{"/a.txt", "a.txt", false, true},
+		{"/a.txt", "x/a.txt", false, false},
+		{"doc/*.md", "doc/x.md", false, true},
+		{"doc/*.md", "doc/sub/x.md", false, false},
+		{"**/logs", "x/y/logs", true, true},
+		{"logs/**", "logs/a/b", false, true},
+		{"a/**/b", "a/b", false, true},
+		{"a/**/b", "a/x/y/b", false, true},
+		{"dir/", "x/dir", false, false},
+		{"dir/", "x/dir", true, true},
+		{"f[0-9].txt", "f1.txt", false, true},
+		{"f[!0-9].txt", "f1.txt", false, false},
+		{"\\#hash", "#hash", false, true},
+	} {
+		r, ok := parseIgnoreRule(c.pattern, "")
+		if !ok {
+			t.Fatalf("invalid pattern %q", c.pattern)
+		}
+		if got := matchIgnoreRules([]ignoreRule{r}, c.path, c.isDir); got != c.match {
+			t.Errorf("pattern %q path %q: got %v", c.pattern, c.path, got)
+		}
+	}
+}
+
+func TestWalkDirFS(t *testing.T) {
+	fsys := fs.NewMemFS()
+	fsys.MkdirAll("/root/a/b", 0755)
+	fsys.MkdirAll("/root/build", 0755)
+	for _, name := range []string{"/root/x.go", "/root/a/y.go", "/root/a/b/z.txt", "/root/build/out"} {
+		fs.WriteFile(fsys, name, nil, 0644)
+	}
+	fs.WriteFile(fsys, "/root/.gitignore", []byte("build/\n*.txt\n"), 0644)
+	var got []string
+	err := WalkDirFS(fsys, "/root", &WalkOptions{IgnoreFiles: []string{".gitignore"}, Types: WalkTypeFile, Workers: 1},
+		func(path string, info os.FileInfo) error {
+			got = append(got, filepath.ToSlash(path))
+			return nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := []string{"/root/.gitignore", "/root/a/y.go", "/root/x.go"}
+	if !reflect.DeepEqual(got, expect) {
+		t.Fatalf("got %v, expect %v", got, expect)
+	}
+}
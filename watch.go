@@ -0,0 +1,239 @@
+package goutil
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/henrylee2cn/goutil/fs"
+)
+
+// WatchOp is the bitmask of the file change operations.
+type WatchOp uint32
+
+// The file change operations.
+const (
+	WatchCreate WatchOp = 1 << iota
+	WatchModify
+	WatchRemove
+	WatchRename
+)
+
+// String returns the names of the operations, e.g. "CREATE|MODIFY".
+func (op WatchOp) String() string {
+	var names []string
+	for _, o := range []struct {
+		op   WatchOp
+		name string
+	}{{WatchCreate, "CREATE"}, {WatchModify, "MODIFY"}, {WatchRemove, "REMOVE"}, {WatchRename, "RENAME"}} {
+		if op&o.op != 0 {
+			names = append(names, o.name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// WatchEvent is a change of the file.
+type WatchEvent struct {
+	Path string
+	// Op is the operations coalesced in the debounce window.
+	Op WatchOp
+}
+
+// pollWatchInterval is the scan interval of the polling watcher,
+// used where the OS notification API is unavailable.
+var pollWatchInterval = 500 * time.Millisecond
+
+// Watcher watches the changes of a file or the entries of a directory.
+type Watcher struct {
+	dir, name string
+	debounce  time.Duration
+	fn        func([]WatchEvent)
+	raw       chan WatchEvent
+	done      chan struct{}
+	release   func() error
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// WatchPath watches the file or the direct entries of the directory,
+// built on inotify on Linux and polling elsewhere.
+// The events are coalesced until no more arrive within debounce,
+// then fn is called serially with the events sorted by path.
+// A watched file may not exist yet, but its directory must exist,
+// and it survives the editors replacing the file on save.
+// The polling watcher reports a rename only if the entry keeps its size, mode and
+// modification time, otherwise as a remove and a create.
+func WatchPath(path string, debounce time.Duration, fn func(events []WatchEvent)) (*Watcher, error) {
+	return WatchPathFS(fs.OS, path, debounce, fn)
+}
+
+// WatchPathFS is WatchPath on the file system fsys, which is polled except for fs.OS on Linux.
+func WatchPathFS(fsys fs.FS, path string, debounce time.Duration, fn func(events []WatchEvent)) (*Watcher, error) {
+	path = filepath.Clean(path)
+	if fsys == fs.OS {
+		var err error
+		if path, err = filepath.Abs(path); err != nil {
+			return nil, err
+		}
+	}
+	w := &Watcher{
+		dir:      path,
+		debounce: debounce,
+		fn:       fn,
+		raw:      make(chan WatchEvent, 128),
+		done:     make(chan struct{}),
+	}
+	info, err := fsys.Stat(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err != nil || !info.IsDir() {
+		w.dir, w.name = filepath.Split(path)
+		w.dir = filepath.Clean(w.dir)
+	}
+	if fsys == fs.OS {
+		w.release, err = watchDir(w.dir, w.raw, w.done)
+	} else {
+		w.release, err = pollDir(fsys, w.dir, w.raw, w.done)
+	}
+	if err != nil {
+		return nil, err
+	}
+	go w.dispatch()
+	return w, nil
+}
+
+// Close stops watching.
+func (w *Watcher) Close() error {
+	w.closeOnce.Do(func() {
+		close(w.done)
+		w.closeErr = w.release()
+	})
+	return w.closeErr
+}
+
+func (w *Watcher) dispatch() {
+	pending := make(map[string]WatchOp)
+	timer := time.NewTimer(time.Hour)
+	timer.Stop()
+	defer timer.Stop()
+	for {
+		select {
+		case <-w.done:
+			return
+		case ev := <-w.raw:
+			if w.name != "" && (filepath.Dir(ev.Path) != w.dir || filepath.Base(ev.Path) != w.name) {
+				continue
+			}
+			pending[ev.Path] |= ev.Op
+			// drains the fired but unreceived tick, or it would flush the restarted window early
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(w.debounce)
+		case <-timer.C:
+			if len(pending) == 0 {
+				continue
+			}
+			events := make([]WatchEvent, 0, len(pending))
+			for p, op := range pending {
+				events = append(events, WatchEvent{Path: p, Op: op})
+			}
+			sort.Slice(events, func(i, j int) bool { return events[i].Path < events[j].Path })
+			pending = make(map[string]WatchOp)
+			select {
+			case <-w.done:
+				return
+			default:
+				w.fn(events)
+			}
+		}
+	}
+}
+
+type watchStamp struct {
+	modTime time.Time
+	size    int64
+	mode    os.FileMode
+}
+
+func scanWatchDir(fsys fs.FS, dir string) map[string]watchStamp {
+	infos, err := fsys.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	m := make(map[string]watchStamp, len(infos))
+	for _, info := range infos {
+		m[info.Name()] = watchStamp{info.ModTime(), info.Size(), info.Mode()}
+	}
+	return m
+}
+
+// pollDir reports the changes of the directory entries by comparing the snapshots.
+// Like inotify, a renamed entry is reported as WatchRename of the old name and WatchCreate
+// of the new one, which is detected by pairing a removed and a created entry of the same
+// size, mode and modification time within a scan, so it is a best effort.
+func pollDir(fsys fs.FS, dir string, out chan<- WatchEvent, done <-chan struct{}) (func() error, error) {
+	if _, err := fsys.Stat(dir); err != nil {
+		return nil, err
+	}
+	prev := scanWatchDir(fsys, dir)
+	go func() {
+		ticker := time.NewTicker(pollWatchInterval)
+		defer ticker.Stop()
+		send := func(name string, op WatchOp) bool {
+			select {
+			case out <- WatchEvent{Path: filepath.Join(dir, name), Op: op}:
+				return true
+			case <-done:
+				return false
+			}
+		}
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+			cur := scanWatchDir(fsys, dir)
+			// the created entries by the stamp, to be paired with the removed ones as renames
+			created := make(map[watchStamp]int)
+			for name, s := range cur {
+				old, ok := prev[name]
+				switch {
+				case !ok:
+					created[s]++
+					if !send(name, WatchCreate) {
+						return
+					}
+				case old != s:
+					if !send(name, WatchModify) {
+						return
+					}
+				}
+			}
+			for name, s := range prev {
+				if _, ok := cur[name]; ok {
+					continue
+				}
+				op := WatchRemove
+				if created[s] > 0 {
+					created[s]--
+					op = WatchRename
+				}
+				if !send(name, op) {
+					return
+				}
+			}
+			prev = cur
+		}
+	}()
+	return func() error { return nil }, nil
+}
@@ -0,0 +1,77 @@
+package goutil
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"syscall"
+	"unsafe"
+
+	"github.com/henrylee2cn/goutil/fs"
+)
+
+const inotifyMask = syscall.IN_CREATE | syscall.IN_MODIFY | syscall.IN_ATTRIB | syscall.IN_DELETE |
+	syscall.IN_MOVED_FROM | syscall.IN_MOVED_TO | syscall.IN_DELETE_SELF | syscall.IN_MOVE_SELF
+
+// watchDir watches the directory by inotify, falling back to polling.
+func watchDir(dir string, out chan<- WatchEvent, done <-chan struct{}) (func() error, error) {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		return pollDir(fs.OS, dir, out, done)
+	}
+	if _, err = syscall.InotifyAddWatch(fd, dir, inotifyMask); err != nil {
+		syscall.Close(fd)
+		return nil, &os.PathError{Op: "inotify_add_watch", Path: dir, Err: err}
+	}
+	// The non-blocking fd is registered to the runtime poller, so Close interrupts Read.
+	f := os.NewFile(uintptr(fd), "inotify")
+	go func() {
+		buf := make([]byte, 64*1024)
+		for {
+			n, err := f.Read(buf)
+			if err != nil {
+				return
+			}
+			for off := 0; off+syscall.SizeofInotifyEvent <= n; {
+				raw := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[off]))
+				path := dir
+				nameStart := off + syscall.SizeofInotifyEvent
+				off = nameStart + int(raw.Len)
+				if raw.Len > 0 && off <= n {
+					name := buf[nameStart:off]
+					if i := bytes.IndexByte(name, 0); i >= 0 {
+						name = name[:i]
+					}
+					path = filepath.Join(dir, string(name))
+				}
+				op := inotifyOp(raw.Mask)
+				if op == 0 {
+					continue
+				}
+				select {
+				case out <- WatchEvent{Path: path, Op: op}:
+				case <-done:
+					return
+				}
+			}
+		}
+	}()
+	return f.Close, nil
+}
+
+func inotifyOp(mask uint32) WatchOp {
+	var op WatchOp
+	if mask&(syscall.IN_CREATE|syscall.IN_MOVED_TO) != 0 {
+		op |= WatchCreate
+	}
+	if mask&(syscall.IN_MODIFY|syscall.IN_ATTRIB|syscall.IN_Q_OVERFLOW) != 0 {
+		op |= WatchModify
+	}
+	if mask&(syscall.IN_DELETE|syscall.IN_DELETE_SELF) != 0 {
+		op |= WatchRemove
+	}
+	if mask&(syscall.IN_MOVED_FROM|syscall.IN_MOVE_SELF) != 0 {
+		op |= WatchRename
+	}
+	return op
+}
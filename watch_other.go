@@ -0,0 +1,11 @@
+//go:build !linux
+// +build !linux
+
+package goutil
+
+import "github.com/henrylee2cn/goutil/fs"
+
+// watchDir watches the directory by polling.
+func watchDir(dir string, out chan<- WatchEvent, done <-chan struct{}) (func() error, error) {
+	return pollDir(fs.OS, dir, out, done)
+}
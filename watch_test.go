@@ -0,0 +1,140 @@
+package goutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/henrylee2cn/goutil/fs"
+)
+
+func TestWatchPath(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goutil_watch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "app.conf")
+
+	ch := make(chan []WatchEvent, 10)
+	w, err := WatchPath(filename, 50*time.Millisecond, func(events []WatchEvent) { ch <- events })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	wait := func() []WatchEvent {
+		select {
+		case events := <-ch:
+			return events
+		case <-time.After(3 * time.Second):
+			t.Fatal("timeout")
+		}
+		return nil
+	}
+
+	// a burst of writes and an unrelated file
+	for i := 0; i < 5; i++ {
+		ioutil.WriteFile(filename, []byte("v1"), 0644)
+	}
+	ioutil.WriteFile(filepath.Join(dir, "other"), nil, 0644)
+	events := wait()
+	if len(events) != 1 || events[0].Path != filename || events[0].Op&WatchCreate == 0 {
+		t.Fatalf("unexpected events: %v", events)
+	}
+
+	// replace on save
+	tmp := filepath.Join(dir, "app.conf.tmp")
+	ioutil.WriteFile(tmp, []byte("v2"), 0644)
+	os.Rename(tmp, filename)
+	events = wait()
+	if len(events) != 1 || events[0].Path != filename {
+		t.Fatalf("unexpected events: %v", events)
+	}
+
+	os.Remove(filename)
+	events = wait()
+	if len(events) != 1 || events[0].Op&WatchRemove == 0 {
+		t.Fatalf("unexpected events: %v", events)
+	}
+	select {
+	case events = <-ch:
+		t.Fatalf("unexpected events: %v", events)
+	case <-time.After(100 * time.Millisecond):
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPollDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goutil_poll")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	old := pollWatchInterval
+	pollWatchInterval = 10 * time.Millisecond
+	defer func() { pollWatchInterval = old }()
+
+	out := make(chan WatchEvent, 10)
+	done := make(chan struct{})
+	defer close(done)
+	if _, err = pollDir(fs.OS, dir, out, done); err != nil {
+		t.Fatal(err)
+	}
+	filename := filepath.Join(dir, "a")
+	expectPath := func(path string, op WatchOp) {
+		select {
+		case ev := <-out:
+			if ev.Path != path || ev.Op != op {
+				t.Fatalf("got %s %s, expect %s %s", ev.Path, ev.Op, path, op)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timeout waiting for %s", op)
+		}
+	}
+	expect := func(op WatchOp) { expectPath(filename, op) }
+	ioutil.WriteFile(filename, []byte("1"), 0644)
+	expect(WatchCreate)
+	ioutil.WriteFile(filename, []byte("12"), 0644)
+	expect(WatchModify)
+	os.Remove(filename)
+	expect(WatchRemove)
+
+	ioutil.WriteFile(filename, []byte("1"), 0644)
+	expect(WatchCreate)
+	renamed := filepath.Join(dir, "b")
+	os.Rename(filename, renamed)
+	// the new name is reported first
+	expectPath(renamed, WatchCreate)
+	expect(WatchRename)
+	if s := (WatchCreate | WatchRename).String(); s != "CREATE|RENAME" {
+		t.Fatal(s)
+	}
+}
+
+func TestWatchPathFS(t *testing.T) {
+	old := pollWatchInterval
+	pollWatchInterval = 10 * time.Millisecond
+	defer func() { pollWatchInterval = old }()
+	fsys := fs.NewMemFS()
+	fsys.MkdirAll("/app", 0755)
+	ch := make(chan []WatchEvent, 10)
+	w, err := WatchPathFS(fsys, "/app/conf", 20*time.Millisecond, func(events []WatchEvent) { ch <- events })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	fs.WriteFile(fsys, "/app/other", nil, 0644)
+	fs.WriteFile(fsys, "/app/conf", []byte("a"), 0644)
+	select {
+	case events := <-ch:
+		if len(events) != 1 || filepath.ToSlash(events[0].Path) != "/app/conf" || events[0].Op&WatchCreate == 0 {
+			t.Fatalf("got %v", events)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout")
+	}
+}
@@ -0,0 +1,134 @@
+package goutil
+
+import (
+	"errors"
+	mrand "math/rand"
+	"sort"
+)
+
+// ErrInvalidWeight is returned when the weights are negative, or sum to zero.
+var ErrInvalidWeight = errors.New("goutil: invalid weights")
+
+// WeightedItem is an item with its non-negative weight.
+type WeightedItem struct {
+	Item   interface{}
+	Weight float64
+}
+
+// WeightedOptions is the options of NewWeightedChooser.
+type WeightedOptions struct {
+	// Alias uses the Walker's alias method for O(1) picks, at the cost of O(n) extra memory.
+	Alias bool
+	// Rand returns a random number in [0.0, 1.0), math/rand.Float64 by default.
+	Rand func() float64
+}
+
+// WeightedChooser picks the items randomly in proportion to their weights,
+// with O(log n) picks via the prefix sums by default, e.g. for traffic splitting and A/B bucketing.
+// It is safe for concurrent use if the Rand is.
+type WeightedChooser struct {
+	items  []interface{}
+	sums   []float64 // prefix sums
+	prob   []float64 // alias method
+	alias  []int
+	random func() float64
+}
+
+// NewWeightedChooser creates a chooser of the items. opts may be nil.
+func NewWeightedChooser(items []WeightedItem, opts *WeightedOptions) (*WeightedChooser, error) {
+	if opts == nil {
+		opts = &WeightedOptions{}
+	}
+	c := &WeightedChooser{
+		items:  make([]interface{}, len(items)),
+		random: opts.Rand,
+	}
+	if c.random == nil {
+		c.random = mrand.Float64
+	}
+	var total float64
+	for i, item := range items {
+		if item.Weight < 0 {
+			return nil, ErrInvalidWeight
+		}
+		total += item.Weight
+		c.items[i] = item.Item
+	}
+	if !(total > 0) {
+		return nil, ErrInvalidWeight
+	}
+	if opts.Alias {
+		c.initAlias(items, total)
+		return c, nil
+	}
+	c.sums = make([]float64, len(items))
+	var sum float64
+	for i, item := range items {
+		sum += item.Weight
+		c.sums[i] = sum
+	}
+	return c, nil
+}
+
+// initAlias builds the tables by the Vose's algorithm.
+func (c *WeightedChooser) initAlias(items []WeightedItem, total float64) {
+	n := len(items)
+	c.prob = make([]float64, n)
+	c.alias = make([]int, n)
+	small := make([]int, 0, n)
+	large := make([]int, 0, n)
+	for i, item := range items {
+		c.prob[i] = item.Weight * float64(n) / total
+		if c.prob[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small = small[:len(small)-1]
+		c.alias[s] = l
+		if c.prob[l] += c.prob[s] - 1; c.prob[l] < 1 {
+			large = large[:len(large)-1]
+			small = append(small, l)
+		}
+	}
+	// the rest are 1 except the floating-point errors
+	for _, i := range append(small, large...) {
+		c.prob[i] = 1
+	}
+}
+
+// Len returns the number of the items.
+func (c *WeightedChooser) Len() int {
+	return len(c.items)
+}
+
+// Pick returns a random item.
+func (c *WeightedChooser) Pick() interface{} {
+	return c.items[c.PickIndex()]
+}
+
+// PickIndex returns the index of a random item.
+func (c *WeightedChooser) PickIndex() int {
+	n := len(c.items)
+	if c.prob != nil {
+		u := c.random() * float64(n)
+		i := int(u)
+		if i >= n {
+			i = n - 1
+		}
+		if u-float64(i) < c.prob[i] {
+			return i
+		}
+		return c.alias[i]
+	}
+	r := c.random() * c.sums[n-1]
+	// the first prefix sum greater than r skips the zero weights
+	i := sort.Search(n, func(i int) bool { return c.sums[i] > r })
+	if i >= n {
+		i = n - 1
+	}
+	return i
+}
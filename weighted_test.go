@@ -0,0 +1,35 @@
+package goutil
+
+import (
+	mrand "math/rand"
+	"testing"
+)
+
+func TestWeightedChooser(t *testing.T) {
+	items := []WeightedItem{{"a", 1}, {"b", 0}, {"c", 3}, {"d", 6}}
+	for _, alias := range []bool{false, true} {
+		r := mrand.New(mrand.NewSource(1))
+		c, err := NewWeightedChooser(items, &WeightedOptions{Alias: alias, Rand: r.Float64})
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts := map[interface{}]int{}
+		for i := 0; i < 100000; i++ {
+			counts[c.Pick()]++
+		}
+		if counts["b"] != 0 {
+			t.Fatalf("alias=%v: picked the zero weight: %v", alias, counts)
+		}
+		for _, item := range items {
+			expect := int(item.Weight * 10000)
+			if n := counts[item.Item]; n < expect*9/10 || n > expect*11/10 {
+				t.Fatalf("alias=%v: got %v", alias, counts)
+			}
+		}
+	}
+	for _, bad := range [][]WeightedItem{nil, {{"a", 0}}, {{"a", -1}, {"b", 2}}} {
+		if _, err := NewWeightedChooser(bad, nil); err != ErrInvalidWeight {
+			t.Fatalf("%v: got %v", bad, err)
+		}
+	}
+}